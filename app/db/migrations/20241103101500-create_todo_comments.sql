@@ -0,0 +1,16 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS todo_comments(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_id INT NOT NULL,
+	user_id INT NOT NULL,
+	content TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_todo_id (todo_id),
+	CONSTRAINT fk_todo_comments_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+	CONSTRAINT fk_todo_comments_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_comments;
@@ -0,0 +1,21 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS notifications(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id INT NOT NULL,
+	actor_id INT NOT NULL,
+	event_type VARCHAR(50) NOT NULL,
+	todo_id INT,
+	todo_comment_id INT,
+	read_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_user_id_read_at (user_id, read_at),
+	CONSTRAINT fk_notifications_users FOREIGN KEY (user_id) REFERENCES users (id),
+	CONSTRAINT fk_notifications_actors FOREIGN KEY (actor_id) REFERENCES users (id),
+	CONSTRAINT fk_notifications_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+	CONSTRAINT fk_notifications_todo_comments FOREIGN KEY (todo_comment_id) REFERENCES todo_comments (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,19 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS attachments(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_id INT NOT NULL,
+	user_id INT NOT NULL,
+	file_name VARCHAR(255) NOT NULL,
+	content_type VARCHAR(255) NOT NULL,
+	size BIGINT NOT NULL,
+	storage_key VARCHAR(255) NOT NULL UNIQUE,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_todo_id (todo_id),
+	CONSTRAINT fk_attachments_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+	CONSTRAINT fk_attachments_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,16 @@
+
+-- +migrate Up
+ALTER TABLE todos
+	ADD COLUMN due_date DATE AFTER content,
+	ADD COLUMN completed_at DATETIME AFTER due_date,
+	ADD COLUMN recurrence_rule VARCHAR(255) AFTER completed_at,
+	ADD COLUMN recurrence_parent_id INT AFTER recurrence_rule,
+	ADD CONSTRAINT fk_todos_recurrence_parents FOREIGN KEY (recurrence_parent_id) REFERENCES todos (id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP FOREIGN KEY fk_todos_recurrence_parents,
+	DROP COLUMN recurrence_parent_id,
+	DROP COLUMN recurrence_rule,
+	DROP COLUMN completed_at,
+	DROP COLUMN due_date;
@@ -0,0 +1,20 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS todo_reminders(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_id INT NOT NULL,
+	user_id INT NOT NULL,
+	remind_at DATETIME NOT NULL,
+	sent_at DATETIME,
+	attempts INT NOT NULL DEFAULT 0,
+	last_error TEXT,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_todo_id (todo_id),
+	index index_sent_at_remind_at (sent_at, remind_at),
+	CONSTRAINT fk_todo_reminders_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+	CONSTRAINT fk_todo_reminders_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_reminders;
@@ -0,0 +1,12 @@
+
+-- +migrate Up
+ALTER TABLE todos
+	ADD COLUMN position VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL DEFAULT '' AFTER recurrence_parent_id,
+	ADD INDEX index_user_id_position (user_id, position);
+-- NOTE: 既存のTodoは作成順(id順)に並ぶよう、idを36進数の固定長にした値を順位とする
+UPDATE todos SET position = CONCAT(LPAD(LOWER(CONV(id, 10, 36)), 8, '0'), 'i');
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP INDEX index_user_id_position,
+	DROP COLUMN position;
@@ -0,0 +1,10 @@
+
+-- +migrate Up
+ALTER TABLE todos
+	ADD COLUMN deleted_at DATETIME AFTER updated_at,
+	ADD INDEX index_deleted_at (deleted_at);
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP INDEX index_deleted_at,
+	DROP COLUMN deleted_at;
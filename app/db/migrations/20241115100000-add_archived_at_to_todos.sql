@@ -0,0 +1,10 @@
+
+-- +migrate Up
+ALTER TABLE todos
+	ADD COLUMN archived_at DATETIME AFTER completed_at,
+	ADD INDEX index_user_id_archived_at (user_id, archived_at);
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP INDEX index_user_id_archived_at,
+	DROP COLUMN archived_at;
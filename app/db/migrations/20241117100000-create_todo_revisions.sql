@@ -0,0 +1,16 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS todo_revisions(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_id INT NOT NULL,
+	actor_id INT,
+	action VARCHAR(20) NOT NULL,
+	changes JSON NOT NULL,
+	created_at DATETIME NOT NULL,
+	index index_todo_id (todo_id),
+	CONSTRAINT fk_todo_revisions_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+	CONSTRAINT fk_todo_revisions_users FOREIGN KEY (actor_id) REFERENCES users (id) ON DELETE SET NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_revisions;
@@ -0,0 +1,8 @@
+
+-- +migrate Up
+ALTER TABLE todos
+	ADD COLUMN version INT NOT NULL DEFAULT 1 AFTER position;
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP COLUMN version;
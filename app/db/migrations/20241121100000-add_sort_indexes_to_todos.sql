@@ -0,0 +1,14 @@
+
+-- +migrate Up
+ALTER TABLE todos
+	ADD INDEX index_user_id_created_at (user_id, created_at),
+	ADD INDEX index_user_id_updated_at (user_id, updated_at),
+	ADD INDEX index_user_id_due_date (user_id, due_date),
+	ADD INDEX index_user_id_title (user_id, title);
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP INDEX index_user_id_created_at,
+	DROP INDEX index_user_id_updated_at,
+	DROP INDEX index_user_id_due_date,
+	DROP INDEX index_user_id_title;
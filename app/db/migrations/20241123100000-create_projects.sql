@@ -0,0 +1,22 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS projects(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id INT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_user_id (user_id),
+	CONSTRAINT fk_projects_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+ALTER TABLE todos
+	ADD COLUMN project_id INT AFTER user_id,
+	ADD CONSTRAINT fk_todos_projects FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP FOREIGN KEY fk_todos_projects,
+	DROP COLUMN project_id;
+
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,10 @@
+
+-- +migrate Up
+ALTER TABLE todos
+	ADD COLUMN priority TINYINT NOT NULL DEFAULT 0 AFTER content,
+	ADD INDEX index_user_id_priority (user_id, priority);
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP INDEX index_user_id_priority,
+	DROP COLUMN priority;
@@ -0,0 +1,14 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS todo_tags(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_id INT NOT NULL,
+	name VARCHAR(50) NOT NULL,
+	created_at DATETIME NOT NULL,
+	UNIQUE index index_todo_id_name (todo_id, name),
+	index index_name (name),
+	CONSTRAINT fk_todo_tags_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_tags;
@@ -0,0 +1,6 @@
+
+-- +migrate Up
+ALTER TABLE todos ADD FULLTEXT INDEX index_title_content (title, content) WITH PARSER ngram;
+
+-- +migrate Down
+ALTER TABLE todos DROP INDEX index_title_content;
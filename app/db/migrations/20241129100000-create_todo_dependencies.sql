@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS todo_dependencies(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_id INT NOT NULL,
+	blocker_id INT NOT NULL,
+	created_at DATETIME NOT NULL,
+	UNIQUE index index_todo_id_blocker_id (todo_id, blocker_id),
+	index index_blocker_id (blocker_id),
+	CONSTRAINT fk_todo_dependencies_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+	CONSTRAINT fk_todo_dependencies_blockers FOREIGN KEY (blocker_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_dependencies;
@@ -0,0 +1,37 @@
+-- +migrate Up
+-- NOTE: boardsとするとsqlboilerが生成するカラム名の定義(BoardColumns)がboard_columnsのモデルと衝突するため、kanban_boardsとする
+CREATE TABLE IF NOT EXISTS kanban_boards(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	project_id INT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE index index_project_id (project_id),
+	CONSTRAINT fk_kanban_boards_projects FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS board_columns(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	board_id INT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	status VARCHAR(20),
+	wip_limit INT,
+	position VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_board_id_position (board_id, position),
+	CONSTRAINT fk_board_columns_boards FOREIGN KEY (board_id) REFERENCES kanban_boards (id) ON DELETE CASCADE
+);
+
+ALTER TABLE todos
+	ADD COLUMN board_column_id INT AFTER project_id,
+	ADD COLUMN board_position VARCHAR(255) CHARACTER SET ascii COLLATE ascii_bin AFTER position,
+	ADD CONSTRAINT fk_todos_board_columns FOREIGN KEY (board_column_id) REFERENCES board_columns (id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP FOREIGN KEY fk_todos_board_columns,
+	DROP COLUMN board_position,
+	DROP COLUMN board_column_id;
+
+DROP TABLE IF EXISTS board_columns;
+DROP TABLE IF EXISTS kanban_boards;
@@ -0,0 +1,32 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS todo_templates(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id INT NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	content TEXT,
+	priority TINYINT NOT NULL DEFAULT 0,
+	due_offset_days INT,
+	tags JSON NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE index index_user_id_name (user_id, name),
+	CONSTRAINT fk_todo_templates_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE TABLE IF NOT EXISTS todo_template_subtasks(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_template_id INT NOT NULL,
+	title VARCHAR(255) NOT NULL,
+	content TEXT,
+	due_offset_days INT,
+	sort_order INT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_todo_template_id_sort_order (todo_template_id, sort_order),
+	CONSTRAINT fk_todo_template_subtasks_todo_templates FOREIGN KEY (todo_template_id) REFERENCES todo_templates (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_template_subtasks;
+DROP TABLE IF EXISTS todo_templates;
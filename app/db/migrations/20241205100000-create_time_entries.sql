@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS time_entries(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id INT NOT NULL,
+	todo_id INT NOT NULL,
+	started_at DATETIME NOT NULL,
+	ended_at DATETIME,
+	duration_seconds INT,
+	note VARCHAR(255),
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_user_id_ended_at (user_id, ended_at),
+	index index_user_id_started_at (user_id, started_at),
+	index index_todo_id (todo_id),
+	CONSTRAINT fk_time_entries_users FOREIGN KEY (user_id) REFERENCES users (id),
+	CONSTRAINT fk_time_entries_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS time_entries;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE todos
+	ADD COLUMN estimate_minutes INT AFTER priority;
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP COLUMN estimate_minutes;
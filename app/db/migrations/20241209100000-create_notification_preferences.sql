@@ -0,0 +1,22 @@
+-- +migrate Up
+-- NOTE: 期日が近いTodoの通知など、操作したユーザのいない通知を作成できるようにする
+ALTER TABLE notifications
+	MODIFY COLUMN actor_id INT;
+
+CREATE TABLE IF NOT EXISTS notification_preferences(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id INT NOT NULL,
+	event_type VARCHAR(50) NOT NULL,
+	enabled BOOLEAN NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE index index_user_id_event_type (user_id, event_type),
+	CONSTRAINT fk_notification_preferences_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS notification_preferences;
+
+DELETE FROM notifications WHERE actor_id IS NULL;
+ALTER TABLE notifications
+	MODIFY COLUMN actor_id INT NOT NULL;
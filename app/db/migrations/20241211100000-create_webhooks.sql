@@ -0,0 +1,34 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS webhooks(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id INT NOT NULL,
+	url VARCHAR(2048) NOT NULL,
+	events JSON NOT NULL,
+	secret VARCHAR(255) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_user_id (user_id),
+	CONSTRAINT fk_webhooks_users FOREIGN KEY (user_id) REFERENCES users (id)
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	webhook_id INT NOT NULL,
+	event VARCHAR(50) NOT NULL,
+	payload JSON NOT NULL,
+	status VARCHAR(20) NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	next_attempt_at DATETIME NOT NULL,
+	response_status INT,
+	last_error TEXT,
+	delivered_at DATETIME,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	index index_status_next_attempt_at (status, next_attempt_at),
+	index index_webhook_id (webhook_id),
+	CONSTRAINT fk_webhook_deliveries_webhooks FOREIGN KEY (webhook_id) REFERENCES webhooks (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS activities(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id INT NOT NULL,
+	actor_id INT NOT NULL,
+	verb VARCHAR(50) NOT NULL,
+	todo_id INT,
+	project_id INT,
+	todo_comment_id INT,
+	created_at DATETIME NOT NULL,
+	index index_user_id_id (user_id, id),
+	index index_project_id_id (project_id, id),
+	CONSTRAINT fk_activities_users FOREIGN KEY (user_id) REFERENCES users (id),
+	CONSTRAINT fk_activities_actors FOREIGN KEY (actor_id) REFERENCES users (id),
+	CONSTRAINT fk_activities_todos FOREIGN KEY (todo_id) REFERENCES todos (id) ON DELETE CASCADE,
+	CONSTRAINT fk_activities_projects FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE SET NULL,
+	CONSTRAINT fk_activities_todo_comments FOREIGN KEY (todo_comment_id) REFERENCES todo_comments (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS activities;
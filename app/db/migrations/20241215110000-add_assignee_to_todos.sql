@@ -0,0 +1,10 @@
+-- +migrate Up
+-- NOTE: 担当者はTodoの所有者、またはTodoを共有した共同作業者から選ぶ
+ALTER TABLE todos
+	ADD COLUMN assignee_id INT AFTER user_id,
+	ADD CONSTRAINT fk_todos_assignees FOREIGN KEY (assignee_id) REFERENCES users (id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE todos
+	DROP FOREIGN KEY fk_todos_assignees,
+	DROP COLUMN assignee_id;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- NOTE: 送信中のリマインダーを他のサーバが重複して送信しないよう、確保した期限を記録する
+ALTER TABLE todo_reminders
+	ADD COLUMN lease_expires_at DATETIME AFTER last_error;
+
+-- NOTE: 一部の配信先のみ失敗した場合に、配信済みの配信先へ再送しないよう配信先ごとに記録する
+CREATE TABLE IF NOT EXISTS todo_reminder_deliveries(
+	id INT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	todo_reminder_id INT NOT NULL,
+	channel VARCHAR(50) NOT NULL,
+	sent_at DATETIME NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE index index_todo_reminder_id_channel (todo_reminder_id, channel),
+	CONSTRAINT fk_todo_reminder_deliveries_todo_reminders FOREIGN KEY (todo_reminder_id) REFERENCES todo_reminders (id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS todo_reminder_deliveries;
+
+ALTER TABLE todo_reminders
+	DROP COLUMN lease_expires_at;
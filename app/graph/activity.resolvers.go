@@ -0,0 +1,92 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/services"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Verb is the resolver for the verb field.
+func (r *activityResolver) Verb(ctx context.Context, obj *models.Activity) (model.ActivityVerb, error) {
+	return services.ActivityVerbFromType(obj.Verb), nil
+}
+
+// Actor is the resolver for the actor field.
+func (r *activityResolver) Actor(ctx context.Context, obj *models.Activity) (*models.User, error) {
+	// NOTE: アクティビティはサービスで読み込む際に関連を設定済み
+	if obj.R != nil && obj.R.Actor != nil {
+		return obj.R.Actor, nil
+	}
+
+	return r.authService.Getuser(ctx, obj.ActorID), nil
+}
+
+// Todo is the resolver for the todo field.
+func (r *activityResolver) Todo(ctx context.Context, obj *models.Activity) (*models.Todo, error) {
+	// NOTE: 削除されたTodoは空とする
+	if obj.R == nil {
+		return nil, nil
+	}
+	return obj.R.Todo, nil
+}
+
+// Project is the resolver for the project field.
+func (r *activityResolver) Project(ctx context.Context, obj *models.Activity) (*models.Project, error) {
+	if obj.R == nil {
+		return nil, nil
+	}
+	return obj.R.Project, nil
+}
+
+// Comment is the resolver for the comment field.
+func (r *activityResolver) Comment(ctx context.Context, obj *models.Activity) (*models.TodoComment, error) {
+	if obj.R == nil {
+		return nil, nil
+	}
+	return obj.R.TodoComment, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *activityResolver) CreatedAt(ctx context.Context, obj *models.Activity) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// ActivityFeed is the resolver for the activityFeed field.
+func (r *queryResolver) ActivityFeed(ctx context.Context, projectID string, first *int, after *string) (*model.ActivityConnection, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.ActivityConnection{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intProjectID, _ := strconv.Atoi(projectID)
+	return r.activityService.FetchActivityFeed(ctx, intProjectID, user.ID, first, after)
+}
+
+// MyActivity is the resolver for the myActivity field.
+func (r *queryResolver) MyActivity(ctx context.Context, first *int, after *string) (*model.ActivityConnection, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.ActivityConnection{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.activityService.FetchMyActivity(ctx, user.ID, first, after)
+}
+
+// Activity returns generated.ActivityResolver implementation.
+func (r *Resolver) Activity() generated.ActivityResolver { return &activityResolver{r} }
+
+// Query returns generated.QueryResolver implementation.
+func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
+
+type activityResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
@@ -0,0 +1,62 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ArchiveTodo is the resolver for the archiveTodo field.
+func (r *mutationResolver) ArchiveTodo(ctx context.Context, id string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.ArchiveTodo(ctx, intID, user.ID)
+}
+
+// UnarchiveTodo is the resolver for the unarchiveTodo field.
+func (r *mutationResolver) UnarchiveTodo(ctx context.Context, id string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.UnarchiveTodo(ctx, intID, user.ID)
+}
+
+// ArchiveCompletedTodos is the resolver for the archiveCompletedTodos field.
+func (r *mutationResolver) ArchiveCompletedTodos(ctx context.Context) (int, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return 0, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.ArchiveCompletedTodos(ctx, user.ID)
+}
+
+// ArchivedAt is the resolver for the archivedAt field.
+func (r *todoResolver) ArchivedAt(ctx context.Context, obj *models.Todo) (*string, error) {
+	if !obj.ArchivedAt.Valid {
+		return nil, nil
+	}
+
+	archivedAt := obj.ArchivedAt.Time.Format("2006-01-02 15:04:05")
+	return &archivedAt, nil
+}
+
+// Mutation returns generated.MutationResolver implementation.
+func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
+
+type mutationResolver struct{ *Resolver }
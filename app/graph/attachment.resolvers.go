@@ -0,0 +1,59 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DownloadURL is the resolver for the downloadUrl field.
+func (r *attachmentResolver) DownloadURL(ctx context.Context, obj *models.Attachment) (string, error) {
+	return r.attachmentService.SignedDownloadURL(obj), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *attachmentResolver) CreatedAt(ctx context.Context, obj *models.Attachment) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// AttachFile is the resolver for the attachFile field.
+func (r *mutationResolver) AttachFile(ctx context.Context, todoID string, file graphql.Upload) (*models.Attachment, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Attachment{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTodoID, _ := strconv.Atoi(todoID)
+	return r.attachmentService.AttachFile(ctx, intTodoID, file, user.ID)
+}
+
+// RemoveAttachment is the resolver for the removeAttachment field.
+func (r *mutationResolver) RemoveAttachment(ctx context.Context, id string) (string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return id, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.attachmentService.RemoveAttachment(ctx, intID, user.ID)
+}
+
+// Attachments is the resolver for the attachments field.
+func (r *todoResolver) Attachments(ctx context.Context, obj *models.Todo) ([]*models.Attachment, error) {
+	return r.attachmentService.FetchAttachments(ctx, obj.ID)
+}
+
+// Attachment returns generated.AttachmentResolver implementation.
+func (r *Resolver) Attachment() generated.AttachmentResolver { return &attachmentResolver{r} }
+
+type attachmentResolver struct{ *Resolver }
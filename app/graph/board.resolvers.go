@@ -0,0 +1,132 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// Project is the resolver for the project field.
+func (r *boardResolver) Project(ctx context.Context, obj *models.KanbanBoard) (*models.Project, error) {
+	// NOTE: ボードはサービスで読み込む際にプロジェクトと列を設定済み
+	if obj.R == nil {
+		return &models.Project{}, nil
+	}
+	return obj.R.Project, nil
+}
+
+// Columns is the resolver for the columns field.
+func (r *boardResolver) Columns(ctx context.Context, obj *models.KanbanBoard) ([]*models.BoardColumn, error) {
+	if obj.R == nil {
+		return models.BoardColumnSlice{}, nil
+	}
+	return obj.R.BoardBoardColumns, nil
+}
+
+// Status is the resolver for the status field.
+func (r *boardColumnResolver) Status(ctx context.Context, obj *models.BoardColumn) (*model.TodoStatus, error) {
+	if !obj.Status.Valid {
+		return nil, nil
+	}
+
+	status := model.TodoStatus(obj.Status.String)
+	return &status, nil
+}
+
+// WipLimit is the resolver for the wipLimit field.
+func (r *boardColumnResolver) WipLimit(ctx context.Context, obj *models.BoardColumn) (*int, error) {
+	return obj.WipLimit.Ptr(), nil
+}
+
+// Todos is the resolver for the todos field.
+func (r *boardColumnResolver) Todos(ctx context.Context, obj *models.BoardColumn) ([]*models.Todo, error) {
+	if obj.R == nil {
+		return models.TodoSlice{}, nil
+	}
+	return obj.R.Todos, nil
+}
+
+// CreateBoard is the resolver for the createBoard field.
+func (r *mutationResolver) CreateBoard(ctx context.Context, projectID string) (*models.KanbanBoard, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.KanbanBoard{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intProjectID, _ := strconv.Atoi(projectID)
+	return r.boardService.CreateBoard(ctx, intProjectID, user.ID)
+}
+
+// AddBoardColumn is the resolver for the addBoardColumn field.
+func (r *mutationResolver) AddBoardColumn(ctx context.Context, boardID string, input model.BoardColumnInput) (*models.KanbanBoard, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.KanbanBoard{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intBoardID, _ := strconv.Atoi(boardID)
+	return r.boardService.AddBoardColumn(ctx, intBoardID, input, user.ID)
+}
+
+// UpdateBoardColumn is the resolver for the updateBoardColumn field.
+func (r *mutationResolver) UpdateBoardColumn(ctx context.Context, id string, input model.BoardColumnInput) (*models.KanbanBoard, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.KanbanBoard{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.boardService.UpdateBoardColumn(ctx, intID, input, user.ID)
+}
+
+// DeleteBoardColumn is the resolver for the deleteBoardColumn field.
+func (r *mutationResolver) DeleteBoardColumn(ctx context.Context, id string) (*models.KanbanBoard, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.KanbanBoard{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.boardService.DeleteBoardColumn(ctx, intID, user.ID)
+}
+
+// MoveCard is the resolver for the moveCard field.
+func (r *mutationResolver) MoveCard(ctx context.Context, todoID string, columnID string, position int) (*models.KanbanBoard, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.KanbanBoard{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTodoID, _ := strconv.Atoi(todoID)
+	intColumnID, _ := strconv.Atoi(columnID)
+	return r.boardService.MoveCard(ctx, intTodoID, intColumnID, position, user.ID)
+}
+
+// Board is the resolver for the board field.
+func (r *queryResolver) Board(ctx context.Context, projectID string) (*models.KanbanBoard, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return nil, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intProjectID, _ := strconv.Atoi(projectID)
+	return r.boardService.FetchBoard(ctx, intProjectID, user.ID)
+}
+
+// Board returns generated.BoardResolver implementation.
+func (r *Resolver) Board() generated.BoardResolver { return &boardResolver{r} }
+
+// BoardColumn returns generated.BoardColumnResolver implementation.
+func (r *Resolver) BoardColumn() generated.BoardColumnResolver { return &boardColumnResolver{r} }
+
+type boardResolver struct{ *Resolver }
+type boardColumnResolver struct{ *Resolver }
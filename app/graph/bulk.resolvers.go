@@ -0,0 +1,53 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/model"
+	"app/lib/auth"
+	"app/view"
+	"context"
+	"fmt"
+)
+
+// BulkCreateTodos is the resolver for the bulkCreateTodos field.
+func (r *mutationResolver) BulkCreateTodos(ctx context.Context, inputs []*model.CreateTodoInput) (*model.BulkTodoPayload, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.BulkTodoPayload{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.BulkCreateTodos(ctx, inputs, user.ID)
+}
+
+// BulkUpdateTodos is the resolver for the bulkUpdateTodos field.
+func (r *mutationResolver) BulkUpdateTodos(ctx context.Context, ids []string, patch model.BulkTodoPatch) (*model.BulkTodoPayload, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.BulkTodoPayload{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.BulkUpdateTodos(ctx, intIDs(ids), patch, user.ID)
+}
+
+// BulkDeleteTodos is the resolver for the bulkDeleteTodos field.
+func (r *mutationResolver) BulkDeleteTodos(ctx context.Context, ids []string) (*model.BulkTodoPayload, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.BulkTodoPayload{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.BulkDeleteTodos(ctx, intIDs(ids), user.ID)
+}
+
+// BulkCompleteTodos is the resolver for the bulkCompleteTodos field.
+func (r *mutationResolver) BulkCompleteTodos(ctx context.Context, ids []string, force *bool) (*model.BulkTodoPayload, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.BulkTodoPayload{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.BulkCompleteTodos(ctx, intIDs(ids), force != nil && *force, user.ID)
+}
@@ -0,0 +1,73 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// AddComment is the resolver for the addComment field.
+func (r *mutationResolver) AddComment(ctx context.Context, input model.AddCommentInput) (*models.TodoComment, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TodoComment{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.commentService.AddComment(ctx, input, user.ID)
+}
+
+// EditComment is the resolver for the editComment field.
+func (r *mutationResolver) EditComment(ctx context.Context, id string, input model.EditCommentInput) (*models.TodoComment, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TodoComment{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.commentService.EditComment(ctx, intID, input, user.ID)
+}
+
+// DeleteComment is the resolver for the deleteComment field.
+func (r *mutationResolver) DeleteComment(ctx context.Context, id string) (string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return id, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.commentService.DeleteComment(ctx, intID, user.ID)
+}
+
+// Comments is the resolver for the comments field.
+func (r *todoResolver) Comments(ctx context.Context, obj *models.Todo, first *int, after *string) (*model.TodoCommentConnection, error) {
+	return r.commentService.FetchComments(ctx, obj.ID, first, after)
+}
+
+// Author is the resolver for the author field.
+func (r *todoCommentResolver) Author(ctx context.Context, obj *models.TodoComment) (*models.User, error) {
+	return r.authService.Getuser(ctx, obj.UserID), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *todoCommentResolver) CreatedAt(ctx context.Context, obj *models.TodoComment) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *todoCommentResolver) UpdatedAt(ctx context.Context, obj *models.TodoComment) (string, error) {
+	return obj.UpdatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// TodoComment returns generated.TodoCommentResolver implementation.
+func (r *Resolver) TodoComment() generated.TodoCommentResolver { return &todoCommentResolver{r} }
+
+type todoCommentResolver struct{ *Resolver }
@@ -0,0 +1,23 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/model"
+	"app/lib/auth"
+	"app/view"
+	"context"
+	"fmt"
+)
+
+// Todos is the resolver for the todos field.
+func (r *queryResolver) Todos(ctx context.Context, first *int, after *string, last *int, before *string, filter *model.TodoFilter, orderBy *model.TodoOrder, includeArchived *bool) (*model.TodoConnection, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.TodoConnection{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.FetchTodos(ctx, user.ID, first, after, last, before, filter, orderBy, includeArchived != nil && *includeArchived)
+}
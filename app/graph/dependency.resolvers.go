@@ -0,0 +1,48 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// AddTodoDependency is the resolver for the addTodoDependency field.
+func (r *mutationResolver) AddTodoDependency(ctx context.Context, todoID string, blockerID string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTodoID, _ := strconv.Atoi(todoID)
+	intBlockerID, _ := strconv.Atoi(blockerID)
+	return r.todoService.AddTodoDependency(ctx, intTodoID, intBlockerID, user.ID)
+}
+
+// RemoveTodoDependency is the resolver for the removeTodoDependency field.
+func (r *mutationResolver) RemoveTodoDependency(ctx context.Context, todoID string, blockerID string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTodoID, _ := strconv.Atoi(todoID)
+	intBlockerID, _ := strconv.Atoi(blockerID)
+	return r.todoService.RemoveTodoDependency(ctx, intTodoID, intBlockerID, user.ID)
+}
+
+// BlockedBy is the resolver for the blockedBy field.
+func (r *todoResolver) BlockedBy(ctx context.Context, obj *models.Todo) ([]*models.Todo, error) {
+	return r.todoService.FetchBlockers(ctx, obj.ID)
+}
+
+// Blocking is the resolver for the blocking field.
+func (r *todoResolver) Blocking(ctx context.Context, obj *models.Todo) ([]*models.Todo, error) {
+	return r.todoService.FetchBlockedTodos(ctx, obj.ID)
+}
@@ -0,0 +1,27 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/services"
+	"context"
+)
+
+// Status is the resolver for the status field.
+func (r *todoResolver) Status(ctx context.Context, obj *models.Todo) (model.TodoStatus, error) {
+	return services.TodoStatusOf(obj), nil
+}
+
+// Priority is the resolver for the priority field.
+func (r *todoResolver) Priority(ctx context.Context, obj *models.Todo) (model.TodoPriority, error) {
+	return services.TodoPriorityFromValue(obj.Priority), nil
+}
+
+// Tags is the resolver for the tags field.
+func (r *todoResolver) Tags(ctx context.Context, obj *models.Todo) ([]string, error) {
+	return r.todoService.FetchTodoTags(ctx, obj.ID)
+}
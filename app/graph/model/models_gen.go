@@ -2,17 +2,189 @@
 
 package model
 
+import (
+	models "app/models/generated"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type NotificationPayload interface {
+	IsNotificationPayload()
+}
+
+type ActivityConnection struct {
+	Edges      []*ActivityEdge `json:"edges"`
+	PageInfo   *PageInfo       `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+type ActivityEdge struct {
+	Cursor string           `json:"cursor"`
+	Node   *models.Activity `json:"node"`
+}
+
+type AddCommentInput struct {
+	TodoID  string `json:"todoId"`
+	Content string `json:"content"`
+}
+
+type AddReminderInput struct {
+	TodoID   string `json:"todoId"`
+	RemindAt string `json:"remindAt"`
+}
+
+type AssignedPayload struct {
+	Todo *models.Todo `json:"todo"`
+}
+
+func (AssignedPayload) IsNotificationPayload() {}
+
+type BoardColumnInput struct {
+	Name     string      `json:"name"`
+	Status   *TodoStatus `json:"status,omitempty"`
+	WipLimit *int        `json:"wipLimit,omitempty"`
+}
+
+type BulkTodoItemResult struct {
+	Index int          `json:"index"`
+	ID    *string      `json:"id,omitempty"`
+	Todo  *models.Todo `json:"todo,omitempty"`
+	Code  *int         `json:"code,omitempty"`
+	Error *string      `json:"error,omitempty"`
+}
+
+type BulkTodoPatch struct {
+	Title      graphql.Omittable[*string]          `json:"title,omitempty"`
+	Content    graphql.Omittable[*string]          `json:"content,omitempty"`
+	DueDate    graphql.Omittable[*string]          `json:"dueDate,omitempty"`
+	Recurrence graphql.Omittable[*RecurrenceInput] `json:"recurrence,omitempty"`
+}
+
+type BulkTodoPayload struct {
+	Results        []*BulkTodoItemResult `json:"results"`
+	SucceededCount int                   `json:"succeededCount"`
+	FailedCount    int                   `json:"failedCount"`
+}
+
+type CompleteTodoPayload struct {
+	Todo           *models.Todo `json:"todo"`
+	NextOccurrence *models.Todo `json:"nextOccurrence,omitempty"`
+}
+
+type CreateProjectInput struct {
+	Name string `json:"name"`
+}
+
+type CreateSavedViewInput struct {
+	Name    string      `json:"name"`
+	Filter  *TodoFilter `json:"filter"`
+	OrderBy *TodoOrder  `json:"orderBy,omitempty"`
+}
+
 type CreateTodoInput struct {
-	Title   string `json:"title"`
+	Title           string           `json:"title"`
+	Content         string           `json:"content"`
+	DueDate         *string          `json:"dueDate,omitempty"`
+	Recurrence      *RecurrenceInput `json:"recurrence,omitempty"`
+	RemindAt        *string          `json:"remindAt,omitempty"`
+	Priority        *TodoPriority    `json:"priority,omitempty"`
+	EstimateMinutes *int             `json:"estimateMinutes,omitempty"`
+	ProjectID       *string          `json:"projectId,omitempty"`
+	Tags            []string         `json:"tags,omitempty"`
+}
+
+type CreateTodoTemplateInput struct {
+	Name          string                      `json:"name"`
+	Title         string                      `json:"title"`
+	Content       *string                     `json:"content,omitempty"`
+	Priority      *TodoPriority               `json:"priority,omitempty"`
+	DueOffsetDays *int                        `json:"dueOffsetDays,omitempty"`
+	Tags          []string                    `json:"tags,omitempty"`
+	Subtasks      []*TodoTemplateSubtaskInput `json:"subtasks,omitempty"`
+}
+
+type DailyCompletionCount struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+type DateRange struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+type DateTimeRange struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+type DueSoonPayload struct {
+	Todo    *models.Todo `json:"todo"`
+	DueDate string       `json:"dueDate"`
+}
+
+func (DueSoonPayload) IsNotificationPayload() {}
+
+type EditCommentInput struct {
 	Content string `json:"content"`
 }
 
+type MentionedPayload struct {
+	Todo    *models.Todo        `json:"todo"`
+	Comment *models.TodoComment `json:"comment"`
+}
+
+func (MentionedPayload) IsNotificationPayload() {}
+
 type Mutation struct {
 }
 
+type NotificationConnection struct {
+	Edges       []*NotificationEdge `json:"edges"`
+	PageInfo    *PageInfo           `json:"pageInfo"`
+	TotalCount  int                 `json:"totalCount"`
+	UnreadCount int                 `json:"unreadCount"`
+}
+
+type NotificationEdge struct {
+	Cursor string               `json:"cursor"`
+	Node   *models.Notification `json:"node"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
+}
+
 type Query struct {
 }
 
+type Recurrence struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  int                 `json:"interval"`
+	Weekdays  []Weekday           `json:"weekdays"`
+	MonthDay  *int                `json:"monthDay,omitempty"`
+	Rule      string              `json:"rule"`
+}
+
+type RecurrenceInput struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  *int                `json:"interval,omitempty"`
+	Weekdays  []Weekday           `json:"weekdays,omitempty"`
+	MonthDay  *int                `json:"monthDay,omitempty"`
+}
+
+type SharedPayload struct {
+	Todo *models.Todo `json:"todo"`
+}
+
+func (SharedPayload) IsNotificationPayload() {}
+
 type SignInInput struct {
 	Email    string `json:"Email"`
 	Password string `json:"Password"`
@@ -24,7 +196,684 @@ type SignUpInput struct {
 	Password string `json:"Password"`
 }
 
+type Subscription struct {
+}
+
+type TimeReport struct {
+	GroupBy      TimeReportGroupBy `json:"groupBy"`
+	Rows         []*TimeReportRow  `json:"rows"`
+	TotalSeconds int               `json:"totalSeconds"`
+}
+
+type TimeReportRow struct {
+	Key          string `json:"key"`
+	Label        string `json:"label"`
+	TotalSeconds int    `json:"totalSeconds"`
+}
+
+type TodoChangeEvent struct {
+	Action TodoChangeAction `json:"action"`
+	Todo   *models.Todo     `json:"todo"`
+}
+
+type TodoCommentConnection struct {
+	Edges      []*TodoCommentEdge `json:"edges"`
+	PageInfo   *PageInfo          `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+type TodoCommentEdge struct {
+	Cursor string              `json:"cursor"`
+	Node   *models.TodoComment `json:"node"`
+}
+
+type TodoConnection struct {
+	Edges      []*TodoEdge `json:"edges"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+type TodoEdge struct {
+	Cursor string       `json:"cursor"`
+	Node   *models.Todo `json:"node"`
+}
+
+type TodoFieldChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"oldValue,omitempty"`
+	NewValue *string `json:"newValue,omitempty"`
+}
+
+type TodoFilter struct {
+	TitleContains   *string        `json:"titleContains,omitempty"`
+	ContentContains *string        `json:"contentContains,omitempty"`
+	Statuses        []TodoStatus   `json:"statuses,omitempty"`
+	Priorities      []TodoPriority `json:"priorities,omitempty"`
+	CreatedAt       *DateTimeRange `json:"createdAt,omitempty"`
+	UpdatedAt       *DateTimeRange `json:"updatedAt,omitempty"`
+	DueDate         *DateRange     `json:"dueDate,omitempty"`
+	Tags            []string       `json:"tags,omitempty"`
+	ProjectID       *string        `json:"projectId,omitempty"`
+	HasAttachments  *bool          `json:"hasAttachments,omitempty"`
+}
+
+type TodoOrder struct {
+	Field     TodoOrderField `json:"field"`
+	Direction OrderDirection `json:"direction"`
+}
+
+type TodoSearchConnection struct {
+	Edges      []*TodoSearchEdge `json:"edges"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+type TodoSearchEdge struct {
+	Cursor         string       `json:"cursor"`
+	Node           *models.Todo `json:"node"`
+	Score          float64      `json:"score"`
+	TitleHighlight string       `json:"titleHighlight"`
+	ContentSnippet *string      `json:"contentSnippet,omitempty"`
+}
+
+type TodoStats struct {
+	StatusCounts             []*TodoStatusCount      `json:"statusCounts"`
+	OverdueCount             int                     `json:"overdueCount"`
+	CompletedPerDay          []*DailyCompletionCount `json:"completedPerDay"`
+	AverageCompletionSeconds *int                    `json:"averageCompletionSeconds,omitempty"`
+	CurrentStreak            int                     `json:"currentStreak"`
+	LongestStreak            int                     `json:"longestStreak"`
+}
+
+type TodoStatusCount struct {
+	Status TodoStatus `json:"status"`
+	Count  int        `json:"count"`
+}
+
+type TodoTemplateOverrides struct {
+	Title     *string       `json:"title,omitempty"`
+	Content   *string       `json:"content,omitempty"`
+	Priority  *TodoPriority `json:"priority,omitempty"`
+	ProjectID *string       `json:"projectId,omitempty"`
+	StartDate *string       `json:"startDate,omitempty"`
+}
+
+type TodoTemplateSubtaskInput struct {
+	Title         string  `json:"title"`
+	Content       *string `json:"content,omitempty"`
+	DueOffsetDays *int    `json:"dueOffsetDays,omitempty"`
+}
+
+type UpdateProjectInput struct {
+	Name string `json:"name"`
+}
+
 type UpdateTodoInput struct {
-	Title   string `json:"title"`
-	Content string `json:"content"`
+	Title           graphql.Omittable[*string]          `json:"title,omitempty"`
+	Content         graphql.Omittable[*string]          `json:"content,omitempty"`
+	DueDate         graphql.Omittable[*string]          `json:"dueDate,omitempty"`
+	Recurrence      graphql.Omittable[*RecurrenceInput] `json:"recurrence,omitempty"`
+	Priority        graphql.Omittable[*TodoPriority]    `json:"priority,omitempty"`
+	EstimateMinutes graphql.Omittable[*int]             `json:"estimateMinutes,omitempty"`
+	ProjectID       graphql.Omittable[*string]          `json:"projectId,omitempty"`
+	Tags            graphql.Omittable[[]string]         `json:"tags,omitempty"`
+	ExpectedVersion int                                 `json:"expectedVersion"`
+}
+
+type WebhookDeliveryConnection struct {
+	Edges      []*WebhookDeliveryEdge `json:"edges"`
+	PageInfo   *PageInfo              `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+type WebhookDeliveryEdge struct {
+	Cursor string                  `json:"cursor"`
+	Node   *models.WebhookDelivery `json:"node"`
+}
+
+type WorkloadDay struct {
+	Date            string       `json:"date"`
+	Assignee        *models.User `json:"assignee,omitempty"`
+	EstimateMinutes int          `json:"estimateMinutes"`
+	TodoCount       int          `json:"todoCount"`
+}
+
+type ActivityVerb string
+
+const (
+	ActivityVerbCreated   ActivityVerb = "CREATED"
+	ActivityVerbCompleted ActivityVerb = "COMPLETED"
+	ActivityVerbCommented ActivityVerb = "COMMENTED"
+	ActivityVerbAssigned  ActivityVerb = "ASSIGNED"
+	ActivityVerbShared    ActivityVerb = "SHARED"
+)
+
+var AllActivityVerb = []ActivityVerb{
+	ActivityVerbCreated,
+	ActivityVerbCompleted,
+	ActivityVerbCommented,
+	ActivityVerbAssigned,
+	ActivityVerbShared,
+}
+
+func (e ActivityVerb) IsValid() bool {
+	switch e {
+	case ActivityVerbCreated, ActivityVerbCompleted, ActivityVerbCommented, ActivityVerbAssigned, ActivityVerbShared:
+		return true
+	}
+	return false
+}
+
+func (e ActivityVerb) String() string {
+	return string(e)
+}
+
+func (e *ActivityVerb) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ActivityVerb(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ActivityVerb", str)
+	}
+	return nil
+}
+
+func (e ActivityVerb) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotificationEvent string
+
+const (
+	NotificationEventAssigned  NotificationEvent = "ASSIGNED"
+	NotificationEventMentioned NotificationEvent = "MENTIONED"
+	NotificationEventDueSoon   NotificationEvent = "DUE_SOON"
+	NotificationEventShared    NotificationEvent = "SHARED"
+)
+
+var AllNotificationEvent = []NotificationEvent{
+	NotificationEventAssigned,
+	NotificationEventMentioned,
+	NotificationEventDueSoon,
+	NotificationEventShared,
+}
+
+func (e NotificationEvent) IsValid() bool {
+	switch e {
+	case NotificationEventAssigned, NotificationEventMentioned, NotificationEventDueSoon, NotificationEventShared:
+		return true
+	}
+	return false
+}
+
+func (e NotificationEvent) String() string {
+	return string(e)
+}
+
+func (e *NotificationEvent) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationEvent", str)
+	}
+	return nil
+}
+
+func (e NotificationEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type OrderDirection string
+
+const (
+	OrderDirectionAsc  OrderDirection = "ASC"
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+var AllOrderDirection = []OrderDirection{
+	OrderDirectionAsc,
+	OrderDirectionDesc,
+}
+
+func (e OrderDirection) IsValid() bool {
+	switch e {
+	case OrderDirectionAsc, OrderDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e OrderDirection) String() string {
+	return string(e)
+}
+
+func (e *OrderDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDirection", str)
+	}
+	return nil
+}
+
+func (e OrderDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily           RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly          RecurrenceFrequency = "WEEKLY"
+	RecurrenceFrequencyMonthly         RecurrenceFrequency = "MONTHLY"
+	RecurrenceFrequencyAfterCompletion RecurrenceFrequency = "AFTER_COMPLETION"
+)
+
+var AllRecurrenceFrequency = []RecurrenceFrequency{
+	RecurrenceFrequencyDaily,
+	RecurrenceFrequencyWeekly,
+	RecurrenceFrequencyMonthly,
+	RecurrenceFrequencyAfterCompletion,
+}
+
+func (e RecurrenceFrequency) IsValid() bool {
+	switch e {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly, RecurrenceFrequencyAfterCompletion:
+		return true
+	}
+	return false
+}
+
+func (e RecurrenceFrequency) String() string {
+	return string(e)
+}
+
+func (e *RecurrenceFrequency) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecurrenceFrequency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecurrenceFrequency", str)
+	}
+	return nil
+}
+
+func (e RecurrenceFrequency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TimeReportGroupBy string
+
+const (
+	TimeReportGroupByTodo    TimeReportGroupBy = "TODO"
+	TimeReportGroupByProject TimeReportGroupBy = "PROJECT"
+	TimeReportGroupByDay     TimeReportGroupBy = "DAY"
+)
+
+var AllTimeReportGroupBy = []TimeReportGroupBy{
+	TimeReportGroupByTodo,
+	TimeReportGroupByProject,
+	TimeReportGroupByDay,
+}
+
+func (e TimeReportGroupBy) IsValid() bool {
+	switch e {
+	case TimeReportGroupByTodo, TimeReportGroupByProject, TimeReportGroupByDay:
+		return true
+	}
+	return false
+}
+
+func (e TimeReportGroupBy) String() string {
+	return string(e)
+}
+
+func (e *TimeReportGroupBy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TimeReportGroupBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TimeReportGroupBy", str)
+	}
+	return nil
+}
+
+func (e TimeReportGroupBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoChangeAction string
+
+const (
+	TodoChangeActionCreated TodoChangeAction = "CREATED"
+	TodoChangeActionUpdated TodoChangeAction = "UPDATED"
+	TodoChangeActionDeleted TodoChangeAction = "DELETED"
+)
+
+var AllTodoChangeAction = []TodoChangeAction{
+	TodoChangeActionCreated,
+	TodoChangeActionUpdated,
+	TodoChangeActionDeleted,
+}
+
+func (e TodoChangeAction) IsValid() bool {
+	switch e {
+	case TodoChangeActionCreated, TodoChangeActionUpdated, TodoChangeActionDeleted:
+		return true
+	}
+	return false
+}
+
+func (e TodoChangeAction) String() string {
+	return string(e)
+}
+
+func (e *TodoChangeAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoChangeAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoChangeAction", str)
+	}
+	return nil
+}
+
+func (e TodoChangeAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoOrderField string
+
+const (
+	TodoOrderFieldPosition  TodoOrderField = "POSITION"
+	TodoOrderFieldCreatedAt TodoOrderField = "CREATED_AT"
+	TodoOrderFieldUpdatedAt TodoOrderField = "UPDATED_AT"
+	TodoOrderFieldDueDate   TodoOrderField = "DUE_DATE"
+	TodoOrderFieldPriority  TodoOrderField = "PRIORITY"
+	TodoOrderFieldTitle     TodoOrderField = "TITLE"
+)
+
+var AllTodoOrderField = []TodoOrderField{
+	TodoOrderFieldPosition,
+	TodoOrderFieldCreatedAt,
+	TodoOrderFieldUpdatedAt,
+	TodoOrderFieldDueDate,
+	TodoOrderFieldPriority,
+	TodoOrderFieldTitle,
+}
+
+func (e TodoOrderField) IsValid() bool {
+	switch e {
+	case TodoOrderFieldPosition, TodoOrderFieldCreatedAt, TodoOrderFieldUpdatedAt, TodoOrderFieldDueDate, TodoOrderFieldPriority, TodoOrderFieldTitle:
+		return true
+	}
+	return false
+}
+
+func (e TodoOrderField) String() string {
+	return string(e)
+}
+
+func (e *TodoOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoOrderField", str)
+	}
+	return nil
+}
+
+func (e TodoOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoPriority string
+
+const (
+	TodoPriorityNone   TodoPriority = "NONE"
+	TodoPriorityLow    TodoPriority = "LOW"
+	TodoPriorityMedium TodoPriority = "MEDIUM"
+	TodoPriorityHigh   TodoPriority = "HIGH"
+)
+
+var AllTodoPriority = []TodoPriority{
+	TodoPriorityNone,
+	TodoPriorityLow,
+	TodoPriorityMedium,
+	TodoPriorityHigh,
+}
+
+func (e TodoPriority) IsValid() bool {
+	switch e {
+	case TodoPriorityNone, TodoPriorityLow, TodoPriorityMedium, TodoPriorityHigh:
+		return true
+	}
+	return false
+}
+
+func (e TodoPriority) String() string {
+	return string(e)
+}
+
+func (e *TodoPriority) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoPriority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoPriority", str)
+	}
+	return nil
+}
+
+func (e TodoPriority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TodoStatus string
+
+const (
+	TodoStatusOpen      TodoStatus = "OPEN"
+	TodoStatusCompleted TodoStatus = "COMPLETED"
+	TodoStatusArchived  TodoStatus = "ARCHIVED"
+)
+
+var AllTodoStatus = []TodoStatus{
+	TodoStatusOpen,
+	TodoStatusCompleted,
+	TodoStatusArchived,
+}
+
+func (e TodoStatus) IsValid() bool {
+	switch e {
+	case TodoStatusOpen, TodoStatusCompleted, TodoStatusArchived:
+		return true
+	}
+	return false
+}
+
+func (e TodoStatus) String() string {
+	return string(e)
+}
+
+func (e *TodoStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TodoStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoStatus", str)
+	}
+	return nil
+}
+
+func (e TodoStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebhookEvent string
+
+const (
+	WebhookEventTodoCreated   WebhookEvent = "TODO_CREATED"
+	WebhookEventTodoUpdated   WebhookEvent = "TODO_UPDATED"
+	WebhookEventTodoCompleted WebhookEvent = "TODO_COMPLETED"
+	WebhookEventTodoDeleted   WebhookEvent = "TODO_DELETED"
+)
+
+var AllWebhookEvent = []WebhookEvent{
+	WebhookEventTodoCreated,
+	WebhookEventTodoUpdated,
+	WebhookEventTodoCompleted,
+	WebhookEventTodoDeleted,
+}
+
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookEventTodoCreated, WebhookEventTodoUpdated, WebhookEventTodoCompleted, WebhookEventTodoDeleted:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEvent) String() string {
+	return string(e)
+}
+
+func (e *WebhookEvent) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEvent", str)
+	}
+	return nil
+}
+
+func (e WebhookEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Weekday string
+
+const (
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+	WeekdaySunday    Weekday = "SUNDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+	WeekdaySunday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday, WeekdaySunday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+func (e *Weekday) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
 }
@@ -0,0 +1,121 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/services"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// MarkNotificationRead is the resolver for the markNotificationRead field.
+func (r *mutationResolver) MarkNotificationRead(ctx context.Context, id string) (*models.Notification, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Notification{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.notificationService.MarkNotificationRead(ctx, intID, user.ID)
+}
+
+// MarkAllRead is the resolver for the markAllRead field.
+func (r *mutationResolver) MarkAllRead(ctx context.Context) (int, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return 0, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.notificationService.MarkAllRead(ctx, user.ID)
+}
+
+// UpdateNotificationPreference is the resolver for the updateNotificationPreference field.
+func (r *mutationResolver) UpdateNotificationPreference(ctx context.Context, event model.NotificationEvent, enabled bool) (*models.NotificationPreference, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.NotificationPreference{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.notificationService.UpdateNotificationPreference(ctx, event, enabled, user.ID)
+}
+
+// Event is the resolver for the event field.
+func (r *notificationResolver) Event(ctx context.Context, obj *models.Notification) (model.NotificationEvent, error) {
+	return services.NotificationEventFromType(obj.EventType), nil
+}
+
+// Actor is the resolver for the actor field.
+func (r *notificationResolver) Actor(ctx context.Context, obj *models.Notification) (*models.User, error) {
+	if obj.R != nil && obj.R.Actor != nil {
+		return obj.R.Actor, nil
+	}
+	if !obj.ActorID.Valid {
+		return nil, nil
+	}
+
+	return r.authService.Getuser(ctx, obj.ActorID.Int), nil
+}
+
+// Payload is the resolver for the payload field.
+func (r *notificationResolver) Payload(ctx context.Context, obj *models.Notification) (model.NotificationPayload, error) {
+	return r.notificationService.FetchNotificationPayload(ctx, obj)
+}
+
+// ReadAt is the resolver for the readAt field.
+func (r *notificationResolver) ReadAt(ctx context.Context, obj *models.Notification) (*string, error) {
+	if !obj.ReadAt.Valid {
+		return nil, nil
+	}
+
+	readAt := obj.ReadAt.Time.Format("2006-01-02 15:04:05")
+	return &readAt, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *notificationResolver) CreatedAt(ctx context.Context, obj *models.Notification) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// Event is the resolver for the event field.
+func (r *notificationPreferenceResolver) Event(ctx context.Context, obj *models.NotificationPreference) (model.NotificationEvent, error) {
+	return services.NotificationEventFromType(obj.EventType), nil
+}
+
+// Notifications is the resolver for the notifications field.
+func (r *queryResolver) Notifications(ctx context.Context, unreadOnly *bool, first *int, after *string) (*model.NotificationConnection, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.NotificationConnection{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.notificationService.FetchNotifications(ctx, user.ID, unreadOnly != nil && *unreadOnly, first, after)
+}
+
+// NotificationPreferences is the resolver for the notificationPreferences field.
+func (r *queryResolver) NotificationPreferences(ctx context.Context) ([]*models.NotificationPreference, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return models.NotificationPreferenceSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.notificationService.FetchNotificationPreferences(ctx, user.ID)
+}
+
+// Notification returns generated.NotificationResolver implementation.
+func (r *Resolver) Notification() generated.NotificationResolver { return &notificationResolver{r} }
+
+// NotificationPreference returns generated.NotificationPreferenceResolver implementation.
+func (r *Resolver) NotificationPreference() generated.NotificationPreferenceResolver {
+	return &notificationPreferenceResolver{r}
+}
+
+type notificationResolver struct{ *Resolver }
+type notificationPreferenceResolver struct{ *Resolver }
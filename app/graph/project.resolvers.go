@@ -0,0 +1,82 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// CreateProject is the resolver for the createProject field.
+func (r *mutationResolver) CreateProject(ctx context.Context, input model.CreateProjectInput) (*models.Project, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Project{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.projectService.CreateProject(ctx, input, user.ID)
+}
+
+// UpdateProject is the resolver for the updateProject field.
+func (r *mutationResolver) UpdateProject(ctx context.Context, id string, input model.UpdateProjectInput) (*models.Project, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Project{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.projectService.UpdateProject(ctx, intID, input, user.ID)
+}
+
+// DeleteProject is the resolver for the deleteProject field.
+func (r *mutationResolver) DeleteProject(ctx context.Context, id string) (string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return id, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.projectService.DeleteProject(ctx, intID, user.ID)
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *projectResolver) CreatedAt(ctx context.Context, obj *models.Project) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *projectResolver) UpdatedAt(ctx context.Context, obj *models.Project) (string, error) {
+	return obj.UpdatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// Projects is the resolver for the projects field.
+func (r *queryResolver) Projects(ctx context.Context) ([]*models.Project, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return models.ProjectSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.projectService.FetchProjects(ctx, user.ID)
+}
+
+// Project is the resolver for the project field.
+func (r *todoResolver) Project(ctx context.Context, obj *models.Todo) (*models.Project, error) {
+	if !obj.ProjectID.Valid {
+		return nil, nil
+	}
+
+	return r.projectService.FetchProject(ctx, obj.ProjectID.Int, obj.UserID)
+}
+
+// Project returns generated.ProjectResolver implementation.
+func (r *Resolver) Project() generated.ProjectResolver { return &projectResolver{r} }
+
+type projectResolver struct{ *Resolver }
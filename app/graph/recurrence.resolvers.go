@@ -0,0 +1,36 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/model"
+	"app/lib/auth"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// CompleteTodo is the resolver for the completeTodo field.
+func (r *mutationResolver) CompleteTodo(ctx context.Context, id string, force *bool) (*model.CompleteTodoPayload, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.CompleteTodoPayload{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.CompleteTodo(ctx, intID, force != nil && *force, user.ID)
+}
+
+// PreviewTodoOccurrences is the resolver for the previewTodoOccurrences field.
+func (r *queryResolver) PreviewTodoOccurrences(ctx context.Context, id string, count *int) ([]string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return []string{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.PreviewOccurrences(ctx, intID, count, user.ID)
+}
@@ -0,0 +1,67 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// AddReminder is the resolver for the addReminder field.
+func (r *mutationResolver) AddReminder(ctx context.Context, input model.AddReminderInput) (*models.TodoReminder, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TodoReminder{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.reminderService.AddReminder(ctx, input, user.ID)
+}
+
+// RemoveReminder is the resolver for the removeReminder field.
+func (r *mutationResolver) RemoveReminder(ctx context.Context, id string) (string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return id, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.reminderService.RemoveReminder(ctx, intID, user.ID)
+}
+
+// Reminders is the resolver for the reminders field.
+func (r *todoResolver) Reminders(ctx context.Context, obj *models.Todo) ([]*models.TodoReminder, error) {
+	return r.reminderService.FetchReminders(ctx, obj.ID)
+}
+
+// RemindAt is the resolver for the remindAt field.
+func (r *todoReminderResolver) RemindAt(ctx context.Context, obj *models.TodoReminder) (string, error) {
+	return obj.RemindAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// SentAt is the resolver for the sentAt field.
+func (r *todoReminderResolver) SentAt(ctx context.Context, obj *models.TodoReminder) (*string, error) {
+	if !obj.SentAt.Valid {
+		return nil, nil
+	}
+
+	sentAt := obj.SentAt.Time.Format("2006-01-02 15:04:05")
+	return &sentAt, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *todoReminderResolver) CreatedAt(ctx context.Context, obj *models.TodoReminder) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// TodoReminder returns generated.TodoReminderResolver implementation.
+func (r *Resolver) TodoReminder() generated.TodoReminderResolver { return &todoReminderResolver{r} }
+
+type todoReminderResolver struct{ *Resolver }
@@ -1,19 +1,65 @@
 package graph
 
-import "app/services"
+import (
+	"app/services"
+	"strconv"
+)
 
 // This file will not be regenerated automatically.
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	authService services.AuthService
-	todoService services.TodoService
+	authService         services.AuthService
+	todoService         services.TodoService
+	commentService      services.CommentService
+	attachmentService   services.AttachmentService
+	reminderService     services.ReminderService
+	projectService      services.ProjectService
+	savedViewService    services.SavedViewService
+	boardService        services.BoardService
+	todoTemplateService services.TodoTemplateService
+	timeEntryService    services.TimeEntryService
+	statsService        services.StatsService
+	notificationService services.NotificationService
+	webhookService      services.WebhookService
+	activityService     services.ActivityService
 }
 
-func NewResolver(authService services.AuthService, todoService services.TodoService) *Resolver {
+func NewResolver(authService services.AuthService, todoService services.TodoService, commentService services.CommentService, attachmentService services.AttachmentService, reminderService services.ReminderService, projectService services.ProjectService, savedViewService services.SavedViewService, boardService services.BoardService, todoTemplateService services.TodoTemplateService, timeEntryService services.TimeEntryService, statsService services.StatsService, notificationService services.NotificationService, webhookService services.WebhookService, activityService services.ActivityService) *Resolver {
 	return &Resolver{
-		authService: authService,
-		todoService: todoService,
+		authService:         authService,
+		todoService:         todoService,
+		commentService:      commentService,
+		attachmentService:   attachmentService,
+		reminderService:     reminderService,
+		projectService:      projectService,
+		savedViewService:    savedViewService,
+		boardService:        boardService,
+		todoTemplateService: todoTemplateService,
+		timeEntryService:    timeEntryService,
+		statsService:        statsService,
+		notificationService: notificationService,
+		webhookService:      webhookService,
+		activityService:     activityService,
 	}
 }
+
+// NOTE: 任意指定のID引数を数値に変換する(未指定の場合はnil)
+func optionalIntID(id *string) *int {
+	if id == nil {
+		return nil
+	}
+
+	intID, _ := strconv.Atoi(*id)
+	return &intID
+}
+
+// NOTE: ID引数のリストを数値に変換する(数値でないIDは0として扱う)
+func intIDs(ids []string) []int {
+	intIDs := make([]int, len(ids))
+	for i, id := range ids {
+		intIDs[i], _ = strconv.Atoi(id)
+	}
+	return intIDs
+}
@@ -0,0 +1,58 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/services"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// RevertTodo is the resolver for the revertTodo field.
+func (r *mutationResolver) RevertTodo(ctx context.Context, id string, revisionID string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	intRevisionID, _ := strconv.Atoi(revisionID)
+	return r.todoService.RevertTodo(ctx, intID, intRevisionID, user.ID)
+}
+
+// History is the resolver for the history field.
+func (r *todoResolver) History(ctx context.Context, obj *models.Todo) ([]*models.TodoRevision, error) {
+	return r.todoService.FetchTodoRevisions(ctx, obj.ID)
+}
+
+// Actor is the resolver for the actor field.
+func (r *todoRevisionResolver) Actor(ctx context.Context, obj *models.TodoRevision) (*models.User, error) {
+	if !obj.ActorID.Valid {
+		return nil, nil
+	}
+
+	return r.authService.Getuser(ctx, obj.ActorID.Int), nil
+}
+
+// Changes is the resolver for the changes field.
+func (r *todoRevisionResolver) Changes(ctx context.Context, obj *models.TodoRevision) ([]*model.TodoFieldChange, error) {
+	return services.TodoRevisionChanges(obj)
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *todoRevisionResolver) CreatedAt(ctx context.Context, obj *models.TodoRevision) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// TodoRevision returns generated.TodoRevisionResolver implementation.
+func (r *Resolver) TodoRevision() generated.TodoRevisionResolver { return &todoRevisionResolver{r} }
+
+type todoRevisionResolver struct{ *Resolver }
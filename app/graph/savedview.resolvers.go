@@ -0,0 +1,91 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/services"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// CreateSavedView is the resolver for the createSavedView field.
+func (r *mutationResolver) CreateSavedView(ctx context.Context, input model.CreateSavedViewInput) (*models.SavedView, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.SavedView{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.savedViewService.CreateSavedView(ctx, input, user.ID)
+}
+
+// DeleteSavedView is the resolver for the deleteSavedView field.
+func (r *mutationResolver) DeleteSavedView(ctx context.Context, id string) (string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return id, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.savedViewService.DeleteSavedView(ctx, intID, user.ID)
+}
+
+// SavedViews is the resolver for the savedViews field.
+func (r *queryResolver) SavedViews(ctx context.Context) ([]*models.SavedView, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return models.SavedViewSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.savedViewService.FetchSavedViews(ctx, user.ID)
+}
+
+// TodosForSavedView is the resolver for the todosForSavedView field.
+func (r *queryResolver) TodosForSavedView(ctx context.Context, id string, first *int, after *string, last *int, before *string) (*model.TodoConnection, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.TodoConnection{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	filter, orderBy, err := r.savedViewService.LoadSavedView(ctx, intID, user.ID)
+	if err != nil {
+		return &model.TodoConnection{}, err
+	}
+
+	return r.todoService.FetchTodos(ctx, user.ID, first, after, last, before, filter, orderBy, false)
+}
+
+// Filter is the resolver for the filter field.
+func (r *savedViewResolver) Filter(ctx context.Context, obj *models.SavedView) (*model.TodoFilter, error) {
+	filter, _, err := services.DecodeSavedView(obj)
+	return filter, err
+}
+
+// OrderBy is the resolver for the orderBy field.
+func (r *savedViewResolver) OrderBy(ctx context.Context, obj *models.SavedView) (*model.TodoOrder, error) {
+	_, orderBy, err := services.DecodeSavedView(obj)
+	return orderBy, err
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *savedViewResolver) CreatedAt(ctx context.Context, obj *models.SavedView) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *savedViewResolver) UpdatedAt(ctx context.Context, obj *models.SavedView) (string, error) {
+	return obj.UpdatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// SavedView returns generated.SavedViewResolver implementation.
+func (r *Resolver) SavedView() generated.SavedViewResolver { return &savedViewResolver{r} }
+
+type savedViewResolver struct{ *Resolver }
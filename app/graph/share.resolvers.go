@@ -0,0 +1,52 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ShareTodo is the resolver for the shareTodo field.
+func (r *mutationResolver) ShareTodo(ctx context.Context, id string, email string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.ShareTodo(ctx, intID, email, user.ID)
+}
+
+// UnshareTodo is the resolver for the unshareTodo field.
+func (r *mutationResolver) UnshareTodo(ctx context.Context, id string, userID string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	collaboratorID, _ := strconv.Atoi(userID)
+	return r.todoService.UnshareTodo(ctx, intID, collaboratorID, user.ID)
+}
+
+// SharedTodos is the resolver for the sharedTodos field.
+func (r *queryResolver) SharedTodos(ctx context.Context) ([]*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return models.TodoSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.FetchSharedTodos(ctx, user.ID)
+}
+
+// Collaborators is the resolver for the collaborators field.
+func (r *todoResolver) Collaborators(ctx context.Context, obj *models.Todo) ([]*models.User, error) {
+	return r.todoService.FetchCollaborators(ctx, obj.ID)
+}
@@ -0,0 +1,23 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/model"
+	"app/lib/auth"
+	"app/view"
+	"context"
+	"fmt"
+)
+
+// TodoStats is the resolver for the todoStats field.
+func (r *queryResolver) TodoStats(ctx context.Context, days *int) (*model.TodoStats, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.TodoStats{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.statsService.FetchTodoStats(ctx, user.ID, days)
+}
@@ -0,0 +1,41 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// TodoChanged is the resolver for the todoChanged field.
+func (r *subscriptionResolver) TodoChanged(ctx context.Context, projectID string) (<-chan *model.TodoChangeEvent, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return nil, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intProjectID, _ := strconv.Atoi(projectID)
+	return r.todoService.SubscribeTodoChanges(ctx, user.ID, &intProjectID)
+}
+
+// MyTodosChanged is the resolver for the myTodosChanged field.
+func (r *subscriptionResolver) MyTodosChanged(ctx context.Context) (<-chan *model.TodoChangeEvent, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return nil, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.SubscribeTodoChanges(ctx, user.ID, nil)
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,130 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/services"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// CreateTodoTemplate is the resolver for the createTodoTemplate field.
+func (r *mutationResolver) CreateTodoTemplate(ctx context.Context, input model.CreateTodoTemplateInput) (*models.TodoTemplate, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TodoTemplate{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoTemplateService.CreateTodoTemplate(ctx, input, user.ID)
+}
+
+// SaveTodoAsTemplate is the resolver for the saveTodoAsTemplate field.
+func (r *mutationResolver) SaveTodoAsTemplate(ctx context.Context, todoID string, name string) (*models.TodoTemplate, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TodoTemplate{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTodoID, _ := strconv.Atoi(todoID)
+	return r.todoTemplateService.SaveTodoAsTemplate(ctx, intTodoID, name, user.ID)
+}
+
+// DeleteTodoTemplate is the resolver for the deleteTodoTemplate field.
+func (r *mutationResolver) DeleteTodoTemplate(ctx context.Context, id string) (string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return id, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoTemplateService.DeleteTodoTemplate(ctx, intID, user.ID)
+}
+
+// CreateTodoFromTemplate is the resolver for the createTodoFromTemplate field.
+func (r *mutationResolver) CreateTodoFromTemplate(ctx context.Context, templateID string, overrides *model.TodoTemplateOverrides) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTemplateID, _ := strconv.Atoi(templateID)
+	return r.todoTemplateService.CreateTodoFromTemplate(ctx, intTemplateID, overrides, user.ID)
+}
+
+// TodoTemplates is the resolver for the todoTemplates field.
+func (r *queryResolver) TodoTemplates(ctx context.Context) ([]*models.TodoTemplate, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return models.TodoTemplateSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoTemplateService.FetchTodoTemplates(ctx, user.ID)
+}
+
+// Content is the resolver for the content field.
+func (r *todoTemplateResolver) Content(ctx context.Context, obj *models.TodoTemplate) (string, error) {
+	return obj.Content.String, nil
+}
+
+// Priority is the resolver for the priority field.
+func (r *todoTemplateResolver) Priority(ctx context.Context, obj *models.TodoTemplate) (model.TodoPriority, error) {
+	return services.TodoPriorityFromValue(obj.Priority), nil
+}
+
+// DueOffsetDays is the resolver for the dueOffsetDays field.
+func (r *todoTemplateResolver) DueOffsetDays(ctx context.Context, obj *models.TodoTemplate) (*int, error) {
+	return obj.DueOffsetDays.Ptr(), nil
+}
+
+// Tags is the resolver for the tags field.
+func (r *todoTemplateResolver) Tags(ctx context.Context, obj *models.TodoTemplate) ([]string, error) {
+	return services.TodoTemplateTags(obj)
+}
+
+// Subtasks is the resolver for the subtasks field.
+func (r *todoTemplateResolver) Subtasks(ctx context.Context, obj *models.TodoTemplate) ([]*models.TodoTemplateSubtask, error) {
+	// NOTE: テンプレートはサービスで読み込む際にサブタスクを設定済み
+	if obj.R == nil {
+		return models.TodoTemplateSubtaskSlice{}, nil
+	}
+	return obj.R.TodoTemplateSubtasks, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *todoTemplateResolver) CreatedAt(ctx context.Context, obj *models.TodoTemplate) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *todoTemplateResolver) UpdatedAt(ctx context.Context, obj *models.TodoTemplate) (string, error) {
+	return obj.UpdatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// Content is the resolver for the content field.
+func (r *todoTemplateSubtaskResolver) Content(ctx context.Context, obj *models.TodoTemplateSubtask) (string, error) {
+	return obj.Content.String, nil
+}
+
+// DueOffsetDays is the resolver for the dueOffsetDays field.
+func (r *todoTemplateSubtaskResolver) DueOffsetDays(ctx context.Context, obj *models.TodoTemplateSubtask) (*int, error) {
+	return obj.DueOffsetDays.Ptr(), nil
+}
+
+// TodoTemplate returns generated.TodoTemplateResolver implementation.
+func (r *Resolver) TodoTemplate() generated.TodoTemplateResolver { return &todoTemplateResolver{r} }
+
+// TodoTemplateSubtask returns generated.TodoTemplateSubtaskResolver implementation.
+func (r *Resolver) TodoTemplateSubtask() generated.TodoTemplateSubtaskResolver {
+	return &todoTemplateSubtaskResolver{r}
+}
+
+type todoTemplateResolver struct{ *Resolver }
+type todoTemplateSubtaskResolver struct{ *Resolver }
@@ -0,0 +1,110 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/services"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// StartTimer is the resolver for the startTimer field.
+func (r *mutationResolver) StartTimer(ctx context.Context, todoID string) (*models.TimeEntry, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TimeEntry{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTodoID, _ := strconv.Atoi(todoID)
+	return r.timeEntryService.StartTimer(ctx, intTodoID, user.ID)
+}
+
+// StopTimer is the resolver for the stopTimer field.
+func (r *mutationResolver) StopTimer(ctx context.Context) (*models.TimeEntry, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TimeEntry{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.timeEntryService.StopTimer(ctx, user.ID)
+}
+
+// LogTime is the resolver for the logTime field.
+func (r *mutationResolver) LogTime(ctx context.Context, todoID string, duration int, note *string) (*models.TimeEntry, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.TimeEntry{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intTodoID, _ := strconv.Atoi(todoID)
+	return r.timeEntryService.LogTime(ctx, intTodoID, duration, note, user.ID)
+}
+
+// RunningTimer is the resolver for the runningTimer field.
+func (r *queryResolver) RunningTimer(ctx context.Context) (*models.TimeEntry, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return nil, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.timeEntryService.FetchRunningTimer(ctx, user.ID)
+}
+
+// TimeReport is the resolver for the timeReport field.
+func (r *queryResolver) TimeReport(ctx context.Context, from string, to string, groupBy model.TimeReportGroupBy) (*model.TimeReport, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.TimeReport{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.timeEntryService.FetchTimeReport(ctx, from, to, groupBy, user.ID)
+}
+
+// Todo is the resolver for the todo field.
+func (r *timeEntryResolver) Todo(ctx context.Context, obj *models.TimeEntry) (*models.Todo, error) {
+	return r.todoService.FetchTodo(ctx, obj.TodoID, obj.UserID)
+}
+
+// StartedAt is the resolver for the startedAt field.
+func (r *timeEntryResolver) StartedAt(ctx context.Context, obj *models.TimeEntry) (string, error) {
+	return obj.StartedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// EndedAt is the resolver for the endedAt field.
+func (r *timeEntryResolver) EndedAt(ctx context.Context, obj *models.TimeEntry) (*string, error) {
+	if !obj.EndedAt.Valid {
+		return nil, nil
+	}
+
+	endedAt := obj.EndedAt.Time.Format("2006-01-02 15:04:05")
+	return &endedAt, nil
+}
+
+// DurationSeconds is the resolver for the durationSeconds field.
+func (r *timeEntryResolver) DurationSeconds(ctx context.Context, obj *models.TimeEntry) (int, error) {
+	return services.TimeEntryDuration(obj, time.Now()), nil
+}
+
+// Note is the resolver for the note field.
+func (r *timeEntryResolver) Note(ctx context.Context, obj *models.TimeEntry) (*string, error) {
+	return obj.Note.Ptr(), nil
+}
+
+// TimeSpent is the resolver for the timeSpent field.
+func (r *todoResolver) TimeSpent(ctx context.Context, obj *models.Todo) (int, error) {
+	return r.timeEntryService.FetchTimeSpent(ctx, obj.ID)
+}
+
+// TimeEntry returns generated.TimeEntryResolver implementation.
+func (r *Resolver) TimeEntry() generated.TimeEntryResolver { return &timeEntryResolver{r} }
+
+type timeEntryResolver struct{ *Resolver }
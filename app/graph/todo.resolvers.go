@@ -9,6 +9,7 @@ import (
 	"app/graph/model"
 	"app/lib/auth"
 	models "app/models/generated"
+	"app/services"
 	"app/view"
 	"context"
 	"fmt"
@@ -47,6 +48,17 @@ func (r *mutationResolver) DeleteTodo(ctx context.Context, id string) (string, e
 	return r.todoService.DeleteTodo(ctx, intID, user.ID)
 }
 
+// MoveTodo is the resolver for the moveTodo field.
+func (r *mutationResolver) MoveTodo(ctx context.Context, id string, afterID *string, beforeID *string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.MoveTodo(ctx, intID, optionalIntID(afterID), optionalIntID(beforeID), user.ID)
+}
+
 // FetchTodo is the resolver for the fetchTodo field.
 func (r *queryResolver) FetchTodo(ctx context.Context, id string) (*models.Todo, error) {
 	user := auth.GetUser(ctx)
@@ -59,13 +71,13 @@ func (r *queryResolver) FetchTodo(ctx context.Context, id string) (*models.Todo,
 }
 
 // FetchTodoLists is the resolver for the fetchTodoLists field.
-func (r *queryResolver) FetchTodoLists(ctx context.Context) ([]*models.Todo, error) {
+func (r *queryResolver) FetchTodoLists(ctx context.Context, includeArchived *bool) ([]*models.Todo, error) {
 	user := auth.GetUser(ctx)
 	if user == nil {
 		return models.TodoSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
 	}
 
-	return r.todoService.FetchTodoLists(ctx, user.ID)
+	return r.todoService.FetchTodoLists(ctx, user.ID, includeArchived != nil && *includeArchived)
 }
 
 // Content is the resolver for the content field.
@@ -73,6 +85,31 @@ func (r *todoResolver) Content(ctx context.Context, obj *models.Todo) (string, e
 	return obj.Content.String, nil
 }
 
+// DueDate is the resolver for the dueDate field.
+func (r *todoResolver) DueDate(ctx context.Context, obj *models.Todo) (*string, error) {
+	if !obj.DueDate.Valid {
+		return nil, nil
+	}
+
+	dueDate := obj.DueDate.Time.Format("2006-01-02")
+	return &dueDate, nil
+}
+
+// CompletedAt is the resolver for the completedAt field.
+func (r *todoResolver) CompletedAt(ctx context.Context, obj *models.Todo) (*string, error) {
+	if !obj.CompletedAt.Valid {
+		return nil, nil
+	}
+
+	completedAt := obj.CompletedAt.Time.Format("2006-01-02 15:04:05")
+	return &completedAt, nil
+}
+
+// Recurrence is the resolver for the recurrence field.
+func (r *todoResolver) Recurrence(ctx context.Context, obj *models.Todo) (*model.Recurrence, error) {
+	return services.RecurrenceFromRule(obj.RecurrenceRule)
+}
+
 // CreatedAt is the resolver for the createdAt field.
 func (r *todoResolver) CreatedAt(ctx context.Context, obj *models.Todo) (string, error) {
 	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
@@ -83,15 +120,7 @@ func (r *todoResolver) UpdatedAt(ctx context.Context, obj *models.Todo) (string,
 	return obj.UpdatedAt.Format("2006-01-02 15:04:05"), nil
 }
 
-// Mutation returns generated.MutationResolver implementation.
-func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
-
-// Query returns generated.QueryResolver implementation.
-func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
-
 // Todo returns generated.TodoResolver implementation.
 func (r *Resolver) Todo() generated.TodoResolver { return &todoResolver{r} }
 
-type mutationResolver struct{ *Resolver }
-type queryResolver struct{ *Resolver }
 type todoResolver struct{ *Resolver }
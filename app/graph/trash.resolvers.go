@@ -0,0 +1,55 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// RestoreTodo is the resolver for the restoreTodo field.
+func (r *mutationResolver) RestoreTodo(ctx context.Context, id string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.RestoreTodo(ctx, intID, user.ID)
+}
+
+// EmptyTrash is the resolver for the emptyTrash field.
+func (r *mutationResolver) EmptyTrash(ctx context.Context) (int, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return 0, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.EmptyTrash(ctx, user.ID)
+}
+
+// TrashedTodos is the resolver for the trashedTodos field.
+func (r *queryResolver) TrashedTodos(ctx context.Context) ([]*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return models.TodoSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.FetchTrashedTodos(ctx, user.ID)
+}
+
+// DeletedAt is the resolver for the deletedAt field.
+func (r *todoResolver) DeletedAt(ctx context.Context, obj *models.Todo) (*string, error) {
+	if !obj.DeletedAt.Valid {
+		return nil, nil
+	}
+
+	deletedAt := obj.DeletedAt.Time.Format("2006-01-02 15:04:05")
+	return &deletedAt, nil
+}
@@ -0,0 +1,136 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/generated"
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/services"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, url string, events []model.WebhookEvent, secret string) (*models.Webhook, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Webhook{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.webhookService.CreateWebhook(ctx, url, events, secret, user.ID)
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, id string) (string, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return id, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.webhookService.DeleteWebhook(ctx, intID, user.ID)
+}
+
+// Webhooks is the resolver for the webhooks field.
+func (r *queryResolver) Webhooks(ctx context.Context) ([]*models.Webhook, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return models.WebhookSlice{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.webhookService.FetchWebhooks(ctx, user.ID)
+}
+
+// WebhookDeliveries is the resolver for the webhookDeliveries field.
+func (r *queryResolver) WebhookDeliveries(ctx context.Context, webhookID string, first *int, after *string) (*model.WebhookDeliveryConnection, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &model.WebhookDeliveryConnection{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intWebhookID, _ := strconv.Atoi(webhookID)
+	return r.webhookService.FetchWebhookDeliveries(ctx, intWebhookID, user.ID, first, after)
+}
+
+// Events is the resolver for the events field.
+func (r *webhookResolver) Events(ctx context.Context, obj *models.Webhook) ([]model.WebhookEvent, error) {
+	return services.WebhookEvents(obj)
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *webhookResolver) CreatedAt(ctx context.Context, obj *models.Webhook) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// Deliveries is the resolver for the deliveries field.
+func (r *webhookResolver) Deliveries(ctx context.Context, obj *models.Webhook, first *int, after *string) (*model.WebhookDeliveryConnection, error) {
+	return r.webhookService.FetchWebhookDeliveries(ctx, obj.ID, obj.UserID, first, after)
+}
+
+// Event is the resolver for the event field.
+func (r *webhookDeliveryResolver) Event(ctx context.Context, obj *models.WebhookDelivery) (model.WebhookEvent, error) {
+	return services.WebhookEventFromType(obj.Event), nil
+}
+
+// Payload is the resolver for the payload field.
+func (r *webhookDeliveryResolver) Payload(ctx context.Context, obj *models.WebhookDelivery) (string, error) {
+	return string(obj.Payload), nil
+}
+
+// Status is the resolver for the status field.
+func (r *webhookDeliveryResolver) Status(ctx context.Context, obj *models.WebhookDelivery) (model.WebhookDeliveryStatus, error) {
+	return services.WebhookDeliveryStatusOf(obj), nil
+}
+
+// ResponseStatus is the resolver for the responseStatus field.
+func (r *webhookDeliveryResolver) ResponseStatus(ctx context.Context, obj *models.WebhookDelivery) (*int, error) {
+	return obj.ResponseStatus.Ptr(), nil
+}
+
+// LastError is the resolver for the lastError field.
+func (r *webhookDeliveryResolver) LastError(ctx context.Context, obj *models.WebhookDelivery) (*string, error) {
+	return obj.LastError.Ptr(), nil
+}
+
+// NextAttemptAt is the resolver for the nextAttemptAt field.
+func (r *webhookDeliveryResolver) NextAttemptAt(ctx context.Context, obj *models.WebhookDelivery) (*string, error) {
+	// NOTE: 送信済み、または送信に失敗した配信は再送しないため空とする
+	if services.WebhookDeliveryStatusOf(obj) != model.WebhookDeliveryStatusPending {
+		return nil, nil
+	}
+
+	nextAttemptAt := obj.NextAttemptAt.Format("2006-01-02 15:04:05")
+	return &nextAttemptAt, nil
+}
+
+// DeliveredAt is the resolver for the deliveredAt field.
+func (r *webhookDeliveryResolver) DeliveredAt(ctx context.Context, obj *models.WebhookDelivery) (*string, error) {
+	if !obj.DeliveredAt.Valid {
+		return nil, nil
+	}
+
+	deliveredAt := obj.DeliveredAt.Time.Format("2006-01-02 15:04:05")
+	return &deliveredAt, nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *webhookDeliveryResolver) CreatedAt(ctx context.Context, obj *models.WebhookDelivery) (string, error) {
+	return obj.CreatedAt.Format("2006-01-02 15:04:05"), nil
+}
+
+// Webhook returns generated.WebhookResolver implementation.
+func (r *Resolver) Webhook() generated.WebhookResolver { return &webhookResolver{r} }
+
+// WebhookDelivery returns generated.WebhookDeliveryResolver implementation.
+func (r *Resolver) WebhookDelivery() generated.WebhookDeliveryResolver {
+	return &webhookDeliveryResolver{r}
+}
+
+type webhookResolver struct{ *Resolver }
+type webhookDeliveryResolver struct{ *Resolver }
@@ -0,0 +1,50 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.55
+
+import (
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// AssignTodo is the resolver for the assignTodo field.
+func (r *mutationResolver) AssignTodo(ctx context.Context, id string, assigneeID *string) (*models.Todo, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return &models.Todo{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	return r.todoService.AssignTodo(ctx, intID, optionalIntID(assigneeID), user.ID)
+}
+
+// Workload is the resolver for the workload field.
+func (r *queryResolver) Workload(ctx context.Context, from string, to string) ([]*model.WorkloadDay, error) {
+	user := auth.GetUser(ctx)
+	if user == nil {
+		return []*model.WorkloadDay{}, view.NewUnauthorizedView(fmt.Errorf("unauthorized error"))
+	}
+
+	return r.todoService.FetchWorkload(ctx, user.ID, from, to)
+}
+
+// EstimateMinutes is the resolver for the estimateMinutes field.
+func (r *todoResolver) EstimateMinutes(ctx context.Context, obj *models.Todo) (*int, error) {
+	return obj.EstimateMinutes.Ptr(), nil
+}
+
+// Assignee is the resolver for the assignee field.
+func (r *todoResolver) Assignee(ctx context.Context, obj *models.Todo) (*models.User, error) {
+	if !obj.AssigneeID.Valid {
+		return nil, nil
+	}
+
+	return r.authService.Getuser(ctx, obj.AssigneeID.Int), nil
+}
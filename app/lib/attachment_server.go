@@ -0,0 +1,77 @@
+package lib
+
+import (
+	"app/lib/storage"
+	"app/services"
+	"app/view"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+const defaultAttachmentStorageDir = "storage/attachments"
+
+// NOTE: GraphQLとダウンロード用ハンドラで同じ署名鍵を使うため、プロセス起動時に1度だけ決定する
+var attachmentSigningKey = loadAttachmentSigningKey()
+
+func newAttachmentStorage() storage.Storage {
+	storageDir := os.Getenv("ATTACHMENT_STORAGE_DIR")
+	if storageDir == "" {
+		storageDir = defaultAttachmentStorageDir
+	}
+
+	return storage.NewLocalStorage(storageDir)
+}
+
+func newAttachmentService(db *sql.DB) services.AttachmentService {
+	return services.NewAttachmentService(db, newAttachmentStorage(), attachmentSigningKey)
+}
+
+func GetAttachmentHttpHandler(db *sql.DB) http.Handler {
+	attachmentService := newAttachmentService(db)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		attachment, file, err := attachmentService.OpenSignedAttachment(r.Context(), r.URL.Query())
+		if err != nil {
+			code := http.StatusInternalServerError
+			var ve view.ViewError
+			if errors.As(err, &ve) {
+				code = int(ve.Code)
+			}
+			http.Error(w, http.StatusText(code), code)
+			return
+		}
+		defer file.Close()
+
+		w.Header().Set("Content-Type", attachment.ContentType)
+		w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		io.Copy(w, file)
+	})
+}
+
+func loadAttachmentSigningKey() []byte {
+	if key := os.Getenv("ATTACHMENT_SIGNING_KEY"); key != "" {
+		return []byte(key)
+	}
+
+	// NOTE: 未設定の場合はランダムな鍵を使う(再起動するとそれまでに発行したURLは無効になる)
+	log.Println("ATTACHMENT_SIGNING_KEY is not set, using a random key")
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		log.Fatalln(err)
+	}
+	return key
+}
@@ -35,38 +35,49 @@ func Middleware(next http.Handler, db *sql.DB) http.Handler {
 		}
 
 		// NOTE: tokenに該当するユーザを取得する
-		token, err := jwt.Parse(tokenString.Value, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-
-			return []byte("abcdefghijklmn"), nil
-		})
+		user, err := UserFromToken(ctx, db, tokenString.Value)
 		if err != nil {
 			next.ServeHTTP(w, r)
-			fmt.Println("failt jwt parse")
+			fmt.Println(err)
 			return
 		}
 
-		var userID int
-		if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-			userID = int(claims["user_id"].(float64))
-		}
-		if userID == 0 {
-			next.ServeHTTP(w, r)
-			fmt.Println("invalid token")
-			return
-		}
-		user, _ := models.FindUser(ctx, db, userID)
-
 		// NOTE: Contextにuserをセットする
-		withUserContext := context.WithValue(r.Context(), userKey, user)
-		r = r.WithContext(withUserContext)
+		r = r.WithContext(WithUser(r.Context(), user))
 
 		next.ServeHTTP(w, r)
 	})
 }
 
+// NOTE: tokenに該当するユーザを返す(WebSocket接続時の認証でも使う)
+func UserFromToken(ctx context.Context, db *sql.DB, tokenString string) (*models.User, error) {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		return []byte("abcdefghijklmn"), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failt jwt parse")
+	}
+
+	var userID int
+	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+		if id, ok := claims["user_id"].(float64); ok {
+			userID = int(id)
+		}
+	}
+	if userID == 0 {
+		return nil, fmt.Errorf("invalid token")
+	}
+	return models.FindUser(ctx, db, userID)
+}
+
+func WithUser(ctx context.Context, user *models.User) context.Context {
+	return context.WithValue(ctx, userKey, user)
+}
+
 func GetUser(ctx context.Context) *models.User {
 	user, _ := ctx.Value(userKey).(*models.User)
 	return user
@@ -10,18 +10,55 @@ import (
 	"database/sql"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func GetGraphQLHttpHandler(db *sql.DB) http.Handler {
 	// NOTE: service
 	authService := services.NewAuthService(db)
-	todoService := services.NewTodoService(db)
+	todoService := newTodoService(db)
+	commentService := services.NewCommentService(db)
+	attachmentService := newAttachmentService(db)
+	reminderService := newReminderService(db)
+	projectService := services.NewProjectService(db)
+	savedViewService := services.NewSavedViewService(db)
+	boardService := services.NewBoardService(db, todoChangeBroker)
+	todoTemplateService := services.NewTodoTemplateService(db, todoChangeBroker)
+	timeEntryService := services.NewTimeEntryService(db)
+	statsService := services.NewStatsService(db)
+	notificationService := services.NewNotificationService(db)
+	webhookService := newWebhookService(db)
+	activityService := services.NewActivityService(db)
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(authService, todoService)}))
+	srv := handler.New(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(authService, todoService, commentService, attachmentService, reminderService, projectService, savedViewService, boardService, todoTemplateService, timeEntryService, statsService, notificationService, webhookService, activityService)}))
+
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              websocketInit(db),
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	// NOTE: ファイルアップロード(graphql-multipart-request-spec)
+	srv.AddTransport(transport.MultipartForm{
+		MaxUploadSize: services.MaxAttachmentSize + 1<<20,
+		MaxMemory:     services.MaxAttachmentSize,
+	})
+
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
 
 	srv.SetErrorPresenter(func(ctx context.Context, e error) *gqlerror.Error {
 		err := graphql.DefaultErrorPresenter(ctx, e)
@@ -39,6 +76,9 @@ func GetGraphQLHttpHandler(db *sql.DB) http.Handler {
 			"code":  errorCode,
 			"error": error,
 		}
+		if re.Current != nil {
+			err.Extensions["current"] = re.Current
+		}
 
 		return err
 	})
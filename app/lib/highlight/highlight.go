@@ -0,0 +1,112 @@
+package highlight
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	openTag  = "<mark>"
+	closeTag = "</mark>"
+	ellipsis = "…"
+)
+
+type span struct {
+	start int
+	end   int
+}
+
+// NOTE: 検索語に一致した部分を<mark>で囲んだHTMLを返す(それ以外の部分はエスケープする)
+// 大文字・小文字は区別しない
+func Highlight(text string, terms []string) string {
+	runes := []rune(text)
+	return render(runes, matches(runes, terms), 0, len(runes))
+}
+
+// NOTE: 最初に検索語が一致した位置の前後width文字を切り出し、一致した部分を強調したHTMLを返す
+// 一致しない場合は先頭から切り出す
+func Snippet(text string, terms []string, width int) string {
+	runes := []rune(text)
+	spans := matches(runes, terms)
+
+	start, end := 0, min(width*2, len(runes))
+	if len(spans) > 0 {
+		start = max(spans[0].start-width, 0)
+		end = min(spans[0].end+width, len(runes))
+	}
+
+	snippet := render(runes, spans, start, end)
+	if start > 0 {
+		snippet = ellipsis + snippet
+	}
+	if end < len(runes) {
+		snippet += ellipsis
+	}
+	return snippet
+}
+
+// NOTE: 先頭から順に、その位置で一致する最も長い検索語の範囲を返す(範囲は重複しない)
+func matches(runes []rune, terms []string) []span {
+	lowered := lower(runes)
+	loweredTerms := make([][]rune, 0, len(terms))
+	for _, term := range terms {
+		if term != "" {
+			loweredTerms = append(loweredTerms, lower([]rune(term)))
+		}
+	}
+
+	spans := []span{}
+	for i := 0; i < len(lowered); {
+		length := 0
+		for _, term := range loweredTerms {
+			if len(term) > length && hasPrefix(lowered[i:], term) {
+				length = len(term)
+			}
+		}
+		if length == 0 {
+			i++
+			continue
+		}
+		spans = append(spans, span{i, i + length})
+		i += length
+	}
+	return spans
+}
+
+func render(runes []rune, spans []span, start int, end int) string {
+	var b strings.Builder
+	pos := start
+	for _, s := range spans {
+		// NOTE: 切り出し範囲外の一致は範囲内の部分のみ強調する
+		s.start, s.end = max(s.start, start), min(s.end, end)
+		if s.start >= s.end {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:s.start])))
+		b.WriteString(openTag + html.EscapeString(string(runes[s.start:s.end])) + closeTag)
+		pos = s.end
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	return b.String()
+}
+
+func lower(runes []rune) []rune {
+	lowered := make([]rune, len(runes))
+	for i, r := range runes {
+		lowered[i] = unicode.ToLower(r)
+	}
+	return lowered
+}
+
+func hasPrefix(runes []rune, prefix []rune) bool {
+	if len(runes) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if runes[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package highlight
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlight(t *testing.T) {
+	cases := []struct {
+		text     string
+		terms    []string
+		expected string
+	}{
+		{"買い物に行く", []string{"買い物"}, "<mark>買い物</mark>に行く"},
+		{"Go and go", []string{"GO"}, "<mark>Go</mark> and <mark>go</mark>"},
+		{"release note", []string{"release", "release note"}, "<mark>release note</mark>"},
+		{"<b>牛乳</b>", []string{"牛乳"}, "&lt;b&gt;<mark>牛乳</mark>&lt;/b&gt;"},
+		{"no match", []string{"todo", ""}, "no match"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, Highlight(c.text, c.terms), "Highlight(%q, %q)", c.text, c.terms)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	text := "あいうえおかきくけこさしすせそ"
+
+	cases := []struct {
+		terms    []string
+		width    int
+		expected string
+	}{
+		{[]string{"くけ"}, 2, "…かき<mark>くけ</mark>こさ…"},
+		{[]string{"あい"}, 2, "<mark>あい</mark>うえ…"},
+		{[]string{"せそ"}, 3, "…さしす<mark>せそ</mark>"},
+		// NOTE: 一致しない場合は先頭から切り出す
+		{[]string{"なに"}, 3, "あいうえおか…"},
+		{[]string{"かき"}, 20, "あいうえお<mark>かき</mark>くけこさしすせそ"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, Snippet(text, c.terms, c.width), "Snippet(%q, %d)", c.terms, c.width)
+	}
+}
@@ -0,0 +1,27 @@
+package lib
+
+import (
+	"app/lib/scheduler"
+	"app/services"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+const defaultDueSoonPollInterval = 10 * time.Minute
+
+// NOTE: 期日が近いTodoの通知を定期的に作成するスケジューラを生成する
+func NewDueSoonNotificationScheduler(db *sql.DB) *scheduler.Scheduler {
+	notificationService := services.NewNotificationService(db)
+
+	interval := durationFromEnv("DUE_SOON_POLL_INTERVAL", defaultDueSoonPollInterval)
+
+	return scheduler.New("due soon notification", interval, func(ctx context.Context) error {
+		created, err := notificationService.NotifyDueSoonTodos(ctx, time.Now())
+		if created > 0 {
+			log.Printf("created %d due soon notifications", created)
+		}
+		return err
+	})
+}
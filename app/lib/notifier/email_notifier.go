@@ -0,0 +1,55 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+type EmailConfig struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+type emailNotifier struct {
+	config   EmailConfig
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewEmailNotifier(config EmailConfig) Notifier {
+	return &emailNotifier{config, smtp.SendMail}
+}
+
+func (en *emailNotifier) Notify(ctx context.Context, message Message) error {
+	if message.Recipient.Email == "" {
+		return fmt.Errorf("recipient email is empty")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if en.config.Username != "" {
+		auth = smtp.PlainAuth("", en.config.Username, en.config.Password, en.config.Host)
+	}
+	addr := net.JoinHostPort(en.config.Host, en.config.Port)
+	return en.sendMail(addr, auth, en.config.From, []string{message.Recipient.Email}, buildEmail(en.config.From, message))
+}
+
+func buildEmail(from string, message Message) []byte {
+	// NOTE: 件名は日本語を含むためMIMEエンコードする
+	header := []string{
+		"From: " + from,
+		"To: " + message.Recipient.Email,
+		"Subject: " + mime.QEncoding.Encode("UTF-8", message.Subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	return []byte(strings.Join(header, "\r\n") + "\r\n\r\n" + message.Body + "\r\n")
+}
@@ -0,0 +1,22 @@
+package notifier
+
+import (
+	"context"
+	"log"
+)
+
+type logNotifier struct {
+	logger *log.Logger
+}
+
+func NewLogNotifier(logger *log.Logger) Notifier {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &logNotifier{logger}
+}
+
+func (ln *logNotifier) Notify(ctx context.Context, message Message) error {
+	ln.logger.Printf("notify user_id=%d subject=%q body=%q", message.Recipient.UserID, message.Subject, message.Body)
+	return nil
+}
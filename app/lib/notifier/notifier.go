@@ -0,0 +1,49 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+)
+
+type Recipient struct {
+	UserID int    `json:"userId"`
+	Name   string `json:"name"`
+	Email  string `json:"email"`
+}
+
+type Message struct {
+	Recipient Recipient         `json:"recipient"`
+	Subject   string            `json:"subject"`
+	Body      string            `json:"body"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// NOTE: リマインダー等の通知を外部へ配信するための抽象
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
+
+// NOTE: 配信先ごとに配信状況を記録するため、通知先に名前を付ける
+type Channel struct {
+	Name     string
+	Notifier Notifier
+}
+
+type multiNotifier struct {
+	notifiers []Notifier
+}
+
+// NOTE: 複数の通知先へ配信する(いずれかが失敗した場合はエラーをまとめて返す)
+func NewMultiNotifier(notifiers ...Notifier) Notifier {
+	return &multiNotifier{notifiers}
+}
+
+func (mn *multiNotifier) Notify(ctx context.Context, message Message) error {
+	var errs []error
+	for _, n := range mn.notifiers {
+		if err := n.Notify(ctx, message); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,93 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type notifierFunc func(ctx context.Context, message Message) error
+
+func (f notifierFunc) Notify(ctx context.Context, message Message) error {
+	return f(ctx, message)
+}
+
+var testMessage = Message{
+	Recipient: Recipient{UserID: 1, Name: "test", Email: "test@example.com"},
+	Subject:   "リマインダー: test title",
+	Body:      "test body",
+}
+
+func TestMultiNotifier(t *testing.T) {
+	called := 0
+	ok := notifierFunc(func(ctx context.Context, message Message) error {
+		called++
+		return nil
+	})
+	ng := notifierFunc(func(ctx context.Context, message Message) error {
+		called++
+		return errors.New("failed")
+	})
+
+	err := NewMultiNotifier(ok, ng, ok).Notify(context.Background(), testMessage)
+
+	// NOTE: 一部が失敗しても残りの通知先へ配信されることの確認
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, called)
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var received Message
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	err := NewWebhookNotifier(server.URL).Notify(context.Background(), testMessage)
+
+	assert.Nil(t, err)
+	assert.Equal(t, testMessage, received)
+}
+
+func TestWebhookNotifier_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	err := NewWebhookNotifier(server.URL).Notify(context.Background(), testMessage)
+
+	assert.NotNil(t, err)
+}
+
+func TestEmailNotifier(t *testing.T) {
+	var sentTo []string
+	var sentMessage string
+	notifier := &emailNotifier{
+		config: EmailConfig{Host: "localhost", Port: "1025", From: "noreply@example.com"},
+		sendMail: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			assert.Equal(t, "localhost:1025", addr)
+			assert.Nil(t, a)
+			sentTo = to
+			sentMessage = string(msg)
+			return nil
+		},
+	}
+
+	err := notifier.Notify(context.Background(), testMessage)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"test@example.com"}, sentTo)
+	assert.True(t, strings.HasPrefix(sentMessage, "From: noreply@example.com\r\n"))
+	assert.Contains(t, sentMessage, "Subject: =?UTF-8?q?")
+	assert.Contains(t, sentMessage, "\r\n\r\ntest body\r\n")
+}
@@ -0,0 +1,54 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+)
+
+// NOTE: 購読者ごとのバッファ(受信が追いつかない購読者への配信は破棄する)
+const subscriberBufferSize = 16
+
+// NOTE: プロセス内で値を購読者へ配信するPub/Sub
+// 複数サーバで動作させる場合は、同じプロセスで発生した変更のみ配信される点に注意
+type Broker[T any] struct {
+	mu          sync.RWMutex
+	subscribers map[chan T]struct{}
+}
+
+func NewBroker[T any]() *Broker[T] {
+	return &Broker[T]{subscribers: map[chan T]struct{}{}}
+}
+
+// NOTE: ctxがキャンセルされるまで配信された値を受け取るチャネルを返す
+// キャンセル後に購読を解除し、チャネルを閉じる
+func (b *Broker[T]) Subscribe(ctx context.Context) <-chan T {
+	ch := make(chan T, subscriberBufferSize)
+
+	b.mu.Lock()
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		close(ch)
+		b.mu.Unlock()
+	}()
+
+	return ch
+}
+
+// NOTE: 全ての購読者へ値を配信する
+// 遅い購読者で配信元(リクエスト処理)が止まらないよう、バッファが埋まっている購読者には送らない
+func (b *Broker[T]) Publish(value T) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- value:
+		default:
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receive(t *testing.T, ch <-chan int) (int, bool) {
+	select {
+	case value, ok := <-ch:
+		return value, ok
+	case <-time.After(time.Second):
+		t.Fatal("no value received")
+		return 0, false
+	}
+}
+
+func TestPublish(t *testing.T) {
+	b := NewBroker[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	first := b.Subscribe(ctx)
+	second := b.Subscribe(ctx)
+
+	b.Publish(1)
+
+	value, _ := receive(t, first)
+	assert.Equal(t, 1, value)
+	value, _ = receive(t, second)
+	assert.Equal(t, 1, value)
+}
+
+func TestPublish_SlowSubscriber(t *testing.T) {
+	b := NewBroker[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := b.Subscribe(ctx)
+
+	// NOTE: バッファを超えた分は破棄され、配信元がブロックされないことの確認
+	for i := 0; i < subscriberBufferSize+1; i++ {
+		b.Publish(i)
+	}
+
+	assert.Len(t, ch, subscriberBufferSize)
+	value, _ := receive(t, ch)
+	assert.Equal(t, 0, value)
+}
+
+func TestSubscribe_Cancel(t *testing.T) {
+	b := NewBroker[int]()
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := b.Subscribe(ctx)
+
+	cancel()
+
+	_, ok := receive(t, ch)
+	assert.False(t, ok)
+	// NOTE: 購読を解除した後の配信でpanicしないことの確認
+	b.Publish(1)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	assert.Empty(t, b.subscribers)
+}
@@ -0,0 +1,121 @@
+package rank
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NOTE: 順位は0 ~ 1の小数を36進数で表した小数部分の文字列とし、文字列比較(バイナリ照合)で順序が決まる
+// 2つの順位の間には必ず新しい順位を作れるため、並び替え時に他の行を更新する必要がない
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+const base = len(digits)
+
+// NOTE: a < 結果 < b となる順位を返す
+// aが空文字の場合は先頭、bが空文字の場合は末尾として扱う
+func Between(a, b string) (string, error) {
+	if err := validate(a); err != nil {
+		return "", err
+	}
+	if err := validate(b); err != nil {
+		return "", err
+	}
+	if a != "" && b != "" && a >= b {
+		return "", fmt.Errorf("invalid rank range: %q >= %q", a, b)
+	}
+	return midpoint(a, b), nil
+}
+
+// NOTE: 順位を振り直す際に使用する、均等な間隔のn件の順位を返す
+func Spread(n int) []string {
+	if n <= 0 {
+		return []string{}
+	}
+
+	// NOTE: n件を表現できる桁数を求め、(i + 1) / (n + 1) の位置に配置する
+	width := 1
+	for capacity := base; capacity <= n; capacity *= base {
+		width++
+	}
+	total := 1
+	for i := 0; i < width; i++ {
+		total *= base
+	}
+
+	ranks := make([]string, n)
+	for i := 0; i < n; i++ {
+		ranks[i] = trimTrailingZeros(encode((i+1)*total/(n+1), width))
+	}
+	return ranks
+}
+
+func midpoint(a, b string) string {
+	if b != "" {
+		// NOTE: 共通の接頭辞はそのまま引き継ぐ(aが短い場合は末尾を0で埋めて比較する)
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			return b[:n] + midpoint(substr(a, n), b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(digits, a[0])
+	}
+	digitB := base
+	if b != "" {
+		digitB = strings.IndexByte(digits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(digits[(digitA+digitB+1)/2])
+	}
+	// NOTE: 先頭の桁が隣接している場合
+	if len(b) > 1 {
+		return b[:1]
+	}
+	return string(digits[digitA]) + midpoint(substr(a, 1), "")
+}
+
+func validate(rank string) error {
+	for i := 0; i < len(rank); i++ {
+		if strings.IndexByte(digits, rank[i]) < 0 {
+			return fmt.Errorf("invalid rank: %q", rank)
+		}
+	}
+	// NOTE: 末尾が0の順位は前に挿入できなくなるため不正とする
+	if strings.HasSuffix(rank, digits[:1]) {
+		return fmt.Errorf("invalid rank: %q", rank)
+	}
+	return nil
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+func substr(s string, from int) string {
+	if from >= len(s) {
+		return ""
+	}
+	return s[from:]
+}
+
+func encode(value int, width int) string {
+	buf := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = digits[value%base]
+		value /= base
+	}
+	return string(buf)
+}
+
+func trimTrailingZeros(s string) string {
+	return strings.TrimRight(s, digits[:1])
+}
@@ -0,0 +1,74 @@
+package rank
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetween(t *testing.T) {
+	cases := []struct {
+		a, b, expected string
+	}{
+		{"", "", "i"},
+		{"i", "", "r"},
+		{"", "i", "9"},
+		{"a", "c", "b"},
+		{"a", "b", "ai"},
+		{"az", "b", "azi"},
+		{"a5", "a6", "a5i"},
+		{"", "1", "0i"},
+		{"0i", "1", "0r"},
+	}
+
+	for _, c := range cases {
+		result, err := Between(c.a, c.b)
+		assert.Nil(t, err)
+		assert.Equal(t, c.expected, result, "Between(%q, %q)", c.a, c.b)
+	}
+}
+
+func TestBetween_Invalid(t *testing.T) {
+	for _, c := range [][2]string{{"b", "a"}, {"a", "a"}, {"a0", ""}, {"A", ""}, {"", "-"}} {
+		_, err := Between(c[0], c[1])
+		assert.NotNil(t, err, "Between(%q, %q)", c[0], c[1])
+	}
+}
+
+func TestBetween_RepeatedInsertions(t *testing.T) {
+	// NOTE: 同じ位置への挿入を繰り返しても順序が保たれることの確認
+	ranks := []string{"i"}
+	for i := 0; i < 200; i++ {
+		front, err := Between("", ranks[0])
+		assert.Nil(t, err)
+		back, err := Between(ranks[len(ranks)-1], "")
+		assert.Nil(t, err)
+		middle, err := Between(ranks[0], ranks[1%len(ranks)])
+		if len(ranks) > 1 {
+			assert.Nil(t, err)
+			ranks = append([]string{ranks[0], middle}, ranks[1:]...)
+		}
+		ranks = append([]string{front}, ranks...)
+		ranks = append(ranks, back)
+	}
+
+	assert.True(t, sort.StringsAreSorted(ranks))
+	for i := 1; i < len(ranks); i++ {
+		assert.NotEqual(t, ranks[i-1], ranks[i])
+	}
+}
+
+func TestSpread(t *testing.T) {
+	ranks := Spread(100)
+
+	assert.Len(t, ranks, 100)
+	assert.True(t, sort.StringsAreSorted(ranks))
+	for i, r := range ranks {
+		assert.Nil(t, validate(r))
+		if i > 0 {
+			assert.NotEqual(t, ranks[i-1], r)
+		}
+	}
+	assert.Equal(t, []string{"i"}, Spread(1))
+}
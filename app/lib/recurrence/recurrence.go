@@ -0,0 +1,257 @@
+package recurrence
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	// NOTE: 完了日を起点にInterval日後を次回とする
+	AfterCompletion Frequency = "AFTER_COMPLETION"
+)
+
+var weekdayCodes = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+// NOTE: RRULEのサブセット(FREQ, INTERVAL, BYDAY, BYMONTHDAY)で表現する繰り返しルール
+type Rule struct {
+	Frequency Frequency
+	Interval  int
+	Weekdays  []time.Weekday
+	MonthDay  int
+}
+
+// NOTE: RRULE形式の文字列を解析する
+// 例) FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,WE / FREQ=DAILY;INTERVAL=3;FROM=COMPLETION
+func Parse(src string) (Rule, error) {
+	rule := Rule{Interval: 1}
+	fromCompletion := false
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(src), "RRULE:"), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Rule{}, fmt.Errorf("繰り返しルールの形式が不正です。")
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Frequency = Frequency(strings.ToUpper(value))
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil {
+				return Rule{}, fmt.Errorf("繰り返し間隔が不正です。")
+			}
+			rule.Interval = interval
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				weekday, ok := parseWeekday(code)
+				if !ok {
+					return Rule{}, fmt.Errorf("曜日の指定が不正です。")
+				}
+				rule.Weekdays = append(rule.Weekdays, weekday)
+			}
+		case "BYMONTHDAY":
+			monthDay, err := strconv.Atoi(value)
+			if err != nil {
+				return Rule{}, fmt.Errorf("日付の指定が不正です。")
+			}
+			rule.MonthDay = monthDay
+		case "FROM":
+			fromCompletion = strings.ToUpper(value) == "COMPLETION"
+		default:
+			return Rule{}, fmt.Errorf("繰り返しルールに未対応の項目が含まれています。")
+		}
+	}
+
+	if fromCompletion && rule.Frequency == Daily {
+		rule.Frequency = AfterCompletion
+	}
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+	return rule, nil
+}
+
+func (r Rule) Validate() error {
+	switch r.Frequency {
+	case Daily, Weekly, Monthly, AfterCompletion:
+	default:
+		return fmt.Errorf("繰り返しの種類が不正です。")
+	}
+	if r.Interval < 1 || r.Interval > 365 {
+		return fmt.Errorf("繰り返し間隔は1 ~ 365での指定をお願いします。")
+	}
+	if r.MonthDay < 0 || r.MonthDay > 31 {
+		return fmt.Errorf("日付は1 ~ 31での指定をお願いします。")
+	}
+	return nil
+}
+
+// NOTE: DBに保存するRRULE形式の文字列へ変換する
+func (r Rule) String() string {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	freq := r.Frequency
+	if freq == AfterCompletion {
+		freq = Daily
+	}
+	parts := []string{"FREQ=" + string(freq), "INTERVAL=" + strconv.Itoa(interval)}
+
+	switch r.Frequency {
+	case Weekly:
+		if len(r.Weekdays) > 0 {
+			codes := make([]string, 0, len(r.Weekdays))
+			for _, weekday := range sortedWeekdays(r.Weekdays) {
+				codes = append(codes, weekdayCodes[weekday])
+			}
+			parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+		}
+	case Monthly:
+		if r.MonthDay > 0 {
+			parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+		}
+	case AfterCompletion:
+		parts = append(parts, "FROM=COMPLETION")
+	}
+	return strings.Join(parts, ";")
+}
+
+// NOTE: baseより後の次回発生日を返す
+// AfterCompletionの場合、baseには完了日を渡す想定
+func (r Rule) Next(base time.Time) time.Time {
+	base = truncateToDate(base)
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	switch r.Frequency {
+	case Weekly:
+		return r.nextWeekly(base, interval)
+	case Monthly:
+		return r.nextMonthly(base, interval)
+	default:
+		return base.AddDate(0, 0, interval)
+	}
+}
+
+// NOTE: 日付の指定がない毎月の繰り返しは、startの日付を指定日として固定したルールを返す
+// 月末に丸めた日付(1/31の次回の2/28など)から次回を計算すると、元の日付が失われるため
+func (r Rule) AnchoredTo(start time.Time) Rule {
+	if r.Frequency == Monthly && r.MonthDay == 0 {
+		r.MonthDay = start.Day()
+	}
+	return r
+}
+
+// NOTE: startの次回以降の発生日をcount件返す
+// AfterCompletionの場合は各発生日に完了したものとして計算する
+func (r Rule) Occurrences(start time.Time, count int) []time.Time {
+	r = r.AnchoredTo(start)
+	occurrences := make([]time.Time, 0, count)
+	current := start
+	for i := 0; i < count; i++ {
+		current = r.Next(current)
+		occurrences = append(occurrences, current)
+	}
+	return occurrences
+}
+
+func (r Rule) nextWeekly(base time.Time, interval int) time.Time {
+	if len(r.Weekdays) == 0 {
+		return base.AddDate(0, 0, 7*interval)
+	}
+
+	weekdays := make(map[time.Weekday]bool, len(r.Weekdays))
+	for _, weekday := range r.Weekdays {
+		weekdays[weekday] = true
+	}
+
+	// NOTE: 週の始まりを月曜日とし、baseの週からinterval週ごとの週に含まれる指定曜日を探す
+	baseWeek := weekStart(base)
+	candidate := base.AddDate(0, 0, 1)
+	for i := 0; i < 7*(interval+1); i++ {
+		weeks := int(weekStart(candidate).Sub(baseWeek).Hours()+12) / (24 * 7)
+		if weeks%interval == 0 && weekdays[candidate.Weekday()] {
+			return candidate
+		}
+		candidate = candidate.AddDate(0, 0, 1)
+	}
+	return base.AddDate(0, 0, 7*interval)
+}
+
+func (r Rule) nextMonthly(base time.Time, interval int) time.Time {
+	monthDay := r.MonthDay
+	if monthDay == 0 {
+		monthDay = base.Day()
+	}
+
+	// NOTE: 指定日が存在しない月(31日指定の4月など)は月末日に丸める
+	firstOfMonth := time.Date(base.Year(), base.Month(), 1, 0, 0, 0, 0, base.Location())
+	for k := 0; ; k++ {
+		month := firstOfMonth.AddDate(0, k*interval, 0)
+		day := monthDay
+		if last := daysIn(month); day > last {
+			day = last
+		}
+		candidate := time.Date(month.Year(), month.Month(), day, 0, 0, 0, 0, base.Location())
+		if candidate.After(base) {
+			return candidate
+		}
+	}
+}
+
+func parseWeekday(code string) (time.Weekday, bool) {
+	for weekday, c := range weekdayCodes {
+		if strings.EqualFold(c, strings.TrimSpace(code)) {
+			return weekday, true
+		}
+	}
+	return 0, false
+}
+
+func sortedWeekdays(weekdays []time.Weekday) []time.Weekday {
+	// NOTE: 月曜始まりの順で並べ、重複は除外する
+	seen := map[time.Weekday]bool{}
+	sorted := []time.Weekday{}
+	for _, weekday := range weekdays {
+		if !seen[weekday] {
+			seen[weekday] = true
+			sorted = append(sorted, weekday)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return (sorted[i]+6)%7 < (sorted[j]+6)%7
+	})
+	return sorted
+}
+
+func truncateToDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return t.AddDate(0, 0, -offset)
+}
+
+func daysIn(month time.Time) int {
+	return time.Date(month.Year(), month.Month()+1, 0, 0, 0, 0, 0, month.Location()).Day()
+}
@@ -0,0 +1,100 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	rule, err := Parse("FREQ=WEEKLY;INTERVAL=2;BYDAY=WE,MO")
+
+	assert.Nil(t, err)
+	assert.Equal(t, Weekly, rule.Frequency)
+	assert.Equal(t, 2, rule.Interval)
+	assert.Equal(t, []time.Weekday{time.Wednesday, time.Monday}, rule.Weekdays)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", rule.String())
+}
+
+func TestParse_AfterCompletion(t *testing.T) {
+	rule, err := Parse("FREQ=DAILY;INTERVAL=3;FROM=COMPLETION")
+
+	assert.Nil(t, err)
+	assert.Equal(t, AfterCompletion, rule.Frequency)
+	assert.Equal(t, "FREQ=DAILY;INTERVAL=3;FROM=COMPLETION", rule.String())
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, src := range []string{"", "FREQ=YEARLY", "FREQ=DAILY;INTERVAL=0", "FREQ=WEEKLY;BYDAY=XX", "FREQ=MONTHLY;BYMONTHDAY=32", "FREQ=DAILY;COUNT=3"} {
+		_, err := Parse(src)
+		assert.NotNil(t, err, src)
+	}
+}
+
+func TestNext_Daily(t *testing.T) {
+	rule := Rule{Frequency: Daily, Interval: 2}
+
+	assert.Equal(t, date(2024, 3, 1), rule.Next(date(2024, 2, 28)))
+}
+
+func TestNext_Weekly(t *testing.T) {
+	// NOTE: 2024-11-04は月曜日
+	rule := Rule{Frequency: Weekly, Interval: 1, Weekdays: []time.Weekday{time.Monday, time.Thursday}}
+
+	assert.Equal(t, date(2024, 11, 7), rule.Next(date(2024, 11, 4)))
+	assert.Equal(t, date(2024, 11, 11), rule.Next(date(2024, 11, 7)))
+}
+
+func TestNext_WeeklyWithInterval(t *testing.T) {
+	rule := Rule{Frequency: Weekly, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Friday}}
+
+	assert.Equal(t, date(2024, 11, 8), rule.Next(date(2024, 11, 4)))
+	assert.Equal(t, date(2024, 11, 18), rule.Next(date(2024, 11, 8)))
+}
+
+func TestNext_WeeklyWithoutWeekdays(t *testing.T) {
+	rule := Rule{Frequency: Weekly, Interval: 1}
+
+	assert.Equal(t, date(2024, 11, 13), rule.Next(date(2024, 11, 6)))
+}
+
+func TestNext_Monthly(t *testing.T) {
+	rule := Rule{Frequency: Monthly, Interval: 1, MonthDay: 15}
+
+	assert.Equal(t, date(2024, 11, 15), rule.Next(date(2024, 11, 5)))
+	assert.Equal(t, date(2024, 12, 15), rule.Next(date(2024, 11, 15)))
+}
+
+func TestNext_MonthlyClampsToEndOfMonth(t *testing.T) {
+	rule := Rule{Frequency: Monthly, Interval: 1, MonthDay: 31}
+
+	assert.Equal(t, date(2025, 2, 28), rule.Next(date(2025, 1, 31)))
+	assert.Equal(t, date(2025, 3, 31), rule.Next(date(2025, 2, 28)))
+}
+
+func TestOccurrences_MonthlyKeepsStartDay(t *testing.T) {
+	rule := Rule{Frequency: Monthly, Interval: 1}
+
+	occurrences := rule.Occurrences(date(2025, 1, 31), 3)
+
+	assert.Equal(t, []time.Time{date(2025, 2, 28), date(2025, 3, 31), date(2025, 4, 30)}, occurrences)
+}
+
+func TestAnchoredTo(t *testing.T) {
+	assert.Equal(t, 31, Rule{Frequency: Monthly, Interval: 1}.AnchoredTo(date(2025, 1, 31)).MonthDay)
+	assert.Equal(t, 15, Rule{Frequency: Monthly, Interval: 1, MonthDay: 15}.AnchoredTo(date(2025, 1, 31)).MonthDay)
+	assert.Equal(t, 0, Rule{Frequency: Weekly, Interval: 1}.AnchoredTo(date(2025, 1, 31)).MonthDay)
+}
+
+func TestOccurrences(t *testing.T) {
+	rule := Rule{Frequency: AfterCompletion, Interval: 10}
+
+	occurrences := rule.Occurrences(date(2024, 12, 25), 3)
+
+	assert.Equal(t, []time.Time{date(2025, 1, 4), date(2025, 1, 14), date(2025, 1, 24)}, occurrences)
+}
@@ -0,0 +1,70 @@
+package lib
+
+import (
+	"app/lib/notifier"
+	"app/lib/scheduler"
+	"app/services"
+	"context"
+	"database/sql"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+const defaultReminderPollInterval = 30 * time.Second
+
+func newReminderService(db *sql.DB) services.ReminderService {
+	return services.NewReminderService(db, newReminderChannels())
+}
+
+// NOTE: リマインダーの配信を定期実行するスケジューラを生成する
+func NewReminderScheduler(db *sql.DB) *scheduler.Scheduler {
+	reminderService := newReminderService(db)
+
+	interval := durationFromEnv("REMINDER_POLL_INTERVAL", defaultReminderPollInterval)
+
+	return scheduler.New("reminder", interval, func(ctx context.Context) error {
+		sent, err := reminderService.DispatchDueReminders(ctx, time.Now())
+		if sent > 0 {
+			log.Printf("dispatched %d reminders", sent)
+		}
+		return err
+	})
+}
+
+// NOTE: REMINDER_NOTIFIERS(カンマ区切り: log, email, webhook)で配信先を切り替える(未設定時はlog)
+// 配信先の名前は配信済みの記録に使うため、変更しないこと
+func newReminderChannels() []notifier.Channel {
+	var channels []notifier.Channel
+	seen := map[string]bool{}
+	for _, name := range strings.Split(os.Getenv("REMINDER_NOTIFIERS"), ",") {
+		name = strings.TrimSpace(name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		switch name {
+		case "":
+		case "log":
+			channels = append(channels, notifier.Channel{Name: name, Notifier: notifier.NewLogNotifier(nil)})
+		case "email":
+			channels = append(channels, notifier.Channel{Name: name, Notifier: notifier.NewEmailNotifier(notifier.EmailConfig{
+				Host:     os.Getenv("SMTP_HOST"),
+				Port:     os.Getenv("SMTP_PORT"),
+				Username: os.Getenv("SMTP_USER"),
+				Password: os.Getenv("SMTP_PASS"),
+				From:     os.Getenv("SMTP_FROM"),
+			})})
+		case "webhook":
+			channels = append(channels, notifier.Channel{Name: name, Notifier: notifier.NewWebhookNotifier(os.Getenv("REMINDER_WEBHOOK_URL"))})
+		default:
+			log.Fatalf("unknown reminder notifier: %q", name)
+		}
+	}
+
+	if len(channels) == 0 {
+		return []notifier.Channel{{Name: "log", Notifier: notifier.NewLogNotifier(nil)}}
+	}
+	return channels
+}
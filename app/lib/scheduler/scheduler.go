@@ -0,0 +1,54 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type Job func(ctx context.Context) error
+
+// NOTE: 一定間隔でJobを実行するバックグラウンドスケジューラ
+type Scheduler struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+func New(name string, interval time.Duration, job Job) *Scheduler {
+	return &Scheduler{name, interval, job}
+}
+
+// NOTE: ctxがキャンセルされるまでJobを定期実行する
+// 実行中のJobが完了してから戻るため、呼び出し側は戻り値を待つことでクリーンに停止できる
+func (s *Scheduler) Run(ctx context.Context) {
+	log.Printf("%s scheduler started (interval: %s)", s.name, s.interval)
+	defer log.Printf("%s scheduler stopped", s.name)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runJob(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runJob(ctx context.Context) {
+	// NOTE: Jobのpanicでスケジューラ(サーバ)ごと停止しないようにする
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("%s scheduler job panicked: %v", s.name, r)
+		}
+	}()
+
+	// NOTE: 停止要求を受けても実行中のJobは最後まで処理させる(途中で中断すると配信済みの記録が失われるため)
+	if err := s.job(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("%s scheduler job failed: %v", s.name, err)
+	}
+}
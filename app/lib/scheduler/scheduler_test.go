@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	var count atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New("test", 10*time.Millisecond, func(ctx context.Context) error {
+		if count.Add(1) == 3 {
+			cancel()
+		}
+		return nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after cancel")
+	}
+	assert.Equal(t, int32(3), count.Load())
+}
+
+func TestRun_ContinuesAfterFailure(t *testing.T) {
+	var count atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+	s := New("test", time.Millisecond, func(ctx context.Context) error {
+		switch count.Add(1) {
+		case 1:
+			return errors.New("failed")
+		case 2:
+			panic("unexpected")
+		default:
+			cancel()
+		}
+		return nil
+	})
+
+	s.Run(ctx)
+
+	// NOTE: エラーやpanicが発生しても次の実行が行われることの確認
+	assert.Equal(t, int32(3), count.Load())
+}
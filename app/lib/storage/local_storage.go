@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type localStorage struct {
+	baseDir string
+}
+
+func NewLocalStorage(baseDir string) Storage {
+	return &localStorage{baseDir}
+}
+
+func (ls *localStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	return file.Close()
+}
+
+func (ls *localStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := ls.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+func (ls *localStorage) Delete(ctx context.Context, key string) error {
+	path, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// NOTE: keyがbaseDirの外を指さないことを確認した上でファイルパスに変換する
+func (ls *localStorage) path(key string) (string, error) {
+	path := filepath.Join(ls.baseDir, filepath.FromSlash(key))
+	base := filepath.Clean(ls.baseDir) + string(filepath.Separator)
+	if !strings.HasPrefix(path, base) {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+	return path, nil
+}
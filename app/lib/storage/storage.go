@@ -0,0 +1,14 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// Storage 添付ファイルなどのバイナリを保存するストレージ
+// NOTE: ローカルファイルシステム以外(S3互換ストレージなど)もこのインターフェースを実装して差し替える
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,41 @@
+package lib
+
+import (
+	"app/lib/auth"
+	"app/services"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// NOTE: GraphQLとスケジューラで同じ配信先を使うため、プロセスで1つだけ生成する
+var todoChangeBroker = services.NewTodoChangeBroker()
+
+// NOTE: 完全に削除したTodoの添付ファイルも削除するため、添付ファイルと同じストレージを使う
+func newTodoService(db *sql.DB) services.TodoService {
+	return services.NewTodoService(db, todoChangeBroker, newAttachmentStorage())
+}
+
+// NOTE: WebSocketの接続時(connection_init)に認証し、未認証の接続は拒否する
+// ブラウザはアップグレード時のリクエストでCookieを送るため認証済みとなる
+// Cookieを送れないクライアントはpayloadのAuthorizationでtokenを渡す
+func websocketInit(db *sql.DB) transport.WebsocketInitFunc {
+	return func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		if auth.GetUser(ctx) != nil {
+			return ctx, nil, nil
+		}
+
+		tokenString := strings.TrimPrefix(initPayload.Authorization(), "Bearer ")
+		if tokenString == "" {
+			return ctx, nil, fmt.Errorf("unauthorized error")
+		}
+		user, err := auth.UserFromToken(ctx, db, tokenString)
+		if err != nil {
+			return ctx, nil, fmt.Errorf("unauthorized error")
+		}
+		return auth.WithUser(ctx, user), nil, nil
+	}
+}
@@ -0,0 +1,43 @@
+package lib
+
+import (
+	"app/lib/scheduler"
+	"context"
+	"database/sql"
+	"log"
+	"os"
+	"time"
+)
+
+const (
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	defaultTrashPurgeInterval = time.Hour
+)
+
+// NOTE: ゴミ箱内で保持期間(TRASH_RETENTION)を過ぎたTodoを完全に削除するスケジューラを生成する
+func NewTrashPurgeScheduler(db *sql.DB) *scheduler.Scheduler {
+	todoService := newTodoService(db)
+	retention := durationFromEnv("TRASH_RETENTION", defaultTrashRetention)
+	interval := durationFromEnv("TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval)
+
+	return scheduler.New("trash purge", interval, func(ctx context.Context) error {
+		purged, err := todoService.PurgeTrashedTodos(ctx, time.Now().Add(-retention))
+		if purged > 0 {
+			log.Printf("purged %d trashed todos", purged)
+		}
+		return err
+	})
+}
+
+func durationFromEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		log.Fatalf("invalid %s: %q", key, value)
+	}
+	return duration
+}
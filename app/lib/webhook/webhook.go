@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+	defaultTimeout  = 10 * time.Second
+
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+)
+
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID int
+	Body       []byte
+}
+
+// NOTE: Webhookの送信先へペイロードを送信する
+// 送信先の応答ステータスを返す(2xx以外の応答はエラーとする)
+type Sender interface {
+	Send(ctx context.Context, req Request) (int, error)
+}
+
+// NOTE: 送信先が内部ネットワークのアドレスだった場合のエラー
+var ErrForbiddenAddress = errors.New("webhook destination address is not allowed")
+
+type httpSender struct {
+	client *http.Client
+}
+
+// NOTE: ユーザが登録したURLから内部ネットワークへアクセスされないよう(SSRF対策)、内部ネットワークのアドレスへは接続しない
+func NewSender() Sender {
+	dialer := &net.Dialer{Timeout: defaultTimeout, Control: restrictAddress}
+	// NOTE: プロキシを経由すると接続先のアドレスを検証できないため、プロキシは使用しない
+	transport := &http.Transport{
+		Proxy:               nil,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: defaultTimeout,
+	}
+	return NewSenderWithClient(&http.Client{Transport: transport, Timeout: defaultTimeout})
+}
+
+// NOTE: 指定したクライアントで送信する(接続先のアドレスは制限しないため、テストなどでのみ使用する)
+func NewSenderWithClient(client *http.Client) Sender {
+	return &httpSender{client}
+}
+
+func (hs *httpSender) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(EventHeader, req.Event)
+	httpReq.Header.Set(DeliveryHeader, strconv.Itoa(req.DeliveryID))
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, timestamp, req.Body))
+
+	res, err := hs.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// NOTE: 名前解決後の接続直前に接続先のアドレスを検証する
+// 検証後に再度名前解決されることがないため、DNSリバインディングによる回避もできない
+func restrictAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !IsAllowedAddress(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+	return nil
+}
+
+// NOTE: ループバック、プライベート、リンクローカル、未指定のアドレスは送信先として許可しない
+func IsAllowedAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsUnspecified()
+}
+
+// NOTE: 「タイムスタンプ.本文」をシークレットでHMAC-SHA256署名する
+// タイムスタンプを署名に含めることで、受信側で古いリクエストの再送(リプレイ)を検出できる
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NOTE: 受信側で署名を検証する(タイミング攻撃を避けるため定数時間で比較する)
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// NOTE: attempt回目の送信に失敗した後、次に再送するまでの待ち時間を返す(指数関数的に増やし、上限はmaxBackoff)
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	backoff := baseBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return backoff
+}
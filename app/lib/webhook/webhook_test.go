@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend(t *testing.T) {
+	body := []byte(`{"event":"todo.created"}`)
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	status, err := NewSenderWithClient(server.Client()).Send(context.Background(), Request{URL: server.URL, Secret: "secret", Event: "todo.created", DeliveryID: 1, Body: body})
+
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	assert.Equal(t, body, receivedBody)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "todo.created", received.Header.Get(EventHeader))
+	assert.Equal(t, "1", received.Header.Get(DeliveryHeader))
+
+	// NOTE: 受信側で署名を検証できることの確認
+	timestamp, _ := strconv.ParseInt(received.Header.Get(TimestampHeader), 10, 64)
+	assert.True(t, Verify("secret", timestamp, receivedBody, received.Header.Get(SignatureHeader)))
+	assert.False(t, Verify("other", timestamp, receivedBody, received.Header.Get(SignatureHeader)))
+}
+
+func TestSend_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	status, err := NewSenderWithClient(server.Client()).Send(context.Background(), Request{URL: server.URL, Secret: "secret", Body: []byte(`{}`)})
+
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+}
+
+func TestSend_ForbiddenAddress(t *testing.T) {
+	received := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = true
+	}))
+	defer server.Close()
+
+	// NOTE: 通常の送信ではループバックアドレスのサーバへ接続しないことの確認
+	status, err := NewSender().Send(context.Background(), Request{URL: server.URL, Secret: "secret", Body: []byte(`{}`)})
+
+	assert.True(t, errors.Is(err, ErrForbiddenAddress))
+	assert.Equal(t, 0, status)
+	assert.False(t, received)
+}
+
+func TestIsAllowedAddress(t *testing.T) {
+	for _, address := range []string{"127.0.0.1", "::1", "10.0.0.1", "172.16.0.1", "192.168.1.1", "169.254.169.254", "fe80::1", "fc00::1", "0.0.0.0", "::", "::ffff:127.0.0.1"} {
+		assert.False(t, IsAllowedAddress(netip.MustParseAddr(address)), address)
+	}
+	for _, address := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"} {
+		assert.True(t, IsAllowedAddress(netip.MustParseAddr(address)), address)
+	}
+}
+
+func TestSign(t *testing.T) {
+	signature := Sign("secret", 1733900000, []byte(`{}`))
+
+	assert.Equal(t, signature, Sign("secret", 1733900000, []byte(`{}`)))
+	// NOTE: タイムスタンプや本文が異なる場合は署名が一致しないことの確認
+	assert.NotEqual(t, signature, Sign("secret", 1733900001, []byte(`{}`)))
+	assert.NotEqual(t, signature, Sign("secret", 1733900000, []byte(`{"a":1}`)))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Backoff(1))
+	assert.Equal(t, time.Minute, Backoff(2))
+	assert.Equal(t, 4*time.Minute, Backoff(4))
+	assert.Equal(t, 6*time.Hour, Backoff(20))
+}
@@ -0,0 +1,32 @@
+package lib
+
+import (
+	"app/lib/scheduler"
+	"app/lib/webhook"
+	"app/services"
+	"context"
+	"database/sql"
+	"log"
+	"time"
+)
+
+const defaultWebhookPollInterval = 10 * time.Second
+
+func newWebhookService(db *sql.DB) services.WebhookService {
+	return services.NewWebhookService(db, webhook.NewSender())
+}
+
+// NOTE: Webhookの配信(失敗時の再送を含む)を定期実行するスケジューラを生成する
+func NewWebhookDeliveryScheduler(db *sql.DB) *scheduler.Scheduler {
+	webhookService := newWebhookService(db)
+
+	interval := durationFromEnv("WEBHOOK_POLL_INTERVAL", defaultWebhookPollInterval)
+
+	return scheduler.New("webhook delivery", interval, func(ctx context.Context) error {
+		sent, err := webhookService.DeliverPendingWebhooks(ctx, time.Now())
+		if sent > 0 {
+			log.Printf("delivered %d webhooks", sent)
+		}
+		return err
+	})
+}
@@ -0,0 +1,2101 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// BoardColumn is an object representing the database table.
+type BoardColumn struct {
+	ID        int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BoardID   int         `boil:"board_id" json:"board_id" toml:"board_id" yaml:"board_id"`
+	Name      string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Status    null.String `boil:"status" json:"status,omitempty" toml:"status" yaml:"status,omitempty"`
+	WipLimit  null.Int    `boil:"wip_limit" json:"wip_limit,omitempty" toml:"wip_limit" yaml:"wip_limit,omitempty"`
+	Position  string      `boil:"position" json:"position" toml:"position" yaml:"position"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *boardColumnR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L boardColumnL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BoardColumnColumns = struct {
+	ID        string
+	BoardID   string
+	Name      string
+	Status    string
+	WipLimit  string
+	Position  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	BoardID:   "board_id",
+	Name:      "name",
+	Status:    "status",
+	WipLimit:  "wip_limit",
+	Position:  "position",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var BoardColumnTableColumns = struct {
+	ID        string
+	BoardID   string
+	Name      string
+	Status    string
+	WipLimit  string
+	Position  string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "board_columns.id",
+	BoardID:   "board_columns.board_id",
+	Name:      "board_columns.name",
+	Status:    "board_columns.status",
+	WipLimit:  "board_columns.wip_limit",
+	Position:  "board_columns.position",
+	CreatedAt: "board_columns.created_at",
+	UpdatedAt: "board_columns.updated_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var BoardColumnWhere = struct {
+	ID        whereHelperint
+	BoardID   whereHelperint
+	Name      whereHelperstring
+	Status    whereHelpernull_String
+	WipLimit  whereHelpernull_Int
+	Position  whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "`board_columns`.`id`"},
+	BoardID:   whereHelperint{field: "`board_columns`.`board_id`"},
+	Name:      whereHelperstring{field: "`board_columns`.`name`"},
+	Status:    whereHelpernull_String{field: "`board_columns`.`status`"},
+	WipLimit:  whereHelpernull_Int{field: "`board_columns`.`wip_limit`"},
+	Position:  whereHelperstring{field: "`board_columns`.`position`"},
+	CreatedAt: whereHelpertime_Time{field: "`board_columns`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`board_columns`.`updated_at`"},
+}
+
+// BoardColumnRels is where relationship names are stored.
+var BoardColumnRels = struct {
+	Board string
+	Todos string
+}{
+	Board: "Board",
+	Todos: "Todos",
+}
+
+// boardColumnR is where relationships are stored.
+type boardColumnR struct {
+	Board *KanbanBoard `boil:"Board" json:"Board" toml:"Board" yaml:"Board"`
+	Todos TodoSlice    `boil:"Todos" json:"Todos" toml:"Todos" yaml:"Todos"`
+}
+
+// NewStruct creates a new relationship struct
+func (*boardColumnR) NewStruct() *boardColumnR {
+	return &boardColumnR{}
+}
+
+func (r *boardColumnR) GetBoard() *KanbanBoard {
+	if r == nil {
+		return nil
+	}
+	return r.Board
+}
+
+func (r *boardColumnR) GetTodos() TodoSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Todos
+}
+
+// boardColumnL is where Load methods for each relationship are stored.
+type boardColumnL struct{}
+
+var (
+	boardColumnAllColumns            = []string{"id", "board_id", "name", "status", "wip_limit", "position", "created_at", "updated_at"}
+	boardColumnColumnsWithoutDefault = []string{"board_id", "name", "status", "wip_limit", "position", "created_at", "updated_at"}
+	boardColumnColumnsWithDefault    = []string{"id"}
+	boardColumnPrimaryKeyColumns     = []string{"id"}
+	boardColumnGeneratedColumns      = []string{}
+)
+
+type (
+	// BoardColumnSlice is an alias for a slice of pointers to BoardColumn.
+	// This should almost always be used instead of []BoardColumn.
+	BoardColumnSlice []*BoardColumn
+	// BoardColumnHook is the signature for custom BoardColumn hook methods
+	BoardColumnHook func(context.Context, boil.ContextExecutor, *BoardColumn) error
+
+	boardColumnQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	boardColumnType                 = reflect.TypeOf(&BoardColumn{})
+	boardColumnMapping              = queries.MakeStructMapping(boardColumnType)
+	boardColumnPrimaryKeyMapping, _ = queries.BindMapping(boardColumnType, boardColumnMapping, boardColumnPrimaryKeyColumns)
+	boardColumnInsertCacheMut       sync.RWMutex
+	boardColumnInsertCache          = make(map[string]insertCache)
+	boardColumnUpdateCacheMut       sync.RWMutex
+	boardColumnUpdateCache          = make(map[string]updateCache)
+	boardColumnUpsertCacheMut       sync.RWMutex
+	boardColumnUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var boardColumnAfterSelectMu sync.Mutex
+var boardColumnAfterSelectHooks []BoardColumnHook
+
+var boardColumnBeforeInsertMu sync.Mutex
+var boardColumnBeforeInsertHooks []BoardColumnHook
+var boardColumnAfterInsertMu sync.Mutex
+var boardColumnAfterInsertHooks []BoardColumnHook
+
+var boardColumnBeforeUpdateMu sync.Mutex
+var boardColumnBeforeUpdateHooks []BoardColumnHook
+var boardColumnAfterUpdateMu sync.Mutex
+var boardColumnAfterUpdateHooks []BoardColumnHook
+
+var boardColumnBeforeDeleteMu sync.Mutex
+var boardColumnBeforeDeleteHooks []BoardColumnHook
+var boardColumnAfterDeleteMu sync.Mutex
+var boardColumnAfterDeleteHooks []BoardColumnHook
+
+var boardColumnBeforeUpsertMu sync.Mutex
+var boardColumnBeforeUpsertHooks []BoardColumnHook
+var boardColumnAfterUpsertMu sync.Mutex
+var boardColumnAfterUpsertHooks []BoardColumnHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BoardColumn) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BoardColumn) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BoardColumn) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BoardColumn) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BoardColumn) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BoardColumn) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BoardColumn) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BoardColumn) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BoardColumn) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardColumnAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBoardColumnHook registers your hook function for all future operations.
+func AddBoardColumnHook(hookPoint boil.HookPoint, boardColumnHook BoardColumnHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		boardColumnAfterSelectMu.Lock()
+		boardColumnAfterSelectHooks = append(boardColumnAfterSelectHooks, boardColumnHook)
+		boardColumnAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		boardColumnBeforeInsertMu.Lock()
+		boardColumnBeforeInsertHooks = append(boardColumnBeforeInsertHooks, boardColumnHook)
+		boardColumnBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		boardColumnAfterInsertMu.Lock()
+		boardColumnAfterInsertHooks = append(boardColumnAfterInsertHooks, boardColumnHook)
+		boardColumnAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		boardColumnBeforeUpdateMu.Lock()
+		boardColumnBeforeUpdateHooks = append(boardColumnBeforeUpdateHooks, boardColumnHook)
+		boardColumnBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		boardColumnAfterUpdateMu.Lock()
+		boardColumnAfterUpdateHooks = append(boardColumnAfterUpdateHooks, boardColumnHook)
+		boardColumnAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		boardColumnBeforeDeleteMu.Lock()
+		boardColumnBeforeDeleteHooks = append(boardColumnBeforeDeleteHooks, boardColumnHook)
+		boardColumnBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		boardColumnAfterDeleteMu.Lock()
+		boardColumnAfterDeleteHooks = append(boardColumnAfterDeleteHooks, boardColumnHook)
+		boardColumnAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		boardColumnBeforeUpsertMu.Lock()
+		boardColumnBeforeUpsertHooks = append(boardColumnBeforeUpsertHooks, boardColumnHook)
+		boardColumnBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		boardColumnAfterUpsertMu.Lock()
+		boardColumnAfterUpsertHooks = append(boardColumnAfterUpsertHooks, boardColumnHook)
+		boardColumnAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single boardColumn record from the query.
+func (q boardColumnQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BoardColumn, error) {
+	o := &BoardColumn{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for board_columns")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BoardColumn records from the query.
+func (q boardColumnQuery) All(ctx context.Context, exec boil.ContextExecutor) (BoardColumnSlice, error) {
+	var o []*BoardColumn
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to BoardColumn slice")
+	}
+
+	if len(boardColumnAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BoardColumn records in the query.
+func (q boardColumnQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count board_columns rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q boardColumnQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if board_columns exists")
+	}
+
+	return count > 0, nil
+}
+
+// Board pointed to by the foreign key.
+func (o *BoardColumn) Board(mods ...qm.QueryMod) kanbanBoardQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.BoardID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return KanbanBoards(queryMods...)
+}
+
+// Todos retrieves all the todo's Todos with an executor.
+func (o *BoardColumn) Todos(mods ...qm.QueryMod) todoQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todos`.`board_column_id`=?", o.ID),
+	)
+
+	return Todos(queryMods...)
+}
+
+// LoadBoard allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardColumnL) LoadBoard(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardColumn interface{}, mods queries.Applicator) error {
+	var slice []*BoardColumn
+	var object *BoardColumn
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardColumn.(*BoardColumn)
+		if !ok {
+			object = new(BoardColumn)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardColumn)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardColumn))
+			}
+		}
+	} else {
+		s, ok := maybeBoardColumn.(*[]*BoardColumn)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardColumn)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardColumn))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardColumnR{}
+		}
+		args[object.BoardID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardColumnR{}
+			}
+
+			args[obj.BoardID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`kanban_boards`),
+		qm.WhereIn(`kanban_boards.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load KanbanBoard")
+	}
+
+	var resultSlice []*KanbanBoard
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice KanbanBoard")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for kanban_boards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for kanban_boards")
+	}
+
+	if len(kanbanBoardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Board = foreign
+		if foreign.R == nil {
+			foreign.R = &kanbanBoardR{}
+		}
+		foreign.R.BoardBoardColumns = append(foreign.R.BoardBoardColumns, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BoardID == foreign.ID {
+				local.R.Board = foreign
+				if foreign.R == nil {
+					foreign.R = &kanbanBoardR{}
+				}
+				foreign.R.BoardBoardColumns = append(foreign.R.BoardBoardColumns, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardColumnL) LoadTodos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardColumn interface{}, mods queries.Applicator) error {
+	var slice []*BoardColumn
+	var object *BoardColumn
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardColumn.(*BoardColumn)
+		if !ok {
+			object = new(BoardColumn)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardColumn)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardColumn))
+			}
+		}
+	} else {
+		s, ok := maybeBoardColumn.(*[]*BoardColumn)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardColumn)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardColumn))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardColumnR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardColumnR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.board_column_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`todos.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todos")
+	}
+
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todos")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Todos = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoR{}
+			}
+			foreign.R.BoardColumn = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BoardColumnID) {
+				local.R.Todos = append(local.R.Todos, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.BoardColumn = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBoard of the boardColumn to the related item.
+// Sets o.R.Board to related.
+// Adds o to related.R.BoardBoardColumns.
+func (o *BoardColumn) SetBoard(ctx context.Context, exec boil.ContextExecutor, insert bool, related *KanbanBoard) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `board_columns` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"board_id"}),
+		strmangle.WhereClause("`", "`", 0, boardColumnPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BoardID = related.ID
+	if o.R == nil {
+		o.R = &boardColumnR{
+			Board: related,
+		}
+	} else {
+		o.R.Board = related
+	}
+
+	if related.R == nil {
+		related.R = &kanbanBoardR{
+			BoardBoardColumns: BoardColumnSlice{o},
+		}
+	} else {
+		related.R.BoardBoardColumns = append(related.R.BoardBoardColumns, o)
+	}
+
+	return nil
+}
+
+// AddTodos adds the given related objects to the existing relationships
+// of the board_column, optionally inserting them as new records.
+// Appends related to o.R.Todos.
+// Sets related.R.BoardColumn appropriately.
+func (o *BoardColumn) AddTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.BoardColumnID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todos` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"board_column_id"}),
+				strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.BoardColumnID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &boardColumnR{
+			Todos: related,
+		}
+	} else {
+		o.R.Todos = append(o.R.Todos, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoR{
+				BoardColumn: o,
+			}
+		} else {
+			rel.R.BoardColumn = o
+		}
+	}
+	return nil
+}
+
+// SetTodos removes all previously related items of the
+// board_column replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.BoardColumn's Todos accordingly.
+// Replaces o.R.Todos with related.
+// Sets related.R.BoardColumn's Todos accordingly.
+func (o *BoardColumn) SetTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
+	query := "update `todos` set `board_column_id` = null where `board_column_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Todos {
+			queries.SetScanner(&rel.BoardColumnID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.BoardColumn = nil
+		}
+		o.R.Todos = nil
+	}
+
+	return o.AddTodos(ctx, exec, insert, related...)
+}
+
+// RemoveTodos relationships from objects passed in.
+// Removes related items from R.Todos (uses pointer comparison, removal does not keep order)
+// Sets related.R.BoardColumn.
+func (o *BoardColumn) RemoveTodos(ctx context.Context, exec boil.ContextExecutor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.BoardColumnID, nil)
+		if rel.R != nil {
+			rel.R.BoardColumn = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("board_column_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Todos {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Todos)
+			if ln > 1 && i < ln-1 {
+				o.R.Todos[i] = o.R.Todos[ln-1]
+			}
+			o.R.Todos = o.R.Todos[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// BoardColumns retrieves all the records using an executor.
+func BoardColumns(mods ...qm.QueryMod) boardColumnQuery {
+	mods = append(mods, qm.From("`board_columns`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`board_columns`.*"})
+	}
+
+	return boardColumnQuery{q}
+}
+
+// FindBoardColumn retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBoardColumn(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*BoardColumn, error) {
+	boardColumnObj := &BoardColumn{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `board_columns` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, boardColumnObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from board_columns")
+	}
+
+	if err = boardColumnObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return boardColumnObj, err
+	}
+
+	return boardColumnObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BoardColumn) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no board_columns provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardColumnColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	boardColumnInsertCacheMut.RLock()
+	cache, cached := boardColumnInsertCache[key]
+	boardColumnInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			boardColumnAllColumns,
+			boardColumnColumnsWithDefault,
+			boardColumnColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(boardColumnType, boardColumnMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(boardColumnType, boardColumnMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `board_columns` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `board_columns` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `board_columns` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, boardColumnPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into board_columns")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == boardColumnMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for board_columns")
+	}
+
+CacheNoHooks:
+	if !cached {
+		boardColumnInsertCacheMut.Lock()
+		boardColumnInsertCache[key] = cache
+		boardColumnInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BoardColumn.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BoardColumn) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	boardColumnUpdateCacheMut.RLock()
+	cache, cached := boardColumnUpdateCache[key]
+	boardColumnUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			boardColumnAllColumns,
+			boardColumnPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update board_columns, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `board_columns` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, boardColumnPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(boardColumnType, boardColumnMapping, append(wl, boardColumnPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update board_columns row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for board_columns")
+	}
+
+	if !cached {
+		boardColumnUpdateCacheMut.Lock()
+		boardColumnUpdateCache[key] = cache
+		boardColumnUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q boardColumnQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for board_columns")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for board_columns")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BoardColumnSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardColumnPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `board_columns` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, boardColumnPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in boardColumn slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all boardColumn")
+	}
+	return rowsAff, nil
+}
+
+var mySQLBoardColumnUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BoardColumn) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no board_columns provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardColumnColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLBoardColumnUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	boardColumnUpsertCacheMut.RLock()
+	cache, cached := boardColumnUpsertCache[key]
+	boardColumnUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			boardColumnAllColumns,
+			boardColumnColumnsWithDefault,
+			boardColumnColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			boardColumnAllColumns,
+			boardColumnPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert board_columns, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(boardColumnAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`board_columns`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `board_columns` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(boardColumnType, boardColumnMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(boardColumnType, boardColumnMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for board_columns")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == boardColumnMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(boardColumnType, boardColumnMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for board_columns")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for board_columns")
+	}
+
+CacheNoHooks:
+	if !cached {
+		boardColumnUpsertCacheMut.Lock()
+		boardColumnUpsertCache[key] = cache
+		boardColumnUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BoardColumn record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BoardColumn) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no BoardColumn provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), boardColumnPrimaryKeyMapping)
+	sql := "DELETE FROM `board_columns` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from board_columns")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for board_columns")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q boardColumnQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no boardColumnQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from board_columns")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for board_columns")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BoardColumnSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(boardColumnBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardColumnPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `board_columns` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, boardColumnPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from boardColumn slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for board_columns")
+	}
+
+	if len(boardColumnAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BoardColumn) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBoardColumn(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BoardColumnSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BoardColumnSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardColumnPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `board_columns`.* FROM `board_columns` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, boardColumnPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BoardColumnSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BoardColumnExists checks if the BoardColumn row exists.
+func BoardColumnExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `board_columns` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if board_columns exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BoardColumn row exists.
+func (o *BoardColumn) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BoardColumnExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	BoardColumnAllColumns            = boardColumnAllColumns
+	BoardColumnColumnsWithoutDefault = boardColumnColumnsWithoutDefault
+	BoardColumnColumnsWithDefault    = boardColumnColumnsWithDefault
+	BoardColumnPrimaryKeyColumns     = boardColumnPrimaryKeyColumns
+	BoardColumnGeneratedColumns      = boardColumnGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *BoardColumn) GetID() int {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s BoardColumnSlice) GetIDs() []int {
+	result := make([]int, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s BoardColumnSlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s BoardColumnSlice) ToIDMap() map[int]*BoardColumn {
+	result := make(map[int]*BoardColumn, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s BoardColumnSlice) ToUniqueItems() BoardColumnSlice {
+	result := make(BoardColumnSlice, 0, len(s))
+	mapChk := make(map[int]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s BoardColumnSlice) FindItemByID(id int) *BoardColumn {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s BoardColumnSlice) FindMissingItemIDs(expectedIDs []int) []int {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o BoardColumnSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			boardColumnAllColumns,
+			boardColumnColumnsWithDefault,
+			boardColumnColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(boardColumnColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range boardColumnAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `board_columns` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(boardColumnType, boardColumnMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from boardColumn slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for board_columns")
+	}
+
+	if len(boardColumnAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o BoardColumnSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o BoardColumnSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLBoardColumnUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			boardColumnAllColumns,
+			boardColumnColumnsWithDefault,
+			boardColumnColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(boardColumnColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range boardColumnAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		boardColumnAllColumns,
+		boardColumnPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert board_columns, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `board_columns`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `board_columns`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(boardColumnType, boardColumnMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
+
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to upsert for board_columns")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for board_columns")
+	}
+
+	if len(boardColumnAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllByPage delete all BoardColumn records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s BoardColumnSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpdateAllByPage update all BoardColumn records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s BoardColumnSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertAllByPage insert all BoardColumn records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s BoardColumnSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&BoardColumnColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all BoardColumn records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s BoardColumnSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&BoardColumnColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpsertAllByPage upsert all BoardColumn records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s BoardColumnSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&BoardColumnColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// LoadBoardsByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s BoardColumnSlice) LoadBoardsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadBoardsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s BoardColumnSlice) LoadBoardsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*BoardColumn](s, pageSize) {
+		if err := chunk[0].L.LoadBoard(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s BoardColumnSlice) GetLoadedBoards() KanbanBoardSlice {
+	result := make(KanbanBoardSlice, 0, len(s))
+	mapCheckDup := make(map[*KanbanBoard]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.Board == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.Board]; ok {
+			continue
+		}
+		result = append(result, item.R.Board)
+		mapCheckDup[item.R.Board] = struct{}{}
+	}
+	return result
+}
+
+// LoadTodosByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s BoardColumnSlice) LoadTodosByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodosByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s BoardColumnSlice) LoadTodosByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*BoardColumn](s, pageSize) {
+		if err := chunk[0].L.LoadTodos(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s BoardColumnSlice) GetLoadedTodos() TodoSlice {
+	result := make(TodoSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Todos == nil {
+			continue
+		}
+		result = append(result, item.R.Todos...)
+	}
+	return result
+}
+
+///////////////////////////////// END EXTENSIONS /////////////////////////////////
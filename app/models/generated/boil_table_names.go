@@ -4,11 +4,51 @@
 package models
 
 var TableNames = struct {
-	GorpMigrations string
-	Todos          string
-	Users          string
+	Activities              string
+	Attachments             string
+	BoardColumns            string
+	GorpMigrations          string
+	KanbanBoards            string
+	NotificationPreferences string
+	Notifications           string
+	Projects                string
+	SavedViews              string
+	TimeEntries             string
+	TodoComments            string
+	TodoDependencies        string
+	TodoReminderDeliveries  string
+	TodoReminders           string
+	TodoRevisions           string
+	TodoShares              string
+	TodoTags                string
+	TodoTemplateSubtasks    string
+	TodoTemplates           string
+	Todos                   string
+	Users                   string
+	WebhookDeliveries       string
+	Webhooks                string
 }{
-	GorpMigrations: "gorp_migrations",
-	Todos:          "todos",
-	Users:          "users",
+	Activities:              "activities",
+	Attachments:             "attachments",
+	BoardColumns:            "board_columns",
+	GorpMigrations:          "gorp_migrations",
+	KanbanBoards:            "kanban_boards",
+	NotificationPreferences: "notification_preferences",
+	Notifications:           "notifications",
+	Projects:                "projects",
+	SavedViews:              "saved_views",
+	TimeEntries:             "time_entries",
+	TodoComments:            "todo_comments",
+	TodoDependencies:        "todo_dependencies",
+	TodoReminderDeliveries:  "todo_reminder_deliveries",
+	TodoReminders:           "todo_reminders",
+	TodoRevisions:           "todo_revisions",
+	TodoShares:              "todo_shares",
+	TodoTags:                "todo_tags",
+	TodoTemplateSubtasks:    "todo_template_subtasks",
+	TodoTemplates:           "todo_templates",
+	Todos:                   "todos",
+	Users:                   "users",
+	WebhookDeliveries:       "webhook_deliveries",
+	Webhooks:                "webhooks",
 }
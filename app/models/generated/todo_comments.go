@@ -0,0 +1,2553 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TodoComment is an object representing the database table.
+type TodoComment struct {
+	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TodoID    int       `boil:"todo_id" json:"todo_id" toml:"todo_id" yaml:"todo_id"`
+	UserID    int       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Content   string    `boil:"content" json:"content" toml:"content" yaml:"content"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *todoCommentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L todoCommentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TodoCommentColumns = struct {
+	ID        string
+	TodoID    string
+	UserID    string
+	Content   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	TodoID:    "todo_id",
+	UserID:    "user_id",
+	Content:   "content",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var TodoCommentTableColumns = struct {
+	ID        string
+	TodoID    string
+	UserID    string
+	Content   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "todo_comments.id",
+	TodoID:    "todo_comments.todo_id",
+	UserID:    "todo_comments.user_id",
+	Content:   "todo_comments.content",
+	CreatedAt: "todo_comments.created_at",
+	UpdatedAt: "todo_comments.updated_at",
+}
+
+// Generated where
+
+var TodoCommentWhere = struct {
+	ID        whereHelperint
+	TodoID    whereHelperint
+	UserID    whereHelperint
+	Content   whereHelperstring
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "`todo_comments`.`id`"},
+	TodoID:    whereHelperint{field: "`todo_comments`.`todo_id`"},
+	UserID:    whereHelperint{field: "`todo_comments`.`user_id`"},
+	Content:   whereHelperstring{field: "`todo_comments`.`content`"},
+	CreatedAt: whereHelpertime_Time{field: "`todo_comments`.`created_at`"},
+	UpdatedAt: whereHelpertime_Time{field: "`todo_comments`.`updated_at`"},
+}
+
+// TodoCommentRels is where relationship names are stored.
+var TodoCommentRels = struct {
+	Todo          string
+	User          string
+	Activities    string
+	Notifications string
+}{
+	Todo:          "Todo",
+	User:          "User",
+	Activities:    "Activities",
+	Notifications: "Notifications",
+}
+
+// todoCommentR is where relationships are stored.
+type todoCommentR struct {
+	Todo          *Todo             `boil:"Todo" json:"Todo" toml:"Todo" yaml:"Todo"`
+	User          *User             `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Activities    ActivitySlice     `boil:"Activities" json:"Activities" toml:"Activities" yaml:"Activities"`
+	Notifications NotificationSlice `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+}
+
+// NewStruct creates a new relationship struct
+func (*todoCommentR) NewStruct() *todoCommentR {
+	return &todoCommentR{}
+}
+
+func (r *todoCommentR) GetTodo() *Todo {
+	if r == nil {
+		return nil
+	}
+	return r.Todo
+}
+
+func (r *todoCommentR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *todoCommentR) GetActivities() ActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Activities
+}
+
+func (r *todoCommentR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Notifications
+}
+
+// todoCommentL is where Load methods for each relationship are stored.
+type todoCommentL struct{}
+
+var (
+	todoCommentAllColumns            = []string{"id", "todo_id", "user_id", "content", "created_at", "updated_at"}
+	todoCommentColumnsWithoutDefault = []string{"todo_id", "user_id", "content", "created_at", "updated_at"}
+	todoCommentColumnsWithDefault    = []string{"id"}
+	todoCommentPrimaryKeyColumns     = []string{"id"}
+	todoCommentGeneratedColumns      = []string{}
+)
+
+type (
+	// TodoCommentSlice is an alias for a slice of pointers to TodoComment.
+	// This should almost always be used instead of []TodoComment.
+	TodoCommentSlice []*TodoComment
+	// TodoCommentHook is the signature for custom TodoComment hook methods
+	TodoCommentHook func(context.Context, boil.ContextExecutor, *TodoComment) error
+
+	todoCommentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	todoCommentType                 = reflect.TypeOf(&TodoComment{})
+	todoCommentMapping              = queries.MakeStructMapping(todoCommentType)
+	todoCommentPrimaryKeyMapping, _ = queries.BindMapping(todoCommentType, todoCommentMapping, todoCommentPrimaryKeyColumns)
+	todoCommentInsertCacheMut       sync.RWMutex
+	todoCommentInsertCache          = make(map[string]insertCache)
+	todoCommentUpdateCacheMut       sync.RWMutex
+	todoCommentUpdateCache          = make(map[string]updateCache)
+	todoCommentUpsertCacheMut       sync.RWMutex
+	todoCommentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var todoCommentAfterSelectMu sync.Mutex
+var todoCommentAfterSelectHooks []TodoCommentHook
+
+var todoCommentBeforeInsertMu sync.Mutex
+var todoCommentBeforeInsertHooks []TodoCommentHook
+var todoCommentAfterInsertMu sync.Mutex
+var todoCommentAfterInsertHooks []TodoCommentHook
+
+var todoCommentBeforeUpdateMu sync.Mutex
+var todoCommentBeforeUpdateHooks []TodoCommentHook
+var todoCommentAfterUpdateMu sync.Mutex
+var todoCommentAfterUpdateHooks []TodoCommentHook
+
+var todoCommentBeforeDeleteMu sync.Mutex
+var todoCommentBeforeDeleteHooks []TodoCommentHook
+var todoCommentAfterDeleteMu sync.Mutex
+var todoCommentAfterDeleteHooks []TodoCommentHook
+
+var todoCommentBeforeUpsertMu sync.Mutex
+var todoCommentBeforeUpsertHooks []TodoCommentHook
+var todoCommentAfterUpsertMu sync.Mutex
+var todoCommentAfterUpsertHooks []TodoCommentHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TodoComment) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TodoComment) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TodoComment) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TodoComment) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TodoComment) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TodoComment) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TodoComment) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TodoComment) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TodoComment) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoCommentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTodoCommentHook registers your hook function for all future operations.
+func AddTodoCommentHook(hookPoint boil.HookPoint, todoCommentHook TodoCommentHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		todoCommentAfterSelectMu.Lock()
+		todoCommentAfterSelectHooks = append(todoCommentAfterSelectHooks, todoCommentHook)
+		todoCommentAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		todoCommentBeforeInsertMu.Lock()
+		todoCommentBeforeInsertHooks = append(todoCommentBeforeInsertHooks, todoCommentHook)
+		todoCommentBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		todoCommentAfterInsertMu.Lock()
+		todoCommentAfterInsertHooks = append(todoCommentAfterInsertHooks, todoCommentHook)
+		todoCommentAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		todoCommentBeforeUpdateMu.Lock()
+		todoCommentBeforeUpdateHooks = append(todoCommentBeforeUpdateHooks, todoCommentHook)
+		todoCommentBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		todoCommentAfterUpdateMu.Lock()
+		todoCommentAfterUpdateHooks = append(todoCommentAfterUpdateHooks, todoCommentHook)
+		todoCommentAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		todoCommentBeforeDeleteMu.Lock()
+		todoCommentBeforeDeleteHooks = append(todoCommentBeforeDeleteHooks, todoCommentHook)
+		todoCommentBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		todoCommentAfterDeleteMu.Lock()
+		todoCommentAfterDeleteHooks = append(todoCommentAfterDeleteHooks, todoCommentHook)
+		todoCommentAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		todoCommentBeforeUpsertMu.Lock()
+		todoCommentBeforeUpsertHooks = append(todoCommentBeforeUpsertHooks, todoCommentHook)
+		todoCommentBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		todoCommentAfterUpsertMu.Lock()
+		todoCommentAfterUpsertHooks = append(todoCommentAfterUpsertHooks, todoCommentHook)
+		todoCommentAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single todoComment record from the query.
+func (q todoCommentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TodoComment, error) {
+	o := &TodoComment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for todo_comments")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TodoComment records from the query.
+func (q todoCommentQuery) All(ctx context.Context, exec boil.ContextExecutor) (TodoCommentSlice, error) {
+	var o []*TodoComment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TodoComment slice")
+	}
+
+	if len(todoCommentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TodoComment records in the query.
+func (q todoCommentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count todo_comments rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q todoCommentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if todo_comments exists")
+	}
+
+	return count > 0, nil
+}
+
+// Todo pointed to by the foreign key.
+func (o *TodoComment) Todo(mods ...qm.QueryMod) todoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TodoID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Todos(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *TodoComment) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Activities retrieves all the activity's Activities with an executor.
+func (o *TodoComment) Activities(mods ...qm.QueryMod) activityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`activities`.`todo_comment_id`=?", o.ID),
+	)
+
+	return Activities(queryMods...)
+}
+
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *TodoComment) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notifications`.`todo_comment_id`=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// LoadTodo allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoCommentL) LoadTodo(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoComment interface{}, mods queries.Applicator) error {
+	var slice []*TodoComment
+	var object *TodoComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoComment.(*TodoComment)
+		if !ok {
+			object = new(TodoComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoComment))
+			}
+		}
+	} else {
+		s, ok := maybeTodoComment.(*[]*TodoComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoCommentR{}
+		}
+		args[object.TodoID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoCommentR{}
+			}
+
+			args[obj.TodoID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`todos.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Todo")
+	}
+
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Todo")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Todo = foreign
+		if foreign.R == nil {
+			foreign.R = &todoR{}
+		}
+		foreign.R.TodoComments = append(foreign.R.TodoComments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TodoID == foreign.ID {
+				local.R.Todo = foreign
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.TodoComments = append(foreign.R.TodoComments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoCommentL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoComment interface{}, mods queries.Applicator) error {
+	var slice []*TodoComment
+	var object *TodoComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoComment.(*TodoComment)
+		if !ok {
+			object = new(TodoComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoComment))
+			}
+		}
+	} else {
+		s, ok := maybeTodoComment.(*[]*TodoComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoCommentR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoCommentR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TodoComments = append(foreign.R.TodoComments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TodoComments = append(foreign.R.TodoComments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoCommentL) LoadActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoComment interface{}, mods queries.Applicator) error {
+	var slice []*TodoComment
+	var object *TodoComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoComment.(*TodoComment)
+		if !ok {
+			object = new(TodoComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoComment))
+			}
+		}
+	} else {
+		s, ok := maybeTodoComment.(*[]*TodoComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoCommentR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoCommentR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`activities`),
+		qm.WhereIn(`activities.todo_comment_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load activities")
+	}
+
+	var resultSlice []*Activity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice activities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for activities")
+	}
+
+	if len(activityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Activities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &activityR{}
+			}
+			foreign.R.TodoComment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TodoCommentID) {
+				local.R.Activities = append(local.R.Activities, foreign)
+				if foreign.R == nil {
+					foreign.R = &activityR{}
+				}
+				foreign.R.TodoComment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoCommentL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoComment interface{}, mods queries.Applicator) error {
+	var slice []*TodoComment
+	var object *TodoComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoComment.(*TodoComment)
+		if !ok {
+			object = new(TodoComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoComment))
+			}
+		}
+	} else {
+		s, ok := maybeTodoComment.(*[]*TodoComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoCommentR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoCommentR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.todo_comment_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.TodoComment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TodoCommentID) {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.TodoComment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTodo of the todoComment to the related item.
+// Sets o.R.Todo to related.
+// Adds o to related.R.TodoComments.
+func (o *TodoComment) SetTodo(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Todo) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todo_comments` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+		strmangle.WhereClause("`", "`", 0, todoCommentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TodoID = related.ID
+	if o.R == nil {
+		o.R = &todoCommentR{
+			Todo: related,
+		}
+	} else {
+		o.R.Todo = related
+	}
+
+	if related.R == nil {
+		related.R = &todoR{
+			TodoComments: TodoCommentSlice{o},
+		}
+	} else {
+		related.R.TodoComments = append(related.R.TodoComments, o)
+	}
+
+	return nil
+}
+
+// SetUser of the todoComment to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TodoComments.
+func (o *TodoComment) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todo_comments` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, todoCommentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &todoCommentR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TodoComments: TodoCommentSlice{o},
+		}
+	} else {
+		related.R.TodoComments = append(related.R.TodoComments, o)
+	}
+
+	return nil
+}
+
+// AddActivities adds the given related objects to the existing relationships
+// of the todo_comment, optionally inserting them as new records.
+// Appends related to o.R.Activities.
+// Sets related.R.TodoComment appropriately.
+func (o *TodoComment) AddActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Activity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TodoCommentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `activities` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_comment_id"}),
+				strmangle.WhereClause("`", "`", 0, activityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TodoCommentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoCommentR{
+			Activities: related,
+		}
+	} else {
+		o.R.Activities = append(o.R.Activities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &activityR{
+				TodoComment: o,
+			}
+		} else {
+			rel.R.TodoComment = o
+		}
+	}
+	return nil
+}
+
+// SetActivities removes all previously related items of the
+// todo_comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TodoComment's Activities accordingly.
+// Replaces o.R.Activities with related.
+// Sets related.R.TodoComment's Activities accordingly.
+func (o *TodoComment) SetActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Activity) error {
+	query := "update `activities` set `todo_comment_id` = null where `todo_comment_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Activities {
+			queries.SetScanner(&rel.TodoCommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TodoComment = nil
+		}
+		o.R.Activities = nil
+	}
+
+	return o.AddActivities(ctx, exec, insert, related...)
+}
+
+// RemoveActivities relationships from objects passed in.
+// Removes related items from R.Activities (uses pointer comparison, removal does not keep order)
+// Sets related.R.TodoComment.
+func (o *TodoComment) RemoveActivities(ctx context.Context, exec boil.ContextExecutor, related ...*Activity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TodoCommentID, nil)
+		if rel.R != nil {
+			rel.R.TodoComment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("todo_comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Activities {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Activities)
+			if ln > 1 && i < ln-1 {
+				o.R.Activities[i] = o.R.Activities[ln-1]
+			}
+			o.R.Activities = o.R.Activities[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the todo_comment, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.TodoComment appropriately.
+func (o *TodoComment) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TodoCommentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notifications` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_comment_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TodoCommentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoCommentR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				TodoComment: o,
+			}
+		} else {
+			rel.R.TodoComment = o
+		}
+	}
+	return nil
+}
+
+// SetNotifications removes all previously related items of the
+// todo_comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.TodoComment's Notifications accordingly.
+// Replaces o.R.Notifications with related.
+// Sets related.R.TodoComment's Notifications accordingly.
+func (o *TodoComment) SetNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	query := "update `notifications` set `todo_comment_id` = null where `todo_comment_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Notifications {
+			queries.SetScanner(&rel.TodoCommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.TodoComment = nil
+		}
+		o.R.Notifications = nil
+	}
+
+	return o.AddNotifications(ctx, exec, insert, related...)
+}
+
+// RemoveNotifications relationships from objects passed in.
+// Removes related items from R.Notifications (uses pointer comparison, removal does not keep order)
+// Sets related.R.TodoComment.
+func (o *TodoComment) RemoveNotifications(ctx context.Context, exec boil.ContextExecutor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TodoCommentID, nil)
+		if rel.R != nil {
+			rel.R.TodoComment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("todo_comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Notifications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Notifications)
+			if ln > 1 && i < ln-1 {
+				o.R.Notifications[i] = o.R.Notifications[ln-1]
+			}
+			o.R.Notifications = o.R.Notifications[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// TodoComments retrieves all the records using an executor.
+func TodoComments(mods ...qm.QueryMod) todoCommentQuery {
+	mods = append(mods, qm.From("`todo_comments`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`todo_comments`.*"})
+	}
+
+	return todoCommentQuery{q}
+}
+
+// FindTodoComment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTodoComment(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TodoComment, error) {
+	todoCommentObj := &TodoComment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `todo_comments` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, todoCommentObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from todo_comments")
+	}
+
+	if err = todoCommentObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return todoCommentObj, err
+	}
+
+	return todoCommentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TodoComment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_comments provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoCommentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	todoCommentInsertCacheMut.RLock()
+	cache, cached := todoCommentInsertCache[key]
+	todoCommentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			todoCommentAllColumns,
+			todoCommentColumnsWithDefault,
+			todoCommentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoCommentType, todoCommentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(todoCommentType, todoCommentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `todo_comments` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `todo_comments` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `todo_comments` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, todoCommentPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into todo_comments")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoCommentMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_comments")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoCommentInsertCacheMut.Lock()
+		todoCommentInsertCache[key] = cache
+		todoCommentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TodoComment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TodoComment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	todoCommentUpdateCacheMut.RLock()
+	cache, cached := todoCommentUpdateCache[key]
+	todoCommentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			todoCommentAllColumns,
+			todoCommentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update todo_comments, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `todo_comments` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, todoCommentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(todoCommentType, todoCommentMapping, append(wl, todoCommentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update todo_comments row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for todo_comments")
+	}
+
+	if !cached {
+		todoCommentUpdateCacheMut.Lock()
+		todoCommentUpdateCache[key] = cache
+		todoCommentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q todoCommentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for todo_comments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for todo_comments")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TodoCommentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoCommentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `todo_comments` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoCommentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in todoComment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all todoComment")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTodoCommentUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TodoComment) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_comments provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoCommentColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTodoCommentUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	todoCommentUpsertCacheMut.RLock()
+	cache, cached := todoCommentUpsertCache[key]
+	todoCommentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			todoCommentAllColumns,
+			todoCommentColumnsWithDefault,
+			todoCommentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			todoCommentAllColumns,
+			todoCommentPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert todo_comments, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(todoCommentAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`todo_comments`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `todo_comments` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoCommentType, todoCommentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(todoCommentType, todoCommentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for todo_comments")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoCommentMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(todoCommentType, todoCommentMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for todo_comments")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_comments")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoCommentUpsertCacheMut.Lock()
+		todoCommentUpsertCache[key] = cache
+		todoCommentUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TodoComment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TodoComment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TodoComment provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), todoCommentPrimaryKeyMapping)
+	sql := "DELETE FROM `todo_comments` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from todo_comments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for todo_comments")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q todoCommentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no todoCommentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todo_comments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_comments")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TodoCommentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(todoCommentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoCommentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `todo_comments` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoCommentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todoComment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_comments")
+	}
+
+	if len(todoCommentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TodoComment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTodoComment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TodoCommentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TodoCommentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoCommentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `todo_comments`.* FROM `todo_comments` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoCommentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TodoCommentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TodoCommentExists checks if the TodoComment row exists.
+func TodoCommentExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `todo_comments` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if todo_comments exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TodoComment row exists.
+func (o *TodoComment) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TodoCommentExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	TodoCommentAllColumns            = todoCommentAllColumns
+	TodoCommentColumnsWithoutDefault = todoCommentColumnsWithoutDefault
+	TodoCommentColumnsWithDefault    = todoCommentColumnsWithDefault
+	TodoCommentPrimaryKeyColumns     = todoCommentPrimaryKeyColumns
+	TodoCommentGeneratedColumns      = todoCommentGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *TodoComment) GetID() int {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s TodoCommentSlice) GetIDs() []int {
+	result := make([]int, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s TodoCommentSlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s TodoCommentSlice) ToIDMap() map[int]*TodoComment {
+	result := make(map[int]*TodoComment, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s TodoCommentSlice) ToUniqueItems() TodoCommentSlice {
+	result := make(TodoCommentSlice, 0, len(s))
+	mapChk := make(map[int]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s TodoCommentSlice) FindItemByID(id int) *TodoComment {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s TodoCommentSlice) FindMissingItemIDs(expectedIDs []int) []int {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoCommentSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			todoCommentAllColumns,
+			todoCommentColumnsWithDefault,
+			todoCommentColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoCommentColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range todoCommentAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `todo_comments` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(todoCommentType, todoCommentMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from todoComment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for todo_comments")
+	}
+
+	if len(todoCommentAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoCommentSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoCommentSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLTodoCommentUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			todoCommentAllColumns,
+			todoCommentColumnsWithDefault,
+			todoCommentColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoCommentColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range todoCommentAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		todoCommentAllColumns,
+		todoCommentPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert todo_comments, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `todo_comments`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `todo_comments`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(todoCommentType, todoCommentMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
+
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to upsert for todo_comments")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for todo_comments")
+	}
+
+	if len(todoCommentAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllByPage delete all TodoComment records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoCommentSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpdateAllByPage update all TodoComment records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoCommentSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertAllByPage insert all TodoComment records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoCommentSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoCommentColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all TodoComment records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s TodoCommentSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoCommentColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpsertAllByPage upsert all TodoComment records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoCommentSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoCommentColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// LoadTodosByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoCommentSlice) LoadTodosByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodosByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoCommentSlice) LoadTodosByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*TodoComment](s, pageSize) {
+		if err := chunk[0].L.LoadTodo(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s TodoCommentSlice) GetLoadedTodos() TodoSlice {
+	result := make(TodoSlice, 0, len(s))
+	mapCheckDup := make(map[*Todo]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.Todo == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.Todo]; ok {
+			continue
+		}
+		result = append(result, item.R.Todo)
+		mapCheckDup[item.R.Todo] = struct{}{}
+	}
+	return result
+}
+
+// LoadUsersByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoCommentSlice) LoadUsersByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadUsersByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoCommentSlice) LoadUsersByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*TodoComment](s, pageSize) {
+		if err := chunk[0].L.LoadUser(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s TodoCommentSlice) GetLoadedUsers() UserSlice {
+	result := make(UserSlice, 0, len(s))
+	mapCheckDup := make(map[*User]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.User == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.User]; ok {
+			continue
+		}
+		result = append(result, item.R.User)
+		mapCheckDup[item.R.User] = struct{}{}
+	}
+	return result
+}
+
+// LoadActivitiesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoCommentSlice) LoadActivitiesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadActivitiesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoCommentSlice) LoadActivitiesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*TodoComment](s, pageSize) {
+		if err := chunk[0].L.LoadActivities(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s TodoCommentSlice) GetLoadedActivities() ActivitySlice {
+	result := make(ActivitySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Activities == nil {
+			continue
+		}
+		result = append(result, item.R.Activities...)
+	}
+	return result
+}
+
+// LoadNotificationsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoCommentSlice) LoadNotificationsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadNotificationsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoCommentSlice) LoadNotificationsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*TodoComment](s, pageSize) {
+		if err := chunk[0].L.LoadNotifications(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s TodoCommentSlice) GetLoadedNotifications() NotificationSlice {
+	result := make(NotificationSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Notifications == nil {
+			continue
+		}
+		result = append(result, item.R.Notifications...)
+	}
+	return result
+}
+
+///////////////////////////////// END EXTENSIONS /////////////////////////////////
@@ -0,0 +1,1750 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TodoReminderDelivery is an object representing the database table.
+type TodoReminderDelivery struct {
+	ID             int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TodoReminderID int       `boil:"todo_reminder_id" json:"todo_reminder_id" toml:"todo_reminder_id" yaml:"todo_reminder_id"`
+	Channel        string    `boil:"channel" json:"channel" toml:"channel" yaml:"channel"`
+	SentAt         time.Time `boil:"sent_at" json:"sent_at" toml:"sent_at" yaml:"sent_at"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *todoReminderDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L todoReminderDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TodoReminderDeliveryColumns = struct {
+	ID             string
+	TodoReminderID string
+	Channel        string
+	SentAt         string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	TodoReminderID: "todo_reminder_id",
+	Channel:        "channel",
+	SentAt:         "sent_at",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var TodoReminderDeliveryTableColumns = struct {
+	ID             string
+	TodoReminderID string
+	Channel        string
+	SentAt         string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "todo_reminder_deliveries.id",
+	TodoReminderID: "todo_reminder_deliveries.todo_reminder_id",
+	Channel:        "todo_reminder_deliveries.channel",
+	SentAt:         "todo_reminder_deliveries.sent_at",
+	CreatedAt:      "todo_reminder_deliveries.created_at",
+	UpdatedAt:      "todo_reminder_deliveries.updated_at",
+}
+
+// Generated where
+
+var TodoReminderDeliveryWhere = struct {
+	ID             whereHelperint
+	TodoReminderID whereHelperint
+	Channel        whereHelperstring
+	SentAt         whereHelpertime_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperint{field: "`todo_reminder_deliveries`.`id`"},
+	TodoReminderID: whereHelperint{field: "`todo_reminder_deliveries`.`todo_reminder_id`"},
+	Channel:        whereHelperstring{field: "`todo_reminder_deliveries`.`channel`"},
+	SentAt:         whereHelpertime_Time{field: "`todo_reminder_deliveries`.`sent_at`"},
+	CreatedAt:      whereHelpertime_Time{field: "`todo_reminder_deliveries`.`created_at`"},
+	UpdatedAt:      whereHelpertime_Time{field: "`todo_reminder_deliveries`.`updated_at`"},
+}
+
+// TodoReminderDeliveryRels is where relationship names are stored.
+var TodoReminderDeliveryRels = struct {
+	TodoReminder string
+}{
+	TodoReminder: "TodoReminder",
+}
+
+// todoReminderDeliveryR is where relationships are stored.
+type todoReminderDeliveryR struct {
+	TodoReminder *TodoReminder `boil:"TodoReminder" json:"TodoReminder" toml:"TodoReminder" yaml:"TodoReminder"`
+}
+
+// NewStruct creates a new relationship struct
+func (*todoReminderDeliveryR) NewStruct() *todoReminderDeliveryR {
+	return &todoReminderDeliveryR{}
+}
+
+func (r *todoReminderDeliveryR) GetTodoReminder() *TodoReminder {
+	if r == nil {
+		return nil
+	}
+	return r.TodoReminder
+}
+
+// todoReminderDeliveryL is where Load methods for each relationship are stored.
+type todoReminderDeliveryL struct{}
+
+var (
+	todoReminderDeliveryAllColumns            = []string{"id", "todo_reminder_id", "channel", "sent_at", "created_at", "updated_at"}
+	todoReminderDeliveryColumnsWithoutDefault = []string{"todo_reminder_id", "channel", "sent_at", "created_at", "updated_at"}
+	todoReminderDeliveryColumnsWithDefault    = []string{"id"}
+	todoReminderDeliveryPrimaryKeyColumns     = []string{"id"}
+	todoReminderDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// TodoReminderDeliverySlice is an alias for a slice of pointers to TodoReminderDelivery.
+	// This should almost always be used instead of []TodoReminderDelivery.
+	TodoReminderDeliverySlice []*TodoReminderDelivery
+	// TodoReminderDeliveryHook is the signature for custom TodoReminderDelivery hook methods
+	TodoReminderDeliveryHook func(context.Context, boil.ContextExecutor, *TodoReminderDelivery) error
+
+	todoReminderDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	todoReminderDeliveryType                 = reflect.TypeOf(&TodoReminderDelivery{})
+	todoReminderDeliveryMapping              = queries.MakeStructMapping(todoReminderDeliveryType)
+	todoReminderDeliveryPrimaryKeyMapping, _ = queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, todoReminderDeliveryPrimaryKeyColumns)
+	todoReminderDeliveryInsertCacheMut       sync.RWMutex
+	todoReminderDeliveryInsertCache          = make(map[string]insertCache)
+	todoReminderDeliveryUpdateCacheMut       sync.RWMutex
+	todoReminderDeliveryUpdateCache          = make(map[string]updateCache)
+	todoReminderDeliveryUpsertCacheMut       sync.RWMutex
+	todoReminderDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var todoReminderDeliveryAfterSelectMu sync.Mutex
+var todoReminderDeliveryAfterSelectHooks []TodoReminderDeliveryHook
+
+var todoReminderDeliveryBeforeInsertMu sync.Mutex
+var todoReminderDeliveryBeforeInsertHooks []TodoReminderDeliveryHook
+var todoReminderDeliveryAfterInsertMu sync.Mutex
+var todoReminderDeliveryAfterInsertHooks []TodoReminderDeliveryHook
+
+var todoReminderDeliveryBeforeUpdateMu sync.Mutex
+var todoReminderDeliveryBeforeUpdateHooks []TodoReminderDeliveryHook
+var todoReminderDeliveryAfterUpdateMu sync.Mutex
+var todoReminderDeliveryAfterUpdateHooks []TodoReminderDeliveryHook
+
+var todoReminderDeliveryBeforeDeleteMu sync.Mutex
+var todoReminderDeliveryBeforeDeleteHooks []TodoReminderDeliveryHook
+var todoReminderDeliveryAfterDeleteMu sync.Mutex
+var todoReminderDeliveryAfterDeleteHooks []TodoReminderDeliveryHook
+
+var todoReminderDeliveryBeforeUpsertMu sync.Mutex
+var todoReminderDeliveryBeforeUpsertHooks []TodoReminderDeliveryHook
+var todoReminderDeliveryAfterUpsertMu sync.Mutex
+var todoReminderDeliveryAfterUpsertHooks []TodoReminderDeliveryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TodoReminderDelivery) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TodoReminderDelivery) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TodoReminderDelivery) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TodoReminderDelivery) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TodoReminderDelivery) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TodoReminderDelivery) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TodoReminderDelivery) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TodoReminderDelivery) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TodoReminderDelivery) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoReminderDeliveryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTodoReminderDeliveryHook registers your hook function for all future operations.
+func AddTodoReminderDeliveryHook(hookPoint boil.HookPoint, todoReminderDeliveryHook TodoReminderDeliveryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		todoReminderDeliveryAfterSelectMu.Lock()
+		todoReminderDeliveryAfterSelectHooks = append(todoReminderDeliveryAfterSelectHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		todoReminderDeliveryBeforeInsertMu.Lock()
+		todoReminderDeliveryBeforeInsertHooks = append(todoReminderDeliveryBeforeInsertHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		todoReminderDeliveryAfterInsertMu.Lock()
+		todoReminderDeliveryAfterInsertHooks = append(todoReminderDeliveryAfterInsertHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		todoReminderDeliveryBeforeUpdateMu.Lock()
+		todoReminderDeliveryBeforeUpdateHooks = append(todoReminderDeliveryBeforeUpdateHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		todoReminderDeliveryAfterUpdateMu.Lock()
+		todoReminderDeliveryAfterUpdateHooks = append(todoReminderDeliveryAfterUpdateHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		todoReminderDeliveryBeforeDeleteMu.Lock()
+		todoReminderDeliveryBeforeDeleteHooks = append(todoReminderDeliveryBeforeDeleteHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		todoReminderDeliveryAfterDeleteMu.Lock()
+		todoReminderDeliveryAfterDeleteHooks = append(todoReminderDeliveryAfterDeleteHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		todoReminderDeliveryBeforeUpsertMu.Lock()
+		todoReminderDeliveryBeforeUpsertHooks = append(todoReminderDeliveryBeforeUpsertHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		todoReminderDeliveryAfterUpsertMu.Lock()
+		todoReminderDeliveryAfterUpsertHooks = append(todoReminderDeliveryAfterUpsertHooks, todoReminderDeliveryHook)
+		todoReminderDeliveryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single todoReminderDelivery record from the query.
+func (q todoReminderDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TodoReminderDelivery, error) {
+	o := &TodoReminderDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for todo_reminder_deliveries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TodoReminderDelivery records from the query.
+func (q todoReminderDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (TodoReminderDeliverySlice, error) {
+	var o []*TodoReminderDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TodoReminderDelivery slice")
+	}
+
+	if len(todoReminderDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TodoReminderDelivery records in the query.
+func (q todoReminderDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count todo_reminder_deliveries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q todoReminderDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if todo_reminder_deliveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// TodoReminder pointed to by the foreign key.
+func (o *TodoReminderDelivery) TodoReminder(mods ...qm.QueryMod) todoReminderQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TodoReminderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TodoReminders(queryMods...)
+}
+
+// LoadTodoReminder allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoReminderDeliveryL) LoadTodoReminder(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoReminderDelivery interface{}, mods queries.Applicator) error {
+	var slice []*TodoReminderDelivery
+	var object *TodoReminderDelivery
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoReminderDelivery.(*TodoReminderDelivery)
+		if !ok {
+			object = new(TodoReminderDelivery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoReminderDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoReminderDelivery))
+			}
+		}
+	} else {
+		s, ok := maybeTodoReminderDelivery.(*[]*TodoReminderDelivery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoReminderDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoReminderDelivery))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoReminderDeliveryR{}
+		}
+		args[object.TodoReminderID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoReminderDeliveryR{}
+			}
+
+			args[obj.TodoReminderID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_reminders`),
+		qm.WhereIn(`todo_reminders.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TodoReminder")
+	}
+
+	var resultSlice []*TodoReminder
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TodoReminder")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for todo_reminders")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_reminders")
+	}
+
+	if len(todoReminderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TodoReminder = foreign
+		if foreign.R == nil {
+			foreign.R = &todoReminderR{}
+		}
+		foreign.R.TodoReminderDeliveries = append(foreign.R.TodoReminderDeliveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TodoReminderID == foreign.ID {
+				local.R.TodoReminder = foreign
+				if foreign.R == nil {
+					foreign.R = &todoReminderR{}
+				}
+				foreign.R.TodoReminderDeliveries = append(foreign.R.TodoReminderDeliveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTodoReminder of the todoReminderDelivery to the related item.
+// Sets o.R.TodoReminder to related.
+// Adds o to related.R.TodoReminderDeliveries.
+func (o *TodoReminderDelivery) SetTodoReminder(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TodoReminder) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todo_reminder_deliveries` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"todo_reminder_id"}),
+		strmangle.WhereClause("`", "`", 0, todoReminderDeliveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TodoReminderID = related.ID
+	if o.R == nil {
+		o.R = &todoReminderDeliveryR{
+			TodoReminder: related,
+		}
+	} else {
+		o.R.TodoReminder = related
+	}
+
+	if related.R == nil {
+		related.R = &todoReminderR{
+			TodoReminderDeliveries: TodoReminderDeliverySlice{o},
+		}
+	} else {
+		related.R.TodoReminderDeliveries = append(related.R.TodoReminderDeliveries, o)
+	}
+
+	return nil
+}
+
+// TodoReminderDeliveries retrieves all the records using an executor.
+func TodoReminderDeliveries(mods ...qm.QueryMod) todoReminderDeliveryQuery {
+	mods = append(mods, qm.From("`todo_reminder_deliveries`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`todo_reminder_deliveries`.*"})
+	}
+
+	return todoReminderDeliveryQuery{q}
+}
+
+// FindTodoReminderDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTodoReminderDelivery(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TodoReminderDelivery, error) {
+	todoReminderDeliveryObj := &TodoReminderDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `todo_reminder_deliveries` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, todoReminderDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from todo_reminder_deliveries")
+	}
+
+	if err = todoReminderDeliveryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return todoReminderDeliveryObj, err
+	}
+
+	return todoReminderDeliveryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TodoReminderDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_reminder_deliveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoReminderDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	todoReminderDeliveryInsertCacheMut.RLock()
+	cache, cached := todoReminderDeliveryInsertCache[key]
+	todoReminderDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			todoReminderDeliveryAllColumns,
+			todoReminderDeliveryColumnsWithDefault,
+			todoReminderDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `todo_reminder_deliveries` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `todo_reminder_deliveries` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `todo_reminder_deliveries` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, todoReminderDeliveryPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into todo_reminder_deliveries")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoReminderDeliveryMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_reminder_deliveries")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoReminderDeliveryInsertCacheMut.Lock()
+		todoReminderDeliveryInsertCache[key] = cache
+		todoReminderDeliveryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TodoReminderDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TodoReminderDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	todoReminderDeliveryUpdateCacheMut.RLock()
+	cache, cached := todoReminderDeliveryUpdateCache[key]
+	todoReminderDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			todoReminderDeliveryAllColumns,
+			todoReminderDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update todo_reminder_deliveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `todo_reminder_deliveries` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, todoReminderDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, append(wl, todoReminderDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update todo_reminder_deliveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for todo_reminder_deliveries")
+	}
+
+	if !cached {
+		todoReminderDeliveryUpdateCacheMut.Lock()
+		todoReminderDeliveryUpdateCache[key] = cache
+		todoReminderDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q todoReminderDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for todo_reminder_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for todo_reminder_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TodoReminderDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoReminderDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `todo_reminder_deliveries` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoReminderDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in todoReminderDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all todoReminderDelivery")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTodoReminderDeliveryUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TodoReminderDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_reminder_deliveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoReminderDeliveryColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTodoReminderDeliveryUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	todoReminderDeliveryUpsertCacheMut.RLock()
+	cache, cached := todoReminderDeliveryUpsertCache[key]
+	todoReminderDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			todoReminderDeliveryAllColumns,
+			todoReminderDeliveryColumnsWithDefault,
+			todoReminderDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			todoReminderDeliveryAllColumns,
+			todoReminderDeliveryPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert todo_reminder_deliveries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(todoReminderDeliveryAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`todo_reminder_deliveries`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `todo_reminder_deliveries` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for todo_reminder_deliveries")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoReminderDeliveryMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for todo_reminder_deliveries")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_reminder_deliveries")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoReminderDeliveryUpsertCacheMut.Lock()
+		todoReminderDeliveryUpsertCache[key] = cache
+		todoReminderDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TodoReminderDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TodoReminderDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TodoReminderDelivery provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), todoReminderDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM `todo_reminder_deliveries` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from todo_reminder_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for todo_reminder_deliveries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q todoReminderDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no todoReminderDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todo_reminder_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_reminder_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TodoReminderDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(todoReminderDeliveryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoReminderDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `todo_reminder_deliveries` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoReminderDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todoReminderDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_reminder_deliveries")
+	}
+
+	if len(todoReminderDeliveryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TodoReminderDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTodoReminderDelivery(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TodoReminderDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TodoReminderDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoReminderDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `todo_reminder_deliveries`.* FROM `todo_reminder_deliveries` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoReminderDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TodoReminderDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TodoReminderDeliveryExists checks if the TodoReminderDelivery row exists.
+func TodoReminderDeliveryExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `todo_reminder_deliveries` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if todo_reminder_deliveries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TodoReminderDelivery row exists.
+func (o *TodoReminderDelivery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TodoReminderDeliveryExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	TodoReminderDeliveryAllColumns            = todoReminderDeliveryAllColumns
+	TodoReminderDeliveryColumnsWithoutDefault = todoReminderDeliveryColumnsWithoutDefault
+	TodoReminderDeliveryColumnsWithDefault    = todoReminderDeliveryColumnsWithDefault
+	TodoReminderDeliveryPrimaryKeyColumns     = todoReminderDeliveryPrimaryKeyColumns
+	TodoReminderDeliveryGeneratedColumns      = todoReminderDeliveryGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *TodoReminderDelivery) GetID() int {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s TodoReminderDeliverySlice) GetIDs() []int {
+	result := make([]int, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s TodoReminderDeliverySlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s TodoReminderDeliverySlice) ToIDMap() map[int]*TodoReminderDelivery {
+	result := make(map[int]*TodoReminderDelivery, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s TodoReminderDeliverySlice) ToUniqueItems() TodoReminderDeliverySlice {
+	result := make(TodoReminderDeliverySlice, 0, len(s))
+	mapChk := make(map[int]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s TodoReminderDeliverySlice) FindItemByID(id int) *TodoReminderDelivery {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s TodoReminderDeliverySlice) FindMissingItemIDs(expectedIDs []int) []int {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoReminderDeliverySlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			todoReminderDeliveryAllColumns,
+			todoReminderDeliveryColumnsWithDefault,
+			todoReminderDeliveryColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoReminderDeliveryColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range todoReminderDeliveryAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `todo_reminder_deliveries` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from todoReminderDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for todo_reminder_deliveries")
+	}
+
+	if len(todoReminderDeliveryAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoReminderDeliverySlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoReminderDeliverySlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLTodoReminderDeliveryUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			todoReminderDeliveryAllColumns,
+			todoReminderDeliveryColumnsWithDefault,
+			todoReminderDeliveryColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoReminderDeliveryColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range todoReminderDeliveryAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		todoReminderDeliveryAllColumns,
+		todoReminderDeliveryPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert todo_reminder_deliveries, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `todo_reminder_deliveries`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `todo_reminder_deliveries`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(todoReminderDeliveryType, todoReminderDeliveryMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
+
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to upsert for todo_reminder_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for todo_reminder_deliveries")
+	}
+
+	if len(todoReminderDeliveryAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllByPage delete all TodoReminderDelivery records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoReminderDeliverySlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpdateAllByPage update all TodoReminderDelivery records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoReminderDeliverySlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertAllByPage insert all TodoReminderDelivery records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoReminderDeliverySlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoReminderDeliveryColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all TodoReminderDelivery records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s TodoReminderDeliverySlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoReminderDeliveryColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpsertAllByPage upsert all TodoReminderDelivery records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoReminderDeliverySlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoReminderDeliveryColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// LoadTodoRemindersByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoReminderDeliverySlice) LoadTodoRemindersByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoRemindersByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoReminderDeliverySlice) LoadTodoRemindersByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*TodoReminderDelivery](s, pageSize) {
+		if err := chunk[0].L.LoadTodoReminder(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s TodoReminderDeliverySlice) GetLoadedTodoReminders() TodoReminderSlice {
+	result := make(TodoReminderSlice, 0, len(s))
+	mapCheckDup := make(map[*TodoReminder]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.TodoReminder == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.TodoReminder]; ok {
+			continue
+		}
+		result = append(result, item.R.TodoReminder)
+		mapCheckDup[item.R.TodoReminder] = struct{}{}
+	}
+	return result
+}
+
+///////////////////////////////// END EXTENSIONS /////////////////////////////////
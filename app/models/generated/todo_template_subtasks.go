@@ -0,0 +1,1765 @@
+// Code generated by SQLBoiler 4.16.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// TodoTemplateSubtask is an object representing the database table.
+type TodoTemplateSubtask struct {
+	ID             int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TodoTemplateID int         `boil:"todo_template_id" json:"todo_template_id" toml:"todo_template_id" yaml:"todo_template_id"`
+	Title          string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Content        null.String `boil:"content" json:"content,omitempty" toml:"content" yaml:"content,omitempty"`
+	DueOffsetDays  null.Int    `boil:"due_offset_days" json:"due_offset_days,omitempty" toml:"due_offset_days" yaml:"due_offset_days,omitempty"`
+	SortOrder      int         `boil:"sort_order" json:"sort_order" toml:"sort_order" yaml:"sort_order"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *todoTemplateSubtaskR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L todoTemplateSubtaskL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TodoTemplateSubtaskColumns = struct {
+	ID             string
+	TodoTemplateID string
+	Title          string
+	Content        string
+	DueOffsetDays  string
+	SortOrder      string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "id",
+	TodoTemplateID: "todo_template_id",
+	Title:          "title",
+	Content:        "content",
+	DueOffsetDays:  "due_offset_days",
+	SortOrder:      "sort_order",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var TodoTemplateSubtaskTableColumns = struct {
+	ID             string
+	TodoTemplateID string
+	Title          string
+	Content        string
+	DueOffsetDays  string
+	SortOrder      string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	ID:             "todo_template_subtasks.id",
+	TodoTemplateID: "todo_template_subtasks.todo_template_id",
+	Title:          "todo_template_subtasks.title",
+	Content:        "todo_template_subtasks.content",
+	DueOffsetDays:  "todo_template_subtasks.due_offset_days",
+	SortOrder:      "todo_template_subtasks.sort_order",
+	CreatedAt:      "todo_template_subtasks.created_at",
+	UpdatedAt:      "todo_template_subtasks.updated_at",
+}
+
+// Generated where
+
+var TodoTemplateSubtaskWhere = struct {
+	ID             whereHelperint
+	TodoTemplateID whereHelperint
+	Title          whereHelperstring
+	Content        whereHelpernull_String
+	DueOffsetDays  whereHelpernull_Int
+	SortOrder      whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperint{field: "`todo_template_subtasks`.`id`"},
+	TodoTemplateID: whereHelperint{field: "`todo_template_subtasks`.`todo_template_id`"},
+	Title:          whereHelperstring{field: "`todo_template_subtasks`.`title`"},
+	Content:        whereHelpernull_String{field: "`todo_template_subtasks`.`content`"},
+	DueOffsetDays:  whereHelpernull_Int{field: "`todo_template_subtasks`.`due_offset_days`"},
+	SortOrder:      whereHelperint{field: "`todo_template_subtasks`.`sort_order`"},
+	CreatedAt:      whereHelpertime_Time{field: "`todo_template_subtasks`.`created_at`"},
+	UpdatedAt:      whereHelpertime_Time{field: "`todo_template_subtasks`.`updated_at`"},
+}
+
+// TodoTemplateSubtaskRels is where relationship names are stored.
+var TodoTemplateSubtaskRels = struct {
+	TodoTemplate string
+}{
+	TodoTemplate: "TodoTemplate",
+}
+
+// todoTemplateSubtaskR is where relationships are stored.
+type todoTemplateSubtaskR struct {
+	TodoTemplate *TodoTemplate `boil:"TodoTemplate" json:"TodoTemplate" toml:"TodoTemplate" yaml:"TodoTemplate"`
+}
+
+// NewStruct creates a new relationship struct
+func (*todoTemplateSubtaskR) NewStruct() *todoTemplateSubtaskR {
+	return &todoTemplateSubtaskR{}
+}
+
+func (r *todoTemplateSubtaskR) GetTodoTemplate() *TodoTemplate {
+	if r == nil {
+		return nil
+	}
+	return r.TodoTemplate
+}
+
+// todoTemplateSubtaskL is where Load methods for each relationship are stored.
+type todoTemplateSubtaskL struct{}
+
+var (
+	todoTemplateSubtaskAllColumns            = []string{"id", "todo_template_id", "title", "content", "due_offset_days", "sort_order", "created_at", "updated_at"}
+	todoTemplateSubtaskColumnsWithoutDefault = []string{"todo_template_id", "title", "content", "due_offset_days", "sort_order", "created_at", "updated_at"}
+	todoTemplateSubtaskColumnsWithDefault    = []string{"id"}
+	todoTemplateSubtaskPrimaryKeyColumns     = []string{"id"}
+	todoTemplateSubtaskGeneratedColumns      = []string{}
+)
+
+type (
+	// TodoTemplateSubtaskSlice is an alias for a slice of pointers to TodoTemplateSubtask.
+	// This should almost always be used instead of []TodoTemplateSubtask.
+	TodoTemplateSubtaskSlice []*TodoTemplateSubtask
+	// TodoTemplateSubtaskHook is the signature for custom TodoTemplateSubtask hook methods
+	TodoTemplateSubtaskHook func(context.Context, boil.ContextExecutor, *TodoTemplateSubtask) error
+
+	todoTemplateSubtaskQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	todoTemplateSubtaskType                 = reflect.TypeOf(&TodoTemplateSubtask{})
+	todoTemplateSubtaskMapping              = queries.MakeStructMapping(todoTemplateSubtaskType)
+	todoTemplateSubtaskPrimaryKeyMapping, _ = queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, todoTemplateSubtaskPrimaryKeyColumns)
+	todoTemplateSubtaskInsertCacheMut       sync.RWMutex
+	todoTemplateSubtaskInsertCache          = make(map[string]insertCache)
+	todoTemplateSubtaskUpdateCacheMut       sync.RWMutex
+	todoTemplateSubtaskUpdateCache          = make(map[string]updateCache)
+	todoTemplateSubtaskUpsertCacheMut       sync.RWMutex
+	todoTemplateSubtaskUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var todoTemplateSubtaskAfterSelectMu sync.Mutex
+var todoTemplateSubtaskAfterSelectHooks []TodoTemplateSubtaskHook
+
+var todoTemplateSubtaskBeforeInsertMu sync.Mutex
+var todoTemplateSubtaskBeforeInsertHooks []TodoTemplateSubtaskHook
+var todoTemplateSubtaskAfterInsertMu sync.Mutex
+var todoTemplateSubtaskAfterInsertHooks []TodoTemplateSubtaskHook
+
+var todoTemplateSubtaskBeforeUpdateMu sync.Mutex
+var todoTemplateSubtaskBeforeUpdateHooks []TodoTemplateSubtaskHook
+var todoTemplateSubtaskAfterUpdateMu sync.Mutex
+var todoTemplateSubtaskAfterUpdateHooks []TodoTemplateSubtaskHook
+
+var todoTemplateSubtaskBeforeDeleteMu sync.Mutex
+var todoTemplateSubtaskBeforeDeleteHooks []TodoTemplateSubtaskHook
+var todoTemplateSubtaskAfterDeleteMu sync.Mutex
+var todoTemplateSubtaskAfterDeleteHooks []TodoTemplateSubtaskHook
+
+var todoTemplateSubtaskBeforeUpsertMu sync.Mutex
+var todoTemplateSubtaskBeforeUpsertHooks []TodoTemplateSubtaskHook
+var todoTemplateSubtaskAfterUpsertMu sync.Mutex
+var todoTemplateSubtaskAfterUpsertHooks []TodoTemplateSubtaskHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TodoTemplateSubtask) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TodoTemplateSubtask) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TodoTemplateSubtask) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TodoTemplateSubtask) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TodoTemplateSubtask) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TodoTemplateSubtask) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TodoTemplateSubtask) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TodoTemplateSubtask) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TodoTemplateSubtask) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range todoTemplateSubtaskAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTodoTemplateSubtaskHook registers your hook function for all future operations.
+func AddTodoTemplateSubtaskHook(hookPoint boil.HookPoint, todoTemplateSubtaskHook TodoTemplateSubtaskHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		todoTemplateSubtaskAfterSelectMu.Lock()
+		todoTemplateSubtaskAfterSelectHooks = append(todoTemplateSubtaskAfterSelectHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		todoTemplateSubtaskBeforeInsertMu.Lock()
+		todoTemplateSubtaskBeforeInsertHooks = append(todoTemplateSubtaskBeforeInsertHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		todoTemplateSubtaskAfterInsertMu.Lock()
+		todoTemplateSubtaskAfterInsertHooks = append(todoTemplateSubtaskAfterInsertHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		todoTemplateSubtaskBeforeUpdateMu.Lock()
+		todoTemplateSubtaskBeforeUpdateHooks = append(todoTemplateSubtaskBeforeUpdateHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		todoTemplateSubtaskAfterUpdateMu.Lock()
+		todoTemplateSubtaskAfterUpdateHooks = append(todoTemplateSubtaskAfterUpdateHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		todoTemplateSubtaskBeforeDeleteMu.Lock()
+		todoTemplateSubtaskBeforeDeleteHooks = append(todoTemplateSubtaskBeforeDeleteHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		todoTemplateSubtaskAfterDeleteMu.Lock()
+		todoTemplateSubtaskAfterDeleteHooks = append(todoTemplateSubtaskAfterDeleteHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		todoTemplateSubtaskBeforeUpsertMu.Lock()
+		todoTemplateSubtaskBeforeUpsertHooks = append(todoTemplateSubtaskBeforeUpsertHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		todoTemplateSubtaskAfterUpsertMu.Lock()
+		todoTemplateSubtaskAfterUpsertHooks = append(todoTemplateSubtaskAfterUpsertHooks, todoTemplateSubtaskHook)
+		todoTemplateSubtaskAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single todoTemplateSubtask record from the query.
+func (q todoTemplateSubtaskQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TodoTemplateSubtask, error) {
+	o := &TodoTemplateSubtask{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for todo_template_subtasks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TodoTemplateSubtask records from the query.
+func (q todoTemplateSubtaskQuery) All(ctx context.Context, exec boil.ContextExecutor) (TodoTemplateSubtaskSlice, error) {
+	var o []*TodoTemplateSubtask
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to TodoTemplateSubtask slice")
+	}
+
+	if len(todoTemplateSubtaskAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TodoTemplateSubtask records in the query.
+func (q todoTemplateSubtaskQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count todo_template_subtasks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q todoTemplateSubtaskQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if todo_template_subtasks exists")
+	}
+
+	return count > 0, nil
+}
+
+// TodoTemplate pointed to by the foreign key.
+func (o *TodoTemplateSubtask) TodoTemplate(mods ...qm.QueryMod) todoTemplateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.TodoTemplateID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TodoTemplates(queryMods...)
+}
+
+// LoadTodoTemplate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoTemplateSubtaskL) LoadTodoTemplate(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodoTemplateSubtask interface{}, mods queries.Applicator) error {
+	var slice []*TodoTemplateSubtask
+	var object *TodoTemplateSubtask
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodoTemplateSubtask.(*TodoTemplateSubtask)
+		if !ok {
+			object = new(TodoTemplateSubtask)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodoTemplateSubtask)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodoTemplateSubtask))
+			}
+		}
+	} else {
+		s, ok := maybeTodoTemplateSubtask.(*[]*TodoTemplateSubtask)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodoTemplateSubtask)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodoTemplateSubtask))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoTemplateSubtaskR{}
+		}
+		args[object.TodoTemplateID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoTemplateSubtaskR{}
+			}
+
+			args[obj.TodoTemplateID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_templates`),
+		qm.WhereIn(`todo_templates.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TodoTemplate")
+	}
+
+	var resultSlice []*TodoTemplate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TodoTemplate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for todo_templates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_templates")
+	}
+
+	if len(todoTemplateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TodoTemplate = foreign
+		if foreign.R == nil {
+			foreign.R = &todoTemplateR{}
+		}
+		foreign.R.TodoTemplateSubtasks = append(foreign.R.TodoTemplateSubtasks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TodoTemplateID == foreign.ID {
+				local.R.TodoTemplate = foreign
+				if foreign.R == nil {
+					foreign.R = &todoTemplateR{}
+				}
+				foreign.R.TodoTemplateSubtasks = append(foreign.R.TodoTemplateSubtasks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetTodoTemplate of the todoTemplateSubtask to the related item.
+// Sets o.R.TodoTemplate to related.
+// Adds o to related.R.TodoTemplateSubtasks.
+func (o *TodoTemplateSubtask) SetTodoTemplate(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TodoTemplate) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todo_template_subtasks` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"todo_template_id"}),
+		strmangle.WhereClause("`", "`", 0, todoTemplateSubtaskPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TodoTemplateID = related.ID
+	if o.R == nil {
+		o.R = &todoTemplateSubtaskR{
+			TodoTemplate: related,
+		}
+	} else {
+		o.R.TodoTemplate = related
+	}
+
+	if related.R == nil {
+		related.R = &todoTemplateR{
+			TodoTemplateSubtasks: TodoTemplateSubtaskSlice{o},
+		}
+	} else {
+		related.R.TodoTemplateSubtasks = append(related.R.TodoTemplateSubtasks, o)
+	}
+
+	return nil
+}
+
+// TodoTemplateSubtasks retrieves all the records using an executor.
+func TodoTemplateSubtasks(mods ...qm.QueryMod) todoTemplateSubtaskQuery {
+	mods = append(mods, qm.From("`todo_template_subtasks`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`todo_template_subtasks`.*"})
+	}
+
+	return todoTemplateSubtaskQuery{q}
+}
+
+// FindTodoTemplateSubtask retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTodoTemplateSubtask(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*TodoTemplateSubtask, error) {
+	todoTemplateSubtaskObj := &TodoTemplateSubtask{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `todo_template_subtasks` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, todoTemplateSubtaskObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from todo_template_subtasks")
+	}
+
+	if err = todoTemplateSubtaskObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return todoTemplateSubtaskObj, err
+	}
+
+	return todoTemplateSubtaskObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TodoTemplateSubtask) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_template_subtasks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoTemplateSubtaskColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	todoTemplateSubtaskInsertCacheMut.RLock()
+	cache, cached := todoTemplateSubtaskInsertCache[key]
+	todoTemplateSubtaskInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			todoTemplateSubtaskAllColumns,
+			todoTemplateSubtaskColumnsWithDefault,
+			todoTemplateSubtaskColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `todo_template_subtasks` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `todo_template_subtasks` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `todo_template_subtasks` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, todoTemplateSubtaskPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into todo_template_subtasks")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoTemplateSubtaskMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_template_subtasks")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoTemplateSubtaskInsertCacheMut.Lock()
+		todoTemplateSubtaskInsertCache[key] = cache
+		todoTemplateSubtaskInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TodoTemplateSubtask.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TodoTemplateSubtask) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	todoTemplateSubtaskUpdateCacheMut.RLock()
+	cache, cached := todoTemplateSubtaskUpdateCache[key]
+	todoTemplateSubtaskUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			todoTemplateSubtaskAllColumns,
+			todoTemplateSubtaskPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update todo_template_subtasks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `todo_template_subtasks` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, todoTemplateSubtaskPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, append(wl, todoTemplateSubtaskPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update todo_template_subtasks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for todo_template_subtasks")
+	}
+
+	if !cached {
+		todoTemplateSubtaskUpdateCacheMut.Lock()
+		todoTemplateSubtaskUpdateCache[key] = cache
+		todoTemplateSubtaskUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q todoTemplateSubtaskQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for todo_template_subtasks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for todo_template_subtasks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TodoTemplateSubtaskSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoTemplateSubtaskPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `todo_template_subtasks` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoTemplateSubtaskPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in todoTemplateSubtask slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all todoTemplateSubtask")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTodoTemplateSubtaskUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TodoTemplateSubtask) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todo_template_subtasks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoTemplateSubtaskColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTodoTemplateSubtaskUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	todoTemplateSubtaskUpsertCacheMut.RLock()
+	cache, cached := todoTemplateSubtaskUpsertCache[key]
+	todoTemplateSubtaskUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			todoTemplateSubtaskAllColumns,
+			todoTemplateSubtaskColumnsWithDefault,
+			todoTemplateSubtaskColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			todoTemplateSubtaskAllColumns,
+			todoTemplateSubtaskPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert todo_template_subtasks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(todoTemplateSubtaskAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`todo_template_subtasks`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `todo_template_subtasks` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for todo_template_subtasks")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoTemplateSubtaskMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for todo_template_subtasks")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todo_template_subtasks")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoTemplateSubtaskUpsertCacheMut.Lock()
+		todoTemplateSubtaskUpsertCache[key] = cache
+		todoTemplateSubtaskUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TodoTemplateSubtask record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TodoTemplateSubtask) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no TodoTemplateSubtask provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), todoTemplateSubtaskPrimaryKeyMapping)
+	sql := "DELETE FROM `todo_template_subtasks` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from todo_template_subtasks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for todo_template_subtasks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q todoTemplateSubtaskQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no todoTemplateSubtaskQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todo_template_subtasks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_template_subtasks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TodoTemplateSubtaskSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(todoTemplateSubtaskBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoTemplateSubtaskPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `todo_template_subtasks` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoTemplateSubtaskPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todoTemplateSubtask slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todo_template_subtasks")
+	}
+
+	if len(todoTemplateSubtaskAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TodoTemplateSubtask) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTodoTemplateSubtask(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TodoTemplateSubtaskSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TodoTemplateSubtaskSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoTemplateSubtaskPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `todo_template_subtasks`.* FROM `todo_template_subtasks` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoTemplateSubtaskPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TodoTemplateSubtaskSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TodoTemplateSubtaskExists checks if the TodoTemplateSubtask row exists.
+func TodoTemplateSubtaskExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `todo_template_subtasks` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if todo_template_subtasks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TodoTemplateSubtask row exists.
+func (o *TodoTemplateSubtask) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TodoTemplateSubtaskExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	TodoTemplateSubtaskAllColumns            = todoTemplateSubtaskAllColumns
+	TodoTemplateSubtaskColumnsWithoutDefault = todoTemplateSubtaskColumnsWithoutDefault
+	TodoTemplateSubtaskColumnsWithDefault    = todoTemplateSubtaskColumnsWithDefault
+	TodoTemplateSubtaskPrimaryKeyColumns     = todoTemplateSubtaskPrimaryKeyColumns
+	TodoTemplateSubtaskGeneratedColumns      = todoTemplateSubtaskGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *TodoTemplateSubtask) GetID() int {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s TodoTemplateSubtaskSlice) GetIDs() []int {
+	result := make([]int, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s TodoTemplateSubtaskSlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s TodoTemplateSubtaskSlice) ToIDMap() map[int]*TodoTemplateSubtask {
+	result := make(map[int]*TodoTemplateSubtask, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s TodoTemplateSubtaskSlice) ToUniqueItems() TodoTemplateSubtaskSlice {
+	result := make(TodoTemplateSubtaskSlice, 0, len(s))
+	mapChk := make(map[int]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s TodoTemplateSubtaskSlice) FindItemByID(id int) *TodoTemplateSubtask {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s TodoTemplateSubtaskSlice) FindMissingItemIDs(expectedIDs []int) []int {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoTemplateSubtaskSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			todoTemplateSubtaskAllColumns,
+			todoTemplateSubtaskColumnsWithDefault,
+			todoTemplateSubtaskColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoTemplateSubtaskColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range todoTemplateSubtaskAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `todo_template_subtasks` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from todoTemplateSubtask slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for todo_template_subtasks")
+	}
+
+	if len(todoTemplateSubtaskAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoTemplateSubtaskSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoTemplateSubtaskSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLTodoTemplateSubtaskUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			todoTemplateSubtaskAllColumns,
+			todoTemplateSubtaskColumnsWithDefault,
+			todoTemplateSubtaskColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoTemplateSubtaskColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range todoTemplateSubtaskAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		todoTemplateSubtaskAllColumns,
+		todoTemplateSubtaskPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert todo_template_subtasks, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `todo_template_subtasks`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `todo_template_subtasks`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(todoTemplateSubtaskType, todoTemplateSubtaskMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
+
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to upsert for todo_template_subtasks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for todo_template_subtasks")
+	}
+
+	if len(todoTemplateSubtaskAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllByPage delete all TodoTemplateSubtask records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoTemplateSubtaskSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpdateAllByPage update all TodoTemplateSubtask records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoTemplateSubtaskSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertAllByPage insert all TodoTemplateSubtask records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoTemplateSubtaskSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoTemplateSubtaskColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all TodoTemplateSubtask records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s TodoTemplateSubtaskSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoTemplateSubtaskColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpsertAllByPage upsert all TodoTemplateSubtask records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoTemplateSubtaskSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoTemplateSubtaskColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// LoadTodoTemplatesByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoTemplateSubtaskSlice) LoadTodoTemplatesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoTemplatesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoTemplateSubtaskSlice) LoadTodoTemplatesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*TodoTemplateSubtask](s, pageSize) {
+		if err := chunk[0].L.LoadTodoTemplate(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s TodoTemplateSubtaskSlice) GetLoadedTodoTemplates() TodoTemplateSlice {
+	result := make(TodoTemplateSlice, 0, len(s))
+	mapCheckDup := make(map[*TodoTemplate]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.TodoTemplate == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.TodoTemplate]; ok {
+			continue
+		}
+		result = append(result, item.R.TodoTemplate)
+		mapCheckDup[item.R.TodoTemplate] = struct{}{}
+	}
+	return result
+}
+
+///////////////////////////////// END EXTENSIONS /////////////////////////////////
@@ -24,186 +24,356 @@ import (
 
 // Todo is an object representing the database table.
 type Todo struct {
-	ID        int         `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    int         `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Title     string      `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Content   null.String `boil:"content" json:"content,omitempty" toml:"content" yaml:"content,omitempty"`
-	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                 int         `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID             int         `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	AssigneeID         null.Int    `boil:"assignee_id" json:"assignee_id,omitempty" toml:"assignee_id" yaml:"assignee_id,omitempty"`
+	ProjectID          null.Int    `boil:"project_id" json:"project_id,omitempty" toml:"project_id" yaml:"project_id,omitempty"`
+	BoardColumnID      null.Int    `boil:"board_column_id" json:"board_column_id,omitempty" toml:"board_column_id" yaml:"board_column_id,omitempty"`
+	Title              string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Content            null.String `boil:"content" json:"content,omitempty" toml:"content" yaml:"content,omitempty"`
+	Priority           int8        `boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	EstimateMinutes    null.Int    `boil:"estimate_minutes" json:"estimate_minutes,omitempty" toml:"estimate_minutes" yaml:"estimate_minutes,omitempty"`
+	DueDate            null.Time   `boil:"due_date" json:"due_date,omitempty" toml:"due_date" yaml:"due_date,omitempty"`
+	CompletedAt        null.Time   `boil:"completed_at" json:"completed_at,omitempty" toml:"completed_at" yaml:"completed_at,omitempty"`
+	ArchivedAt         null.Time   `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	RecurrenceRule     null.String `boil:"recurrence_rule" json:"recurrence_rule,omitempty" toml:"recurrence_rule" yaml:"recurrence_rule,omitempty"`
+	RecurrenceParentID null.Int    `boil:"recurrence_parent_id" json:"recurrence_parent_id,omitempty" toml:"recurrence_parent_id" yaml:"recurrence_parent_id,omitempty"`
+	Position           string      `boil:"position" json:"position" toml:"position" yaml:"position"`
+	BoardPosition      null.String `boil:"board_position" json:"board_position,omitempty" toml:"board_position" yaml:"board_position,omitempty"`
+	Version            int         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	CreatedAt          time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt          null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *todoR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L todoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TodoColumns = struct {
-	ID        string
-	UserID    string
-	Title     string
-	Content   string
-	CreatedAt string
-	UpdatedAt string
+	ID                 string
+	UserID             string
+	AssigneeID         string
+	ProjectID          string
+	BoardColumnID      string
+	Title              string
+	Content            string
+	Priority           string
+	EstimateMinutes    string
+	DueDate            string
+	CompletedAt        string
+	ArchivedAt         string
+	RecurrenceRule     string
+	RecurrenceParentID string
+	Position           string
+	BoardPosition      string
+	Version            string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	Title:     "title",
-	Content:   "content",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	ID:                 "id",
+	UserID:             "user_id",
+	AssigneeID:         "assignee_id",
+	ProjectID:          "project_id",
+	BoardColumnID:      "board_column_id",
+	Title:              "title",
+	Content:            "content",
+	Priority:           "priority",
+	EstimateMinutes:    "estimate_minutes",
+	DueDate:            "due_date",
+	CompletedAt:        "completed_at",
+	ArchivedAt:         "archived_at",
+	RecurrenceRule:     "recurrence_rule",
+	RecurrenceParentID: "recurrence_parent_id",
+	Position:           "position",
+	BoardPosition:      "board_position",
+	Version:            "version",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	DeletedAt:          "deleted_at",
 }
 
 var TodoTableColumns = struct {
-	ID        string
-	UserID    string
-	Title     string
-	Content   string
-	CreatedAt string
-	UpdatedAt string
+	ID                 string
+	UserID             string
+	AssigneeID         string
+	ProjectID          string
+	BoardColumnID      string
+	Title              string
+	Content            string
+	Priority           string
+	EstimateMinutes    string
+	DueDate            string
+	CompletedAt        string
+	ArchivedAt         string
+	RecurrenceRule     string
+	RecurrenceParentID string
+	Position           string
+	BoardPosition      string
+	Version            string
+	CreatedAt          string
+	UpdatedAt          string
+	DeletedAt          string
 }{
-	ID:        "todos.id",
-	UserID:    "todos.user_id",
-	Title:     "todos.title",
-	Content:   "todos.content",
-	CreatedAt: "todos.created_at",
-	UpdatedAt: "todos.updated_at",
+	ID:                 "todos.id",
+	UserID:             "todos.user_id",
+	AssigneeID:         "todos.assignee_id",
+	ProjectID:          "todos.project_id",
+	BoardColumnID:      "todos.board_column_id",
+	Title:              "todos.title",
+	Content:            "todos.content",
+	Priority:           "todos.priority",
+	EstimateMinutes:    "todos.estimate_minutes",
+	DueDate:            "todos.due_date",
+	CompletedAt:        "todos.completed_at",
+	ArchivedAt:         "todos.archived_at",
+	RecurrenceRule:     "todos.recurrence_rule",
+	RecurrenceParentID: "todos.recurrence_parent_id",
+	Position:           "todos.position",
+	BoardPosition:      "todos.board_position",
+	Version:            "todos.version",
+	CreatedAt:          "todos.created_at",
+	UpdatedAt:          "todos.updated_at",
+	DeletedAt:          "todos.deleted_at",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+var TodoWhere = struct {
+	ID                 whereHelperint
+	UserID             whereHelperint
+	AssigneeID         whereHelpernull_Int
+	ProjectID          whereHelpernull_Int
+	BoardColumnID      whereHelpernull_Int
+	Title              whereHelperstring
+	Content            whereHelpernull_String
+	Priority           whereHelperint8
+	EstimateMinutes    whereHelpernull_Int
+	DueDate            whereHelpernull_Time
+	CompletedAt        whereHelpernull_Time
+	ArchivedAt         whereHelpernull_Time
+	RecurrenceRule     whereHelpernull_String
+	RecurrenceParentID whereHelpernull_Int
+	Position           whereHelperstring
+	BoardPosition      whereHelpernull_String
+	Version            whereHelperint
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	DeletedAt          whereHelpernull_Time
+}{
+	ID:                 whereHelperint{field: "`todos`.`id`"},
+	UserID:             whereHelperint{field: "`todos`.`user_id`"},
+	AssigneeID:         whereHelpernull_Int{field: "`todos`.`assignee_id`"},
+	ProjectID:          whereHelpernull_Int{field: "`todos`.`project_id`"},
+	BoardColumnID:      whereHelpernull_Int{field: "`todos`.`board_column_id`"},
+	Title:              whereHelperstring{field: "`todos`.`title`"},
+	Content:            whereHelpernull_String{field: "`todos`.`content`"},
+	Priority:           whereHelperint8{field: "`todos`.`priority`"},
+	EstimateMinutes:    whereHelpernull_Int{field: "`todos`.`estimate_minutes`"},
+	DueDate:            whereHelpernull_Time{field: "`todos`.`due_date`"},
+	CompletedAt:        whereHelpernull_Time{field: "`todos`.`completed_at`"},
+	ArchivedAt:         whereHelpernull_Time{field: "`todos`.`archived_at`"},
+	RecurrenceRule:     whereHelpernull_String{field: "`todos`.`recurrence_rule`"},
+	RecurrenceParentID: whereHelpernull_Int{field: "`todos`.`recurrence_parent_id`"},
+	Position:           whereHelperstring{field: "`todos`.`position`"},
+	BoardPosition:      whereHelpernull_String{field: "`todos`.`board_position`"},
+	Version:            whereHelperint{field: "`todos`.`version`"},
+	CreatedAt:          whereHelpertime_Time{field: "`todos`.`created_at`"},
+	UpdatedAt:          whereHelpertime_Time{field: "`todos`.`updated_at`"},
+	DeletedAt:          whereHelpernull_Time{field: "`todos`.`deleted_at`"},
 }
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+// TodoRels is where relationship names are stored.
+var TodoRels = struct {
+	Assignee                string
+	BoardColumn             string
+	Project                 string
+	RecurrenceParent        string
+	User                    string
+	Activities              string
+	Attachments             string
+	Notifications           string
+	TimeEntries             string
+	TodoComments            string
+	BlockerTodoDependencies string
+	TodoDependencies        string
+	TodoReminders           string
+	TodoRevisions           string
+	TodoShares              string
+	TodoTags                string
+	RecurrenceParentTodos   string
+}{
+	Assignee:                "Assignee",
+	BoardColumn:             "BoardColumn",
+	Project:                 "Project",
+	RecurrenceParent:        "RecurrenceParent",
+	User:                    "User",
+	Activities:              "Activities",
+	Attachments:             "Attachments",
+	Notifications:           "Notifications",
+	TimeEntries:             "TimeEntries",
+	TodoComments:            "TodoComments",
+	BlockerTodoDependencies: "BlockerTodoDependencies",
+	TodoDependencies:        "TodoDependencies",
+	TodoReminders:           "TodoReminders",
+	TodoRevisions:           "TodoRevisions",
+	TodoShares:              "TodoShares",
+	TodoTags:                "TodoTags",
+	RecurrenceParentTodos:   "RecurrenceParentTodos",
 }
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+// todoR is where relationships are stored.
+type todoR struct {
+	Assignee                *User               `boil:"Assignee" json:"Assignee" toml:"Assignee" yaml:"Assignee"`
+	BoardColumn             *BoardColumn        `boil:"BoardColumn" json:"BoardColumn" toml:"BoardColumn" yaml:"BoardColumn"`
+	Project                 *Project            `boil:"Project" json:"Project" toml:"Project" yaml:"Project"`
+	RecurrenceParent        *Todo               `boil:"RecurrenceParent" json:"RecurrenceParent" toml:"RecurrenceParent" yaml:"RecurrenceParent"`
+	User                    *User               `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Activities              ActivitySlice       `boil:"Activities" json:"Activities" toml:"Activities" yaml:"Activities"`
+	Attachments             AttachmentSlice     `boil:"Attachments" json:"Attachments" toml:"Attachments" yaml:"Attachments"`
+	Notifications           NotificationSlice   `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	TimeEntries             TimeEntrySlice      `boil:"TimeEntries" json:"TimeEntries" toml:"TimeEntries" yaml:"TimeEntries"`
+	TodoComments            TodoCommentSlice    `boil:"TodoComments" json:"TodoComments" toml:"TodoComments" yaml:"TodoComments"`
+	BlockerTodoDependencies TodoDependencySlice `boil:"BlockerTodoDependencies" json:"BlockerTodoDependencies" toml:"BlockerTodoDependencies" yaml:"BlockerTodoDependencies"`
+	TodoDependencies        TodoDependencySlice `boil:"TodoDependencies" json:"TodoDependencies" toml:"TodoDependencies" yaml:"TodoDependencies"`
+	TodoReminders           TodoReminderSlice   `boil:"TodoReminders" json:"TodoReminders" toml:"TodoReminders" yaml:"TodoReminders"`
+	TodoRevisions           TodoRevisionSlice   `boil:"TodoRevisions" json:"TodoRevisions" toml:"TodoRevisions" yaml:"TodoRevisions"`
+	TodoShares              TodoShareSlice      `boil:"TodoShares" json:"TodoShares" toml:"TodoShares" yaml:"TodoShares"`
+	TodoTags                TodoTagSlice        `boil:"TodoTags" json:"TodoTags" toml:"TodoTags" yaml:"TodoTags"`
+	RecurrenceParentTodos   TodoSlice           `boil:"RecurrenceParentTodos" json:"RecurrenceParentTodos" toml:"RecurrenceParentTodos" yaml:"RecurrenceParentTodos"`
 }
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+// NewStruct creates a new relationship struct
+func (*todoR) NewStruct() *todoR {
+	return &todoR{}
 }
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
+
+func (r *todoR) GetAssignee() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Assignee
 }
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
+
+func (r *todoR) GetBoardColumn() *BoardColumn {
+	if r == nil {
+		return nil
+	}
+	return r.BoardColumn
 }
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *todoR) GetProject() *Project {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+	return r.Project
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *todoR) GetRecurrenceParent() *Todo {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+	return r.RecurrenceParent
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (r *todoR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
 
-type whereHelpertime_Time struct{ field string }
+func (r *todoR) GetActivities() ActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Activities
+}
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (r *todoR) GetAttachments() AttachmentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Attachments
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+
+func (r *todoR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Notifications
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+
+func (r *todoR) GetTimeEntries() TimeEntrySlice {
+	if r == nil {
+		return nil
+	}
+	return r.TimeEntries
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+func (r *todoR) GetTodoComments() TodoCommentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoComments
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+func (r *todoR) GetBlockerTodoDependencies() TodoDependencySlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockerTodoDependencies
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+func (r *todoR) GetTodoDependencies() TodoDependencySlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoDependencies
 }
 
-var TodoWhere = struct {
-	ID        whereHelperint
-	UserID    whereHelperint
-	Title     whereHelperstring
-	Content   whereHelpernull_String
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-}{
-	ID:        whereHelperint{field: "`todos`.`id`"},
-	UserID:    whereHelperint{field: "`todos`.`user_id`"},
-	Title:     whereHelperstring{field: "`todos`.`title`"},
-	Content:   whereHelpernull_String{field: "`todos`.`content`"},
-	CreatedAt: whereHelpertime_Time{field: "`todos`.`created_at`"},
-	UpdatedAt: whereHelpertime_Time{field: "`todos`.`updated_at`"},
+func (r *todoR) GetTodoReminders() TodoReminderSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoReminders
 }
 
-// TodoRels is where relationship names are stored.
-var TodoRels = struct {
-	User string
-}{
-	User: "User",
+func (r *todoR) GetTodoRevisions() TodoRevisionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoRevisions
 }
 
-// todoR is where relationships are stored.
-type todoR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+func (r *todoR) GetTodoShares() TodoShareSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoShares
 }
 
-// NewStruct creates a new relationship struct
-func (*todoR) NewStruct() *todoR {
-	return &todoR{}
+func (r *todoR) GetTodoTags() TodoTagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoTags
 }
 
-func (r *todoR) GetUser() *User {
+func (r *todoR) GetRecurrenceParentTodos() TodoSlice {
 	if r == nil {
 		return nil
 	}
-	return r.User
+	return r.RecurrenceParentTodos
 }
 
 // todoL is where Load methods for each relationship are stored.
 type todoL struct{}
 
 var (
-	todoAllColumns            = []string{"id", "user_id", "title", "content", "created_at", "updated_at"}
-	todoColumnsWithoutDefault = []string{"user_id", "title", "content", "created_at", "updated_at"}
-	todoColumnsWithDefault    = []string{"id"}
+	todoAllColumns            = []string{"id", "user_id", "assignee_id", "project_id", "board_column_id", "title", "content", "priority", "estimate_minutes", "due_date", "completed_at", "archived_at", "recurrence_rule", "recurrence_parent_id", "position", "board_position", "version", "created_at", "updated_at", "deleted_at"}
+	todoColumnsWithoutDefault = []string{"user_id", "assignee_id", "project_id", "board_column_id", "title", "content", "estimate_minutes", "due_date", "completed_at", "archived_at", "recurrence_rule", "recurrence_parent_id", "position", "board_position", "created_at", "updated_at", "deleted_at"}
+	todoColumnsWithDefault    = []string{"id", "priority", "version"}
 	todoPrimaryKeyColumns     = []string{"id"}
 	todoGeneratedColumns      = []string{}
 )
@@ -513,6 +683,50 @@ func (q todoQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Assignee pointed to by the foreign key.
+func (o *Todo) Assignee(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.AssigneeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// BoardColumn pointed to by the foreign key.
+func (o *Todo) BoardColumn(mods ...qm.QueryMod) boardColumnQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.BoardColumnID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return BoardColumns(queryMods...)
+}
+
+// Project pointed to by the foreign key.
+func (o *Todo) Project(mods ...qm.QueryMod) projectQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.ProjectID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Projects(queryMods...)
+}
+
+// RecurrenceParent pointed to by the foreign key.
+func (o *Todo) RecurrenceParent(mods ...qm.QueryMod) todoQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.RecurrenceParentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Todos(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *Todo) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -524,9 +738,177 @@ func (o *Todo) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
-// LoadUser allows an eager lookup of values, cached into the
+// Activities retrieves all the activity's Activities with an executor.
+func (o *Todo) Activities(mods ...qm.QueryMod) activityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`activities`.`todo_id`=?", o.ID),
+	)
+
+	return Activities(queryMods...)
+}
+
+// Attachments retrieves all the attachment's Attachments with an executor.
+func (o *Todo) Attachments(mods ...qm.QueryMod) attachmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`attachments`.`todo_id`=?", o.ID),
+	)
+
+	return Attachments(queryMods...)
+}
+
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *Todo) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notifications`.`todo_id`=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// TimeEntries retrieves all the time_entry's TimeEntries with an executor.
+func (o *Todo) TimeEntries(mods ...qm.QueryMod) timeEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`time_entries`.`todo_id`=?", o.ID),
+	)
+
+	return TimeEntries(queryMods...)
+}
+
+// TodoComments retrieves all the todo_comment's TodoComments with an executor.
+func (o *Todo) TodoComments(mods ...qm.QueryMod) todoCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_comments`.`todo_id`=?", o.ID),
+	)
+
+	return TodoComments(queryMods...)
+}
+
+// BlockerTodoDependencies retrieves all the todo_dependency's TodoDependencies with an executor via blocker_id column.
+func (o *Todo) BlockerTodoDependencies(mods ...qm.QueryMod) todoDependencyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_dependencies`.`blocker_id`=?", o.ID),
+	)
+
+	return TodoDependencies(queryMods...)
+}
+
+// TodoDependencies retrieves all the todo_dependency's TodoDependencies with an executor.
+func (o *Todo) TodoDependencies(mods ...qm.QueryMod) todoDependencyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_dependencies`.`todo_id`=?", o.ID),
+	)
+
+	return TodoDependencies(queryMods...)
+}
+
+// TodoReminders retrieves all the todo_reminder's TodoReminders with an executor.
+func (o *Todo) TodoReminders(mods ...qm.QueryMod) todoReminderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_reminders`.`todo_id`=?", o.ID),
+	)
+
+	return TodoReminders(queryMods...)
+}
+
+// TodoRevisions retrieves all the todo_revision's TodoRevisions with an executor.
+func (o *Todo) TodoRevisions(mods ...qm.QueryMod) todoRevisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_revisions`.`todo_id`=?", o.ID),
+	)
+
+	return TodoRevisions(queryMods...)
+}
+
+// TodoShares retrieves all the todo_share's TodoShares with an executor.
+func (o *Todo) TodoShares(mods ...qm.QueryMod) todoShareQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_shares`.`todo_id`=?", o.ID),
+	)
+
+	return TodoShares(queryMods...)
+}
+
+// TodoTags retrieves all the todo_tag's TodoTags with an executor.
+func (o *Todo) TodoTags(mods ...qm.QueryMod) todoTagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_tags`.`todo_id`=?", o.ID),
+	)
+
+	return TodoTags(queryMods...)
+}
+
+// RecurrenceParentTodos retrieves all the todo's Todos with an executor via recurrence_parent_id column.
+func (o *Todo) RecurrenceParentTodos(mods ...qm.QueryMod) todoQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todos`.`recurrence_parent_id`=?", o.ID),
+	)
+
+	return Todos(queryMods...)
+}
+
+// LoadAssignee allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (todoL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+func (todoL) LoadAssignee(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
 	var slice []*Todo
 	var object *Todo
 
@@ -557,7 +939,9 @@ func (todoL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool
 		if object.R == nil {
 			object.R = &todoR{}
 		}
-		args[object.UserID] = struct{}{}
+		if !queries.IsNil(object.AssigneeID) {
+			args[object.AssigneeID] = struct{}{}
+		}
 
 	} else {
 		for _, obj := range slice {
@@ -565,7 +949,9 @@ func (todoL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool
 				obj.R = &todoR{}
 			}
 
-			args[obj.UserID] = struct{}{}
+			if !queries.IsNil(obj.AssigneeID) {
+				args[obj.AssigneeID] = struct{}{}
+			}
 
 		}
 	}
@@ -620,22 +1006,22 @@ func (todoL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.User = foreign
+		object.R.Assignee = foreign
 		if foreign.R == nil {
 			foreign.R = &userR{}
 		}
-		foreign.R.Todos = append(foreign.R.Todos, object)
+		foreign.R.AssigneeTodos = append(foreign.R.AssigneeTodos, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.UserID == foreign.ID {
-				local.R.User = foreign
+			if queries.Equal(local.AssigneeID, foreign.ID) {
+				local.R.Assignee = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
 				}
-				foreign.R.Todos = append(foreign.R.Todos, local)
+				foreign.R.AssigneeTodos = append(foreign.R.AssigneeTodos, local)
 				break
 			}
 		}
@@ -644,1194 +1030,4715 @@ func (todoL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
-// SetUser of the todo to the related item.
-// Sets o.R.User to related.
-// Adds o to related.R.Todos.
-func (o *Todo) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE `todos` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-		strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadBoardColumn allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoL) LoadBoardColumn(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
 
-	o.UserID = related.ID
-	if o.R == nil {
-		o.R = &todoR{
-			User: related,
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
 		}
 	} else {
-		o.R.User = related
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			Todos: TodoSlice{o},
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
 		}
+		if !queries.IsNil(object.BoardColumnID) {
+			args[object.BoardColumnID] = struct{}{}
+		}
+
 	} else {
-		related.R.Todos = append(related.R.Todos, o)
-	}
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
 
-	return nil
-}
+			if !queries.IsNil(obj.BoardColumnID) {
+				args[obj.BoardColumnID] = struct{}{}
+			}
 
-// Todos retrieves all the records using an executor.
-func Todos(mods ...qm.QueryMod) todoQuery {
-	mods = append(mods, qm.From("`todos`"))
-	q := NewQuery(mods...)
-	if len(queries.GetSelect(q)) == 0 {
-		queries.SetSelect(q, []string{"`todos`.*"})
+		}
 	}
 
-	return todoQuery{q}
-}
-
-// FindTodo retrieves a single record by ID with an executor.
-// If selectCols is empty Find will return all columns.
-func FindTodo(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Todo, error) {
-	todoObj := &Todo{}
+	if len(args) == 0 {
+		return nil
+	}
 
-	sel := "*"
-	if len(selectCols) > 0 {
-		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
-	query := fmt.Sprintf(
-		"select %s from `todos` where `id`=?", sel,
-	)
 
-	q := queries.Raw(query, iD)
+	query := NewQuery(
+		qm.From(`board_columns`),
+		qm.WhereIn(`board_columns.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	err := q.Bind(ctx, exec, todoObj)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, sql.ErrNoRows
-		}
-		return nil, errors.Wrap(err, "models: unable to select from todos")
+		return errors.Wrap(err, "failed to eager load BoardColumn")
 	}
 
-	if err = todoObj.doAfterSelectHooks(ctx, exec); err != nil {
-		return todoObj, err
+	var resultSlice []*BoardColumn
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice BoardColumn")
 	}
 
-	return todoObj, nil
-}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for board_columns")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_columns")
+	}
 
-// Insert a single record using an executor.
-// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
-func (o *Todo) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
-	if o == nil {
-		return errors.New("models: no todos provided for insertion")
+	if len(boardColumnAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
 	}
 
-	var err error
-	if !boil.TimestampsAreSkipped(ctx) {
-		currTime := time.Now().In(boil.GetLocation())
+	if len(resultSlice) == 0 {
+		return nil
+	}
 
-		if o.CreatedAt.IsZero() {
-			o.CreatedAt = currTime
-		}
-		if o.UpdatedAt.IsZero() {
-			o.UpdatedAt = currTime
+	if singular {
+		foreign := resultSlice[0]
+		object.R.BoardColumn = foreign
+		if foreign.R == nil {
+			foreign.R = &boardColumnR{}
 		}
+		foreign.R.Todos = append(foreign.R.Todos, object)
+		return nil
 	}
 
-	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
-		return err
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.BoardColumnID, foreign.ID) {
+				local.R.BoardColumn = foreign
+				if foreign.R == nil {
+					foreign.R = &boardColumnR{}
+				}
+				foreign.R.Todos = append(foreign.R.Todos, local)
+				break
+			}
+		}
 	}
 
-	nzDefaults := queries.NonZeroDefaultSet(todoColumnsWithDefault, o)
-
-	key := makeCacheKey(columns, nzDefaults)
-	todoInsertCacheMut.RLock()
-	cache, cached := todoInsertCache[key]
-	todoInsertCacheMut.RUnlock()
+	return nil
+}
 
-	if !cached {
-		wl, returnColumns := columns.InsertColumnSet(
-			todoAllColumns,
-			todoColumnsWithDefault,
-			todoColumnsWithoutDefault,
-			nzDefaults,
-		)
+// LoadProject allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoL) LoadProject(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
 
-		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, wl)
-		if err != nil {
-			return err
-		}
-		cache.retMapping, err = queries.BindMapping(todoType, todoMapping, returnColumns)
-		if err != nil {
-			return err
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
 		}
-		if len(wl) != 0 {
-			cache.query = fmt.Sprintf("INSERT INTO `todos` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
 		} else {
-			cache.query = "INSERT INTO `todos` () VALUES ()%s%s"
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
 		}
+	}
 
-		var queryOutput, queryReturning string
-
-		if len(cache.retMapping) != 0 {
-			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `todos` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns))
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		if !queries.IsNil(object.ProjectID) {
+			args[object.ProjectID] = struct{}{}
 		}
 
-		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
-	}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
 
-	value := reflect.Indirect(reflect.ValueOf(o))
-	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+			if !queries.IsNil(obj.ProjectID) {
+				args[obj.ProjectID] = struct{}{}
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
+		}
 	}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
 
-	if err != nil {
-		return errors.Wrap(err, "models: unable to insert into todos")
+	if len(args) == 0 {
+		return nil
 	}
 
-	var lastID int64
-	var identifierCols []interface{}
-
-	if len(cache.retMapping) == 0 {
-		goto CacheNoHooks
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	lastID, err = result.LastInsertId()
-	if err != nil {
-		return ErrSyncFail
+	query := NewQuery(
+		qm.From(`projects`),
+		qm.WhereIn(`projects.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	o.ID = int(lastID)
-	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoMapping["id"] {
-		goto CacheNoHooks
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Project")
 	}
 
-	identifierCols = []interface{}{
-		o.ID,
+	var resultSlice []*Project
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Project")
 	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, identifierCols...)
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for projects")
 	}
-	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
-	if err != nil {
-		return errors.Wrap(err, "models: unable to populate default values for todos")
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for projects")
 	}
 
-CacheNoHooks:
-	if !cached {
-		todoInsertCacheMut.Lock()
-		todoInsertCache[key] = cache
-		todoInsertCacheMut.Unlock()
+	if len(projectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
 	}
 
-	return o.doAfterInsertHooks(ctx, exec)
-}
-
-// Update uses an executor to update the Todo.
-// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
-// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
-func (o *Todo) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
-	if !boil.TimestampsAreSkipped(ctx) {
-		currTime := time.Now().In(boil.GetLocation())
+	if len(resultSlice) == 0 {
+		return nil
+	}
 
-		o.UpdatedAt = currTime
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Project = foreign
+		if foreign.R == nil {
+			foreign.R = &projectR{}
+		}
+		foreign.R.Todos = append(foreign.R.Todos, object)
+		return nil
 	}
 
-	var err error
-	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
-		return 0, err
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ProjectID, foreign.ID) {
+				local.R.Project = foreign
+				if foreign.R == nil {
+					foreign.R = &projectR{}
+				}
+				foreign.R.Todos = append(foreign.R.Todos, local)
+				break
+			}
+		}
 	}
-	key := makeCacheKey(columns, nil)
-	todoUpdateCacheMut.RLock()
-	cache, cached := todoUpdateCache[key]
-	todoUpdateCacheMut.RUnlock()
 
-	if !cached {
-		wl := columns.UpdateColumnSet(
-			todoAllColumns,
-			todoPrimaryKeyColumns,
-		)
+	return nil
+}
 
-		if !columns.IsWhitelist() {
-			wl = strmangle.SetComplement(wl, []string{"created_at"})
+// LoadRecurrenceParent allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoL) LoadRecurrenceParent(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
 		}
-		if len(wl) == 0 {
-			return 0, errors.New("models: unable to update todos, could not build whitelist")
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
 		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		if !queries.IsNil(object.RecurrenceParentID) {
+			args[object.RecurrenceParentID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+
+			if !queries.IsNil(obj.RecurrenceParentID) {
+				args[obj.RecurrenceParentID] = struct{}{}
+			}
 
-		cache.query = fmt.Sprintf("UPDATE `todos` SET %s WHERE %s",
-			strmangle.SetParamNames("`", "`", 0, wl),
-			strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
-		)
-		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, append(wl, todoPrimaryKeyColumns...))
-		if err != nil {
-			return 0, err
 		}
 	}
 
-	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+	if len(args) == 0 {
+		return nil
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, values)
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
-	var result sql.Result
-	result, err = exec.ExecContext(ctx, cache.query, values...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to update todos row")
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`todos.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	rowsAff, err := result.RowsAffected()
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by update for todos")
+		return errors.Wrap(err, "failed to eager load Todo")
 	}
 
-	if !cached {
-		todoUpdateCacheMut.Lock()
-		todoUpdateCache[key] = cache
-		todoUpdateCacheMut.Unlock()
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Todo")
 	}
 
-	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
-}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
 
-// UpdateAll updates all rows with the specified column values.
-func (q todoQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
-	queries.SetUpdate(q.Query, cols)
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
 
-	result, err := q.Query.ExecContext(ctx, exec)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to update all for todos")
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for todos")
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RecurrenceParent = foreign
+		if foreign.R == nil {
+			foreign.R = &todoR{}
+		}
+		foreign.R.RecurrenceParentTodos = append(foreign.R.RecurrenceParentTodos, object)
+		return nil
 	}
 
-	return rowsAff, nil
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RecurrenceParentID, foreign.ID) {
+				local.R.RecurrenceParent = foreign
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.RecurrenceParentTodos = append(foreign.R.RecurrenceParentTodos, local)
+				break
+			}
+		}
+	}
+
+	return nil
 }
 
-// UpdateAll updates all rows with the specified column values, using an executor.
-func (o TodoSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
-	ln := int64(len(o))
-	if ln == 0 {
-		return 0, nil
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (todoL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
 	}
 
-	if len(cols) == 0 {
-		return 0, errors.New("models: update all requires at least one column argument")
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
 	}
 
-	colNames := make([]string, len(cols))
-	args := make([]interface{}, len(cols))
+	if len(args) == 0 {
+		return nil
+	}
 
+	argsSlice := make([]interface{}, len(args))
 	i := 0
-	for name, value := range cols {
-		colNames[i] = name
-		args[i] = value
+	for arg := range args {
+		argsSlice[i] = arg
 		i++
 	}
 
-	// Append all of the primary key values for each column
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	sql := fmt.Sprintf("UPDATE `todos` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, colNames),
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoPrimaryKeyColumns, len(o)))
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
-	}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to update all in todo slice")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all todo")
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
-	return rowsAff, nil
-}
-
-var mySQLTodoUniqueColumns = []string{
-	"id",
-}
 
-// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
-// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *Todo) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
-	if o == nil {
-		return errors.New("models: no todos provided for upsert")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
-	if !boil.TimestampsAreSkipped(ctx) {
-		currTime := time.Now().In(boil.GetLocation())
 
-		if o.CreatedAt.IsZero() {
-			o.CreatedAt = currTime
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-		o.UpdatedAt = currTime
 	}
 
-	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
-		return err
+	if len(resultSlice) == 0 {
+		return nil
 	}
 
-	nzDefaults := queries.NonZeroDefaultSet(todoColumnsWithDefault, o)
-	nzUniques := queries.NonZeroDefaultSet(mySQLTodoUniqueColumns, o)
-
-	if len(nzUniques) == 0 {
-		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Todos = append(foreign.R.Todos, object)
+		return nil
 	}
 
-	// Build cache key in-line uglily - mysql vs psql problems
-	buf := strmangle.GetBuffer()
-	buf.WriteString(strconv.Itoa(updateColumns.Kind))
-	for _, c := range updateColumns.Cols {
-		buf.WriteString(c)
-	}
-	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(insertColumns.Kind))
-	for _, c := range insertColumns.Cols {
-		buf.WriteString(c)
-	}
-	buf.WriteByte('.')
-	for _, c := range nzDefaults {
-		buf.WriteString(c)
-	}
-	buf.WriteByte('.')
-	for _, c := range nzUniques {
-		buf.WriteString(c)
-	}
-	key := buf.String()
-	strmangle.PutBuffer(buf)
-
-	todoUpsertCacheMut.RLock()
-	cache, cached := todoUpsertCache[key]
-	todoUpsertCacheMut.RUnlock()
-
-	var err error
-
-	if !cached {
-		insert, _ := insertColumns.InsertColumnSet(
-			todoAllColumns,
-			todoColumnsWithDefault,
-			todoColumnsWithoutDefault,
-			nzDefaults,
-		)
-
-		update := updateColumns.UpdateColumnSet(
-			todoAllColumns,
-			todoPrimaryKeyColumns,
-		)
-
-		if !updateColumns.IsNone() && len(update) == 0 {
-			return errors.New("models: unable to upsert todos, could not build update column list")
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Todos = append(foreign.R.Todos, local)
+				break
+			}
 		}
+	}
 
-		ret := strmangle.SetComplement(todoAllColumns, strmangle.SetIntersect(insert, update))
+	return nil
+}
 
-		cache.query = buildUpsertQueryMySQL(dialect, "`todos`", update, insert)
-		cache.retQuery = fmt.Sprintf(
-			"SELECT %s FROM `todos` WHERE %s",
-			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
-			strmangle.WhereClause("`", "`", 0, nzUniques),
-		)
+// LoadActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
 
-		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, insert)
-		if err != nil {
-			return err
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
 		}
-		if len(ret) != 0 {
-			cache.retMapping, err = queries.BindMapping(todoType, todoMapping, ret)
-			if err != nil {
-				return err
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
 			}
 		}
 	}
 
-	value := reflect.Indirect(reflect.ValueOf(o))
-	vals := queries.ValuesFromMapping(value, cache.valueMapping)
-	var returns []interface{}
-	if len(cache.retMapping) != 0 {
-		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
+	if len(args) == 0 {
+		return nil
 	}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
 
-	if err != nil {
-		return errors.Wrap(err, "models: unable to upsert for todos")
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	var lastID int64
-	var uniqueMap []uint64
-	var nzUniqueCols []interface{}
-
-	if len(cache.retMapping) == 0 {
-		goto CacheNoHooks
+	query := NewQuery(
+		qm.From(`activities`),
+		qm.WhereIn(`activities.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	lastID, err = result.LastInsertId()
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return ErrSyncFail
+		return errors.Wrap(err, "failed to eager load activities")
 	}
 
-	o.ID = int(lastID)
-	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoMapping["id"] {
-		goto CacheNoHooks
+	var resultSlice []*Activity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice activities")
 	}
 
-	uniqueMap, err = queries.BindMapping(todoType, todoMapping, nzUniques)
-	if err != nil {
-		return errors.Wrap(err, "models: unable to retrieve unique values for todos")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for activities")
 	}
-	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, nzUniqueCols...)
+	if len(activityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
 	}
-	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
-	if err != nil {
-		return errors.Wrap(err, "models: unable to populate default values for todos")
+	if singular {
+		object.R.Activities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &activityR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
 	}
 
-CacheNoHooks:
-	if !cached {
-		todoUpsertCacheMut.Lock()
-		todoUpsertCache[key] = cache
-		todoUpsertCacheMut.Unlock()
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TodoID) {
+				local.R.Activities = append(local.R.Activities, foreign)
+				if foreign.R == nil {
+					foreign.R = &activityR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
 	}
 
-	return o.doAfterUpsertHooks(ctx, exec)
+	return nil
 }
 
-// Delete deletes a single Todo record with an executor.
-// Delete will match against the primary key column to find the record to delete.
-func (o *Todo) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	if o == nil {
-		return 0, errors.New("models: no Todo provided for delete")
-	}
+// LoadAttachments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadAttachments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
 
-	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
-		return 0, err
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), todoPrimaryKeyMapping)
-	sql := "DELETE FROM `todos` WHERE `id`=?"
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
-	}
-	result, err := exec.ExecContext(ctx, sql, args...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to delete from todos")
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for todos")
+	if len(args) == 0 {
+		return nil
 	}
 
-	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
-		return 0, err
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	return rowsAff, nil
-}
-
-// DeleteAll deletes all matching rows.
-func (q todoQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	if q.Query == nil {
-		return 0, errors.New("models: no todoQuery provided for delete all")
+	query := NewQuery(
+		qm.From(`attachments`),
+		qm.WhereIn(`attachments.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	queries.SetDelete(q.Query)
-
-	result, err := q.Query.ExecContext(ctx, exec)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to delete all from todos")
+		return errors.Wrap(err, "failed to eager load attachments")
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todos")
+	var resultSlice []*Attachment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attachments")
 	}
 
-	return rowsAff, nil
-}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attachments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attachments")
+	}
 
-// DeleteAll deletes all rows in the slice, using an executor.
-func (o TodoSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	if len(o) == 0 {
-		return 0, nil
+	if len(attachmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
 	}
-
-	if len(todoBeforeDeleteHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
-				return 0, err
+	if singular {
+		object.R.Attachments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attachmentR{}
 			}
+			foreign.R.Todo = object
 		}
+		return nil
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.Attachments = append(local.R.Attachments, foreign)
+				if foreign.R == nil {
+					foreign.R = &attachmentR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
 	}
 
-	sql := "DELETE FROM `todos` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoPrimaryKeyColumns, len(o))
+	return nil
+}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args)
-	}
-	result, err := exec.ExecContext(ctx, sql, args...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to delete all from todo slice")
-	}
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todos")
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
 	}
 
-	if len(todoAfterDeleteHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
-				return 0, err
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
 			}
+			args[obj.ID] = struct{}{}
 		}
 	}
 
-	return rowsAff, nil
-}
-
-// Reload refetches the object from the database
-// using the primary keys with an executor.
-func (o *Todo) Reload(ctx context.Context, exec boil.ContextExecutor) error {
-	ret, err := FindTodo(ctx, exec, o.ID)
-	if err != nil {
-		return err
+	if len(args) == 0 {
+		return nil
 	}
 
-	*o = *ret
-	return nil
-}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-// ReloadAll refetches every row with matching primary key column values
-// and overwrites the original object slice with the newly updated slice.
-func (o *TodoSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
-	if o == nil || len(*o) == 0 {
-		return nil
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	slice := TodoSlice{}
-	var args []interface{}
-	for _, obj := range *o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
 	}
 
-	sql := "SELECT `todos`.* FROM `todos` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoPrimaryKeyColumns, len(*o))
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
 
-	q := queries.Raw(sql, args...)
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
 
-	err := q.Bind(ctx, exec, &slice)
-	if err != nil {
-		return errors.Wrap(err, "models: unable to reload all in TodoSlice")
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
 	}
 
-	*o = slice
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.TodoID) {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
 
 	return nil
 }
 
-// TodoExists checks if the Todo row exists.
-func TodoExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
-	var exists bool
-	sql := "select exists(select 1 from `todos` where `id`=? limit 1)"
+// LoadTimeEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTimeEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, iD)
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
 	}
-	row := exec.QueryRowContext(ctx, sql, iD)
 
-	err := row.Scan(&exists)
-	if err != nil {
-		return false, errors.Wrap(err, "models: unable to check if todos exists")
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	return exists, nil
-}
-
-// Exists checks if the Todo row exists.
-func (o *Todo) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	return TodoExists(ctx, exec, o.ID)
-}
-
-// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
-// Expose table columns
-var (
-	TodoAllColumns            = todoAllColumns
-	TodoColumnsWithoutDefault = todoColumnsWithoutDefault
-	TodoColumnsWithDefault    = todoColumnsWithDefault
-	TodoPrimaryKeyColumns     = todoPrimaryKeyColumns
-	TodoGeneratedColumns      = todoGeneratedColumns
-)
+	if len(args) == 0 {
+		return nil
+	}
 
-// GetID get ID from model object
-func (o *Todo) GetID() int {
-	return o.ID
-}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-// GetIDs extract IDs from model objects
-func (s TodoSlice) GetIDs() []int {
-	result := make([]int, len(s))
-	for i := range s {
-		result[i] = s[i].ID
+	query := NewQuery(
+		qm.From(`time_entries`),
+		qm.WhereIn(`time_entries.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return result
-}
 
-// GetIntfIDs extract IDs from model objects as interface slice
-func (s TodoSlice) GetIntfIDs() []interface{} {
-	result := make([]interface{}, len(s))
-	for i := range s {
-		result[i] = s[i].ID
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load time_entries")
 	}
-	return result
-}
 
-// ToIDMap convert a slice of model objects to a map with ID as key
-func (s TodoSlice) ToIDMap() map[int]*Todo {
-	result := make(map[int]*Todo, len(s))
-	for _, o := range s {
-		result[o.ID] = o
+	var resultSlice []*TimeEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice time_entries")
 	}
-	return result
-}
 
-// ToUniqueItems construct a slice of unique items from the given slice
-func (s TodoSlice) ToUniqueItems() TodoSlice {
-	result := make(TodoSlice, 0, len(s))
-	mapChk := make(map[int]struct{}, len(s))
-	for i := len(s) - 1; i >= 0; i-- {
-		o := s[i]
-		if _, ok := mapChk[o.ID]; !ok {
-			mapChk[o.ID] = struct{}{}
-			result = append(result, o)
-		}
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on time_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for time_entries")
 	}
-	return result
-}
 
-// FindItemByID find item by ID in the slice
-func (s TodoSlice) FindItemByID(id int) *Todo {
-	for _, o := range s {
-		if o.ID == id {
-			return o
+	if len(timeEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TimeEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &timeEntryR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TimeEntries = append(local.R.TimeEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &timeEntryR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
 		}
 	}
+
 	return nil
 }
 
-// FindMissingItemIDs find all item IDs that are not in the list
-// NOTE: the input ID slice should contain unique values
-func (s TodoSlice) FindMissingItemIDs(expectedIDs []int) []int {
-	if len(s) == 0 {
-		return expectedIDs
+// LoadTodoComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
 	}
-	result := []int{}
-	mapChk := s.ToIDMap()
-	for _, id := range expectedIDs {
-		if _, ok := mapChk[id]; !ok {
-			result = append(result, id)
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
 	}
-	return result
-}
 
-// InsertAll inserts all rows with the specified column values, using an executor.
-// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
-func (o TodoSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
-	if len(o) == 0 {
-		return 0, nil
+	if len(args) == 0 {
+		return nil
 	}
 
-	// Calculate the widest columns from all rows need to insert
-	wlCols := make(map[string]struct{}, 10)
-	for _, row := range o {
-		wl, _ := columns.InsertColumnSet(
-			todoAllColumns,
-			todoColumnsWithDefault,
-			todoColumnsWithoutDefault,
-			queries.NonZeroDefaultSet(todoColumnsWithDefault, row),
-		)
-		for _, col := range wl {
-			wlCols[col] = struct{}{}
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_comments`),
+		qm.WhereIn(`todo_comments.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_comments")
+	}
+
+	var resultSlice []*TodoComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_comments")
+	}
+
+	if len(todoCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
 	}
-	wl := make([]string, 0, len(wlCols))
-	for _, col := range todoAllColumns {
-		if _, ok := wlCols[col]; ok {
-			wl = append(wl, col)
+	if singular {
+		object.R.TodoComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoCommentR{}
+			}
+			foreign.R.Todo = object
 		}
+		return nil
 	}
 
-	var sql string
-	vals := []interface{}{}
-	for i, row := range o {
-		if !boil.TimestampsAreSkipped(ctx) {
-			currTime := time.Now().In(boil.GetLocation())
-			if row.CreatedAt.IsZero() {
-				row.CreatedAt = currTime
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoComments = append(local.R.TodoComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoCommentR{}
+				}
+				foreign.R.Todo = local
+				break
 			}
-			if row.UpdatedAt.IsZero() {
-				row.UpdatedAt = currTime
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockerTodoDependencies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadBlockerTodoDependencies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
 			}
 		}
+	}
 
-		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
-			return 0, err
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
+	}
 
-		if i == 0 {
-			sql = "INSERT INTO `todos` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_dependencies`),
+		qm.WhereIn(`todo_dependencies.blocker_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_dependencies")
+	}
+
+	var resultSlice []*TodoDependency
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_dependencies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_dependencies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_dependencies")
+	}
+
+	if len(todoDependencyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
-		if i != len(o)-1 {
-			sql += ","
+	}
+	if singular {
+		object.R.BlockerTodoDependencies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoDependencyR{}
+			}
+			foreign.R.Blocker = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BlockerID {
+				local.R.BlockerTodoDependencies = append(local.R.BlockerTodoDependencies, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoDependencyR{}
+				}
+				foreign.R.Blocker = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoDependencies allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoDependencies(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_dependencies`),
+		qm.WhereIn(`todo_dependencies.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_dependencies")
+	}
+
+	var resultSlice []*TodoDependency
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_dependencies")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_dependencies")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_dependencies")
+	}
+
+	if len(todoDependencyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoDependencies = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoDependencyR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoDependencies = append(local.R.TodoDependencies, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoDependencyR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoReminders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoReminders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_reminders`),
+		qm.WhereIn(`todo_reminders.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_reminders")
+	}
+
+	var resultSlice []*TodoReminder
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_reminders")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_reminders")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_reminders")
+	}
+
+	if len(todoReminderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoReminders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoReminderR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoReminders = append(local.R.TodoReminders, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoReminderR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoRevisions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoRevisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_revisions`),
+		qm.WhereIn(`todo_revisions.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_revisions")
+	}
+
+	var resultSlice []*TodoRevision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_revisions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_revisions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_revisions")
+	}
+
+	if len(todoRevisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoRevisions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoRevisionR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoRevisions = append(local.R.TodoRevisions, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoRevisionR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoShares allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoShares(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_shares`),
+		qm.WhereIn(`todo_shares.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_shares")
+	}
+
+	var resultSlice []*TodoShare
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_shares")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_shares")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_shares")
+	}
+
+	if len(todoShareAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoShares = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoShareR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoShares = append(local.R.TodoShares, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoShareR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadTodoTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_tags`),
+		qm.WhereIn(`todo_tags.todo_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_tags")
+	}
+
+	var resultSlice []*TodoTag
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_tags")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_tags")
+	}
+
+	if len(todoTagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoTags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoTagR{}
+			}
+			foreign.R.Todo = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.TodoID {
+				local.R.TodoTags = append(local.R.TodoTags, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoTagR{}
+				}
+				foreign.R.Todo = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecurrenceParentTodos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (todoL) LoadRecurrenceParentTodos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTodo interface{}, mods queries.Applicator) error {
+	var slice []*Todo
+	var object *Todo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTodo.(*Todo)
+		if !ok {
+			object = new(Todo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTodo))
+			}
+		}
+	} else {
+		s, ok := maybeTodo.(*[]*Todo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTodo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTodo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &todoR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &todoR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.recurrence_parent_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`todos.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todos")
+	}
+
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todos")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RecurrenceParentTodos = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoR{}
+			}
+			foreign.R.RecurrenceParent = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RecurrenceParentID) {
+				local.R.RecurrenceParentTodos = append(local.R.RecurrenceParentTodos, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.RecurrenceParent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAssignee of the todo to the related item.
+// Sets o.R.Assignee to related.
+// Adds o to related.R.AssigneeTodos.
+func (o *Todo) SetAssignee(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todos` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"assignee_id"}),
+		strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AssigneeID, related.ID)
+	if o.R == nil {
+		o.R = &todoR{
+			Assignee: related,
+		}
+	} else {
+		o.R.Assignee = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AssigneeTodos: TodoSlice{o},
+		}
+	} else {
+		related.R.AssigneeTodos = append(related.R.AssigneeTodos, o)
+	}
+
+	return nil
+}
+
+// RemoveAssignee relationship.
+// Sets o.R.Assignee to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Todo) RemoveAssignee(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AssigneeID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("assignee_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Assignee = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AssigneeTodos {
+		if queries.Equal(o.AssigneeID, ri.AssigneeID) {
+			continue
+		}
+
+		ln := len(related.R.AssigneeTodos)
+		if ln > 1 && i < ln-1 {
+			related.R.AssigneeTodos[i] = related.R.AssigneeTodos[ln-1]
+		}
+		related.R.AssigneeTodos = related.R.AssigneeTodos[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetBoardColumn of the todo to the related item.
+// Sets o.R.BoardColumn to related.
+// Adds o to related.R.Todos.
+func (o *Todo) SetBoardColumn(ctx context.Context, exec boil.ContextExecutor, insert bool, related *BoardColumn) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todos` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"board_column_id"}),
+		strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.BoardColumnID, related.ID)
+	if o.R == nil {
+		o.R = &todoR{
+			BoardColumn: related,
+		}
+	} else {
+		o.R.BoardColumn = related
+	}
+
+	if related.R == nil {
+		related.R = &boardColumnR{
+			Todos: TodoSlice{o},
+		}
+	} else {
+		related.R.Todos = append(related.R.Todos, o)
+	}
+
+	return nil
+}
+
+// RemoveBoardColumn relationship.
+// Sets o.R.BoardColumn to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Todo) RemoveBoardColumn(ctx context.Context, exec boil.ContextExecutor, related *BoardColumn) error {
+	var err error
+
+	queries.SetScanner(&o.BoardColumnID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("board_column_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.BoardColumn = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Todos {
+		if queries.Equal(o.BoardColumnID, ri.BoardColumnID) {
+			continue
+		}
+
+		ln := len(related.R.Todos)
+		if ln > 1 && i < ln-1 {
+			related.R.Todos[i] = related.R.Todos[ln-1]
+		}
+		related.R.Todos = related.R.Todos[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetProject of the todo to the related item.
+// Sets o.R.Project to related.
+// Adds o to related.R.Todos.
+func (o *Todo) SetProject(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Project) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todos` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"project_id"}),
+		strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ProjectID, related.ID)
+	if o.R == nil {
+		o.R = &todoR{
+			Project: related,
+		}
+	} else {
+		o.R.Project = related
+	}
+
+	if related.R == nil {
+		related.R = &projectR{
+			Todos: TodoSlice{o},
+		}
+	} else {
+		related.R.Todos = append(related.R.Todos, o)
+	}
+
+	return nil
+}
+
+// RemoveProject relationship.
+// Sets o.R.Project to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Todo) RemoveProject(ctx context.Context, exec boil.ContextExecutor, related *Project) error {
+	var err error
+
+	queries.SetScanner(&o.ProjectID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("project_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Project = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Todos {
+		if queries.Equal(o.ProjectID, ri.ProjectID) {
+			continue
+		}
+
+		ln := len(related.R.Todos)
+		if ln > 1 && i < ln-1 {
+			related.R.Todos[i] = related.R.Todos[ln-1]
+		}
+		related.R.Todos = related.R.Todos[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetRecurrenceParent of the todo to the related item.
+// Sets o.R.RecurrenceParent to related.
+// Adds o to related.R.RecurrenceParentTodos.
+func (o *Todo) SetRecurrenceParent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Todo) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todos` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"recurrence_parent_id"}),
+		strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RecurrenceParentID, related.ID)
+	if o.R == nil {
+		o.R = &todoR{
+			RecurrenceParent: related,
+		}
+	} else {
+		o.R.RecurrenceParent = related
+	}
+
+	if related.R == nil {
+		related.R = &todoR{
+			RecurrenceParentTodos: TodoSlice{o},
+		}
+	} else {
+		related.R.RecurrenceParentTodos = append(related.R.RecurrenceParentTodos, o)
+	}
+
+	return nil
+}
+
+// RemoveRecurrenceParent relationship.
+// Sets o.R.RecurrenceParent to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Todo) RemoveRecurrenceParent(ctx context.Context, exec boil.ContextExecutor, related *Todo) error {
+	var err error
+
+	queries.SetScanner(&o.RecurrenceParentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("recurrence_parent_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.RecurrenceParent = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RecurrenceParentTodos {
+		if queries.Equal(o.RecurrenceParentID, ri.RecurrenceParentID) {
+			continue
+		}
+
+		ln := len(related.R.RecurrenceParentTodos)
+		if ln > 1 && i < ln-1 {
+			related.R.RecurrenceParentTodos[i] = related.R.RecurrenceParentTodos[ln-1]
+		}
+		related.R.RecurrenceParentTodos = related.R.RecurrenceParentTodos[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the todo to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Todos.
+func (o *Todo) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `todos` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &todoR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Todos: TodoSlice{o},
+		}
+	} else {
+		related.R.Todos = append(related.R.Todos, o)
+	}
+
+	return nil
+}
+
+// AddActivities adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.Activities.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Activity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TodoID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `activities` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, activityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TodoID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			Activities: related,
+		}
+	} else {
+		o.R.Activities = append(o.R.Activities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &activityR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// SetActivities removes all previously related items of the
+// todo replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Todo's Activities accordingly.
+// Replaces o.R.Activities with related.
+// Sets related.R.Todo's Activities accordingly.
+func (o *Todo) SetActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Activity) error {
+	query := "update `activities` set `todo_id` = null where `todo_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Activities {
+			queries.SetScanner(&rel.TodoID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Todo = nil
+		}
+		o.R.Activities = nil
+	}
+
+	return o.AddActivities(ctx, exec, insert, related...)
+}
+
+// RemoveActivities relationships from objects passed in.
+// Removes related items from R.Activities (uses pointer comparison, removal does not keep order)
+// Sets related.R.Todo.
+func (o *Todo) RemoveActivities(ctx context.Context, exec boil.ContextExecutor, related ...*Activity) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TodoID, nil)
+		if rel.R != nil {
+			rel.R.Todo = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("todo_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Activities {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Activities)
+			if ln > 1 && i < ln-1 {
+				o.R.Activities[i] = o.R.Activities[ln-1]
+			}
+			o.R.Activities = o.R.Activities[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAttachments adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.Attachments.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddAttachments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attachment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `attachments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, attachmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			Attachments: related,
+		}
+	} else {
+		o.R.Attachments = append(o.R.Attachments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attachmentR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.TodoID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notifications` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.TodoID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// SetNotifications removes all previously related items of the
+// todo replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Todo's Notifications accordingly.
+// Replaces o.R.Notifications with related.
+// Sets related.R.Todo's Notifications accordingly.
+func (o *Todo) SetNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	query := "update `notifications` set `todo_id` = null where `todo_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Notifications {
+			queries.SetScanner(&rel.TodoID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Todo = nil
+		}
+		o.R.Notifications = nil
+	}
+
+	return o.AddNotifications(ctx, exec, insert, related...)
+}
+
+// RemoveNotifications relationships from objects passed in.
+// Removes related items from R.Notifications (uses pointer comparison, removal does not keep order)
+// Sets related.R.Todo.
+func (o *Todo) RemoveNotifications(ctx context.Context, exec boil.ContextExecutor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.TodoID, nil)
+		if rel.R != nil {
+			rel.R.Todo = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("todo_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Notifications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Notifications)
+			if ln > 1 && i < ln-1 {
+				o.R.Notifications[i] = o.R.Notifications[ln-1]
+			}
+			o.R.Notifications = o.R.Notifications[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTimeEntries adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TimeEntries.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTimeEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TimeEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `time_entries` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, timeEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TimeEntries: related,
+		}
+	} else {
+		o.R.TimeEntries = append(o.R.TimeEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &timeEntryR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddTodoComments adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoComments.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoComment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_comments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, todoCommentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoComments: related,
+		}
+	} else {
+		o.R.TodoComments = append(o.R.TodoComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoCommentR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddBlockerTodoDependencies adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.BlockerTodoDependencies.
+// Sets related.R.Blocker appropriately.
+func (o *Todo) AddBlockerTodoDependencies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoDependency) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BlockerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_dependencies` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"blocker_id"}),
+				strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BlockerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			BlockerTodoDependencies: related,
+		}
+	} else {
+		o.R.BlockerTodoDependencies = append(o.R.BlockerTodoDependencies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoDependencyR{
+				Blocker: o,
+			}
+		} else {
+			rel.R.Blocker = o
+		}
+	}
+	return nil
+}
+
+// AddTodoDependencies adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoDependencies.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoDependencies(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoDependency) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_dependencies` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, todoDependencyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoDependencies: related,
+		}
+	} else {
+		o.R.TodoDependencies = append(o.R.TodoDependencies, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoDependencyR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddTodoReminders adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoReminders.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoReminders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoReminder) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_reminders` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, todoReminderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoReminders: related,
+		}
+	} else {
+		o.R.TodoReminders = append(o.R.TodoReminders, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoReminderR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddTodoRevisions adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoRevisions.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoRevisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoRevision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_revisions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, todoRevisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoRevisions: related,
+		}
+	} else {
+		o.R.TodoRevisions = append(o.R.TodoRevisions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoRevisionR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddTodoShares adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoShares.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoShares(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoShare) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_shares` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, todoSharePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoShares: related,
+		}
+	} else {
+		o.R.TodoShares = append(o.R.TodoShares, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoShareR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddTodoTags adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.TodoTags.
+// Sets related.R.Todo appropriately.
+func (o *Todo) AddTodoTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoTag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.TodoID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_tags` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"todo_id"}),
+				strmangle.WhereClause("`", "`", 0, todoTagPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.TodoID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			TodoTags: related,
+		}
+	} else {
+		o.R.TodoTags = append(o.R.TodoTags, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoTagR{
+				Todo: o,
+			}
+		} else {
+			rel.R.Todo = o
+		}
+	}
+	return nil
+}
+
+// AddRecurrenceParentTodos adds the given related objects to the existing relationships
+// of the todo, optionally inserting them as new records.
+// Appends related to o.R.RecurrenceParentTodos.
+// Sets related.R.RecurrenceParent appropriately.
+func (o *Todo) AddRecurrenceParentTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.RecurrenceParentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todos` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"recurrence_parent_id"}),
+				strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RecurrenceParentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &todoR{
+			RecurrenceParentTodos: related,
+		}
+	} else {
+		o.R.RecurrenceParentTodos = append(o.R.RecurrenceParentTodos, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoR{
+				RecurrenceParent: o,
+			}
+		} else {
+			rel.R.RecurrenceParent = o
+		}
+	}
+	return nil
+}
+
+// SetRecurrenceParentTodos removes all previously related items of the
+// todo replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RecurrenceParent's RecurrenceParentTodos accordingly.
+// Replaces o.R.RecurrenceParentTodos with related.
+// Sets related.R.RecurrenceParent's RecurrenceParentTodos accordingly.
+func (o *Todo) SetRecurrenceParentTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
+	query := "update `todos` set `recurrence_parent_id` = null where `recurrence_parent_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RecurrenceParentTodos {
+			queries.SetScanner(&rel.RecurrenceParentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RecurrenceParent = nil
+		}
+		o.R.RecurrenceParentTodos = nil
+	}
+
+	return o.AddRecurrenceParentTodos(ctx, exec, insert, related...)
+}
+
+// RemoveRecurrenceParentTodos relationships from objects passed in.
+// Removes related items from R.RecurrenceParentTodos (uses pointer comparison, removal does not keep order)
+// Sets related.R.RecurrenceParent.
+func (o *Todo) RemoveRecurrenceParentTodos(ctx context.Context, exec boil.ContextExecutor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RecurrenceParentID, nil)
+		if rel.R != nil {
+			rel.R.RecurrenceParent = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("recurrence_parent_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RecurrenceParentTodos {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RecurrenceParentTodos)
+			if ln > 1 && i < ln-1 {
+				o.R.RecurrenceParentTodos[i] = o.R.RecurrenceParentTodos[ln-1]
+			}
+			o.R.RecurrenceParentTodos = o.R.RecurrenceParentTodos[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// Todos retrieves all the records using an executor.
+func Todos(mods ...qm.QueryMod) todoQuery {
+	mods = append(mods, qm.From("`todos`"), qmhelper.WhereIsNull("`todos`.`deleted_at`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`todos`.*"})
+	}
+
+	return todoQuery{q}
+}
+
+// FindTodo retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTodo(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Todo, error) {
+	todoObj := &Todo{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `todos` where `id`=? and `deleted_at` is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, todoObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from todos")
+	}
+
+	if err = todoObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return todoObj, err
+	}
+
+	return todoObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Todo) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todos provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	todoInsertCacheMut.RLock()
+	cache, cached := todoInsertCache[key]
+	todoInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			todoAllColumns,
+			todoColumnsWithDefault,
+			todoColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(todoType, todoMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `todos` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `todos` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `todos` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into todos")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todos")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoInsertCacheMut.Lock()
+		todoInsertCache[key] = cache
+		todoInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Todo.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Todo) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	todoUpdateCacheMut.RLock()
+	cache, cached := todoUpdateCache[key]
+	todoUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			todoAllColumns,
+			todoPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update todos, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `todos` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, append(wl, todoPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update todos row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for todos")
+	}
+
+	if !cached {
+		todoUpdateCacheMut.Lock()
+		todoUpdateCache[key] = cache
+		todoUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q todoQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for todos")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for todos")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TodoSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `todos` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in todo slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all todo")
+	}
+	return rowsAff, nil
+}
+
+var mySQLTodoUniqueColumns = []string{
+	"id",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Todo) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no todos provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(todoColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLTodoUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	todoUpsertCacheMut.RLock()
+	cache, cached := todoUpsertCache[key]
+	todoUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			todoAllColumns,
+			todoColumnsWithDefault,
+			todoColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			todoAllColumns,
+			todoPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert todos, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(todoAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`todos`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `todos` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(todoType, todoMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(todoType, todoMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for todos")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == todoMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(todoType, todoMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for todos")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for todos")
+	}
+
+CacheNoHooks:
+	if !cached {
+		todoUpsertCacheMut.Lock()
+		todoUpsertCache[key] = cache
+		todoUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Todo record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Todo) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Todo provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), todoPrimaryKeyMapping)
+		sql = "DELETE FROM `todos` WHERE `id`=?"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `todos` SET %s WHERE `id`=?",
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		valueMapping, err := queries.BindMapping(todoType, todoMapping, append(wl, todoPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from todos")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for todos")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q todoQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no todoQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todos")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todos")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TodoSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(todoBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM `todos` WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `todos` SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from todo slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for todos")
+	}
+
+	if len(todoAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Todo) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTodo(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TodoSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TodoSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), todoPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `todos`.* FROM `todos` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, todoPrimaryKeyColumns, len(*o)) +
+		"and `deleted_at` is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in TodoSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TodoExists checks if the Todo row exists.
+func TodoExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `todos` where `id`=? and `deleted_at` is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if todos exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Todo row exists.
+func (o *Todo) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TodoExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	TodoAllColumns            = todoAllColumns
+	TodoColumnsWithoutDefault = todoColumnsWithoutDefault
+	TodoColumnsWithDefault    = todoColumnsWithDefault
+	TodoPrimaryKeyColumns     = todoPrimaryKeyColumns
+	TodoGeneratedColumns      = todoGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *Todo) GetID() int {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s TodoSlice) GetIDs() []int {
+	result := make([]int, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s TodoSlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s TodoSlice) ToIDMap() map[int]*Todo {
+	result := make(map[int]*Todo, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s TodoSlice) ToUniqueItems() TodoSlice {
+	result := make(TodoSlice, 0, len(s))
+	mapChk := make(map[int]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s TodoSlice) FindItemByID(id int) *Todo {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s TodoSlice) FindMissingItemIDs(expectedIDs []int) []int {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			todoAllColumns,
+			todoColumnsWithDefault,
+			todoColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range todoAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `todos` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(todoType, todoMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from todo slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for todos")
+	}
+
+	if len(todoAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o TodoSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLTodoUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			todoAllColumns,
+			todoColumnsWithDefault,
+			todoColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(todoColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range todoAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		todoAllColumns,
+		todoPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert todos, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `todos`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `todos`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(todoType, todoMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
+
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, query, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to upsert for todos")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for todos")
+	}
+
+	if len(todoAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllByPage delete all Todo records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, hardDelete bool, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec, hardDelete)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec, hardDelete)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpdateAllByPage update all Todo records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertAllByPage insert all Todo records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all Todo records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s TodoSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
+}
+
+// UpsertAllByPage upsert all Todo records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s TodoSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&TodoColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
 		}
-		valMapping, err := queries.BindMapping(todoType, todoMapping, wl)
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
 		if err != nil {
-			return 0, err
+			return rowsAffected, err
 		}
 
-		value := reflect.Indirect(reflect.ValueOf(row))
-		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, vals)
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
 	}
+	return rowsAffected, nil
+}
 
-	result, err := exec.ExecContext(ctx, sql, vals...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to insert all from todo slice")
+// LoadAssigneesByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoSlice) LoadAssigneesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadAssigneesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadAssigneesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for todos")
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadAssignee(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	if len(todoAfterInsertHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
-				return 0, err
-			}
+func (s TodoSlice) GetLoadedAssignees() UserSlice {
+	result := make(UserSlice, 0, len(s))
+	mapCheckDup := make(map[*User]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.Assignee == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.Assignee]; ok {
+			continue
 		}
+		result = append(result, item.R.Assignee)
+		mapCheckDup[item.R.Assignee] = struct{}{}
 	}
-
-	return rowsAff, nil
+	return result
 }
 
-// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
-// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
-func (o TodoSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
-	return o.UpsertAll(ctx, exec, boil.None(), columns)
+// LoadBoardColumnsByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoSlice) LoadBoardColumnsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadBoardColumnsByPageEx(ctx, e, DefaultPageSize, mods...)
 }
-
-// UpsertAll inserts or updates all rows
-// Currently it doesn't support "NoContext" and "NoRowsAffected"
-// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
-func (o TodoSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
-	if len(o) == 0 {
-		return 0, nil
+func (s TodoSlice) LoadBoardColumnsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	// Calculate the widest columns from all rows need to upsert
-	insertCols := make(map[string]struct{}, 10)
-	for _, row := range o {
-		nzUniques := queries.NonZeroDefaultSet(mySQLTodoUniqueColumns, row)
-		if len(nzUniques) == 0 {
-			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
-		}
-		insert, _ := insertColumns.InsertColumnSet(
-			todoAllColumns,
-			todoColumnsWithDefault,
-			todoColumnsWithoutDefault,
-			queries.NonZeroDefaultSet(todoColumnsWithDefault, row),
-		)
-		for _, col := range insert {
-			insertCols[col] = struct{}{}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadBoardColumn(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	insert := make([]string, 0, len(insertCols))
-	for _, col := range todoAllColumns {
-		if _, ok := insertCols[col]; ok {
-			insert = append(insert, col)
+	return nil
+}
+
+func (s TodoSlice) GetLoadedBoardColumns() BoardColumnSlice {
+	result := make(BoardColumnSlice, 0, len(s))
+	mapCheckDup := make(map[*BoardColumn]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.BoardColumn == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.BoardColumn]; ok {
+			continue
 		}
+		result = append(result, item.R.BoardColumn)
+		mapCheckDup[item.R.BoardColumn] = struct{}{}
 	}
+	return result
+}
 
-	update := updateColumns.UpdateColumnSet(
-		todoAllColumns,
-		todoPrimaryKeyColumns,
-	)
-	if !updateColumns.IsNone() && len(update) == 0 {
-		return 0, errors.New("models: unable to upsert todos, could not build update column list")
+// LoadProjectsByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoSlice) LoadProjectsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadProjectsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadProjectsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	buf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(buf)
-
-	if len(update) == 0 {
-		fmt.Fprintf(
-			buf,
-			"INSERT IGNORE INTO `todos`(%s) VALUES %s",
-			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
-			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
-		)
-	} else {
-		fmt.Fprintf(
-			buf,
-			"INSERT INTO `todos`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
-			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
-			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
-		)
-
-		for i, v := range update {
-			if i != 0 {
-				buf.WriteByte(',')
-			}
-			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
-			buf.WriteString(quoted)
-			buf.WriteString(" = VALUES(")
-			buf.WriteString(quoted)
-			buf.WriteByte(')')
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadProject(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	query := buf.String()
-	valueMapping, err := queries.BindMapping(todoType, todoMapping, insert)
-	if err != nil {
-		return 0, err
+func (s TodoSlice) GetLoadedProjects() ProjectSlice {
+	result := make(ProjectSlice, 0, len(s))
+	mapCheckDup := make(map[*Project]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.Project == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.Project]; ok {
+			continue
+		}
+		result = append(result, item.R.Project)
+		mapCheckDup[item.R.Project] = struct{}{}
 	}
+	return result
+}
 
-	var vals []interface{}
-	for _, row := range o {
-		if !boil.TimestampsAreSkipped(ctx) {
-			currTime := time.Now().In(boil.GetLocation())
-			if row.CreatedAt.IsZero() {
-				row.CreatedAt = currTime
-			}
-
-			row.UpdatedAt = currTime
+// LoadRecurrenceParentsByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoSlice) LoadRecurrenceParentsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadRecurrenceParentsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadRecurrenceParentsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadRecurrenceParent(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
-			return 0, err
+func (s TodoSlice) GetLoadedRecurrenceParents() TodoSlice {
+	result := make(TodoSlice, 0, len(s))
+	mapCheckDup := make(map[*Todo]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.RecurrenceParent == nil {
+			continue
 		}
-
-		value := reflect.Indirect(reflect.ValueOf(row))
-		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+		if _, ok := mapCheckDup[item.R.RecurrenceParent]; ok {
+			continue
+		}
+		result = append(result, item.R.RecurrenceParent)
+		mapCheckDup[item.R.RecurrenceParent] = struct{}{}
 	}
+	return result
+}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, vals)
+// LoadUsersByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s TodoSlice) LoadUsersByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadUsersByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadUsersByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	result, err := exec.ExecContext(ctx, query, vals...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to upsert for todos")
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadUser(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for todos")
+func (s TodoSlice) GetLoadedUsers() UserSlice {
+	result := make(UserSlice, 0, len(s))
+	mapCheckDup := make(map[*User]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.User == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.User]; ok {
+			continue
+		}
+		result = append(result, item.R.User)
+		mapCheckDup[item.R.User] = struct{}{}
 	}
+	return result
+}
 
-	if len(todoAfterUpsertHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
-				return 0, err
-			}
+// LoadActivitiesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadActivitiesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadActivitiesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadActivitiesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadActivities(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-
-	return rowsAff, nil
+	return nil
 }
 
-// DeleteAllByPage delete all Todo records from the slice.
-// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s TodoSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s TodoSlice) GetLoadedActivities() ActivitySlice {
+	result := make(ActivitySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Activities == nil {
+			continue
+		}
+		result = append(result, item.R.Activities...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	chunkSize := DefaultPageSize
-	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
-		chunkSize = limits[0]
-	}
-	if length <= chunkSize {
-		return s.DeleteAll(ctx, exec)
+// LoadAttachmentsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadAttachmentsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadAttachmentsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadAttachmentsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadAttachments(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
-		rows, err := s[start:end].DeleteAll(ctx, exec)
-		if err != nil {
-			return rowsAffected, err
+	}
+	return nil
+}
+
+func (s TodoSlice) GetLoadedAttachments() AttachmentSlice {
+	result := make(AttachmentSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Attachments == nil {
+			continue
 		}
+		result = append(result, item.R.Attachments...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadNotificationsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadNotificationsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadNotificationsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadNotificationsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadNotifications(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// UpdateAllByPage update all Todo records from the slice.
-// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s TodoSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s TodoSlice) GetLoadedNotifications() NotificationSlice {
+	result := make(NotificationSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Notifications == nil {
+			continue
+		}
+		result = append(result, item.R.Notifications...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	// NOTE (eric): len(cols) should not be too big
-	chunkSize := DefaultPageSize
-	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
-		chunkSize = limits[0]
+// LoadTimeEntriesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadTimeEntriesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTimeEntriesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadTimeEntriesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.UpdateAll(ctx, exec, cols)
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadTimeEntries(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
-		}
-		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
-		if err != nil {
-			return rowsAffected, err
+func (s TodoSlice) GetLoadedTimeEntries() TimeEntrySlice {
+	result := make(TimeEntrySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TimeEntries == nil {
+			continue
 		}
+		result = append(result, item.R.TimeEntries...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadTodoCommentsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadTodoCommentsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoCommentsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadTodoCommentsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadTodoComments(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// InsertAllByPage insert all Todo records from the slice.
-// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s TodoSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s TodoSlice) GetLoadedTodoComments() TodoCommentSlice {
+	result := make(TodoCommentSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoComments == nil {
+			continue
+		}
+		result = append(result, item.R.TodoComments...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	chunkSize := MaxPageSize / reflect.ValueOf(&TodoColumns).Elem().NumField()
-	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
-		chunkSize = limits[0]
+// LoadBlockerTodoDependenciesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadBlockerTodoDependenciesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadBlockerTodoDependenciesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadBlockerTodoDependenciesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.InsertAll(ctx, exec, columns)
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadBlockerTodoDependencies(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
-		}
-		rows, err := s[start:end].InsertAll(ctx, exec, columns)
-		if err != nil {
-			return rowsAffected, err
+func (s TodoSlice) GetLoadedBlockerTodoDependencies() TodoDependencySlice {
+	result := make(TodoDependencySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.BlockerTodoDependencies == nil {
+			continue
 		}
+		result = append(result, item.R.BlockerTodoDependencies...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadTodoDependenciesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadTodoDependenciesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoDependenciesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadTodoDependenciesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadTodoDependencies(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// InsertIgnoreAllByPage insert all Todo records from the slice.
-// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
-func (s TodoSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s TodoSlice) GetLoadedTodoDependencies() TodoDependencySlice {
+	result := make(TodoDependencySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoDependencies == nil {
+			continue
+		}
+		result = append(result, item.R.TodoDependencies...)
 	}
+	return result
+}
 
-	// max number of parameters = 65535
-	chunkSize := MaxPageSize / reflect.ValueOf(&TodoColumns).Elem().NumField()
-	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
-		chunkSize = limits[0]
+// LoadTodoRemindersByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadTodoRemindersByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoRemindersByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadTodoRemindersByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.InsertIgnoreAll(ctx, exec, columns)
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadTodoReminders(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
-		}
-		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
-		if err != nil {
-			return rowsAffected, err
+func (s TodoSlice) GetLoadedTodoReminders() TodoReminderSlice {
+	result := make(TodoReminderSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoReminders == nil {
+			continue
 		}
+		result = append(result, item.R.TodoReminders...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadTodoRevisionsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadTodoRevisionsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoRevisionsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadTodoRevisionsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadTodoRevisions(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// UpsertAllByPage upsert all Todo records from the slice.
-// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s TodoSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s TodoSlice) GetLoadedTodoRevisions() TodoRevisionSlice {
+	result := make(TodoRevisionSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoRevisions == nil {
+			continue
+		}
+		result = append(result, item.R.TodoRevisions...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	chunkSize := MaxPageSize / reflect.ValueOf(&TodoColumns).Elem().NumField()
-	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
-		chunkSize = limits[0]
+// LoadTodoSharesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadTodoSharesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoSharesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadTodoSharesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadTodoShares(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
+func (s TodoSlice) GetLoadedTodoShares() TodoShareSlice {
+	result := make(TodoShareSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoShares == nil {
+			continue
 		}
-		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
-		if err != nil {
-			return rowsAffected, err
+		result = append(result, item.R.TodoShares...)
+	}
+	return result
+}
+
+// LoadTodoTagsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadTodoTagsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoTagsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s TodoSlice) LoadTodoTagsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
+		if err := chunk[0].L.LoadTodoTags(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+func (s TodoSlice) GetLoadedTodoTags() TodoTagSlice {
+	result := make(TodoTagSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoTags == nil {
+			continue
 		}
+		result = append(result, item.R.TodoTags...)
 	}
-	return rowsAffected, nil
+	return result
 }
 
-// LoadUsersByPage performs eager loading of values by page. This is for a N-1 relationship.
-func (s TodoSlice) LoadUsersByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
-	return s.LoadUsersByPageEx(ctx, e, DefaultPageSize, mods...)
+// LoadRecurrenceParentTodosByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s TodoSlice) LoadRecurrenceParentTodosByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadRecurrenceParentTodosByPageEx(ctx, e, DefaultPageSize, mods...)
 }
-func (s TodoSlice) LoadUsersByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+func (s TodoSlice) LoadRecurrenceParentTodosByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
 	if len(s) == 0 {
 		return nil
 	}
 	for _, chunk := range chunkSlice[*Todo](s, pageSize) {
-		if err := chunk[0].L.LoadUser(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+		if err := chunk[0].L.LoadRecurrenceParentTodos(ctx, e, false, &chunk, queryMods(mods)); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (s TodoSlice) GetLoadedUsers() UserSlice {
-	result := make(UserSlice, 0, len(s))
-	mapCheckDup := make(map[*User]struct{})
+func (s TodoSlice) GetLoadedRecurrenceParentTodos() TodoSlice {
+	result := make(TodoSlice, 0, len(s)*2)
 	for _, item := range s {
-		if item.R == nil || item.R.User == nil {
-			continue
-		}
-		if _, ok := mapCheckDup[item.R.User]; ok {
+		if item.R == nil || item.R.RecurrenceParentTodos == nil {
 			continue
 		}
-		result = append(result, item.R.User)
-		mapCheckDup[item.R.User] = struct{}{}
+		result = append(result, item.R.RecurrenceParentTodos...)
 	}
 	return result
 }
@@ -86,14 +86,62 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Todos string
+	ActorActivities         string
+	Activities              string
+	Attachments             string
+	NotificationPreferences string
+	ActorNotifications      string
+	Notifications           string
+	Projects                string
+	SavedViews              string
+	TimeEntries             string
+	TodoComments            string
+	TodoReminders           string
+	ActorTodoRevisions      string
+	TodoShares              string
+	TodoTemplates           string
+	AssigneeTodos           string
+	Todos                   string
+	Webhooks                string
 }{
-	Todos: "Todos",
+	ActorActivities:         "ActorActivities",
+	Activities:              "Activities",
+	Attachments:             "Attachments",
+	NotificationPreferences: "NotificationPreferences",
+	ActorNotifications:      "ActorNotifications",
+	Notifications:           "Notifications",
+	Projects:                "Projects",
+	SavedViews:              "SavedViews",
+	TimeEntries:             "TimeEntries",
+	TodoComments:            "TodoComments",
+	TodoReminders:           "TodoReminders",
+	ActorTodoRevisions:      "ActorTodoRevisions",
+	TodoShares:              "TodoShares",
+	TodoTemplates:           "TodoTemplates",
+	AssigneeTodos:           "AssigneeTodos",
+	Todos:                   "Todos",
+	Webhooks:                "Webhooks",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Todos TodoSlice `boil:"Todos" json:"Todos" toml:"Todos" yaml:"Todos"`
+	ActorActivities         ActivitySlice               `boil:"ActorActivities" json:"ActorActivities" toml:"ActorActivities" yaml:"ActorActivities"`
+	Activities              ActivitySlice               `boil:"Activities" json:"Activities" toml:"Activities" yaml:"Activities"`
+	Attachments             AttachmentSlice             `boil:"Attachments" json:"Attachments" toml:"Attachments" yaml:"Attachments"`
+	NotificationPreferences NotificationPreferenceSlice `boil:"NotificationPreferences" json:"NotificationPreferences" toml:"NotificationPreferences" yaml:"NotificationPreferences"`
+	ActorNotifications      NotificationSlice           `boil:"ActorNotifications" json:"ActorNotifications" toml:"ActorNotifications" yaml:"ActorNotifications"`
+	Notifications           NotificationSlice           `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	Projects                ProjectSlice                `boil:"Projects" json:"Projects" toml:"Projects" yaml:"Projects"`
+	SavedViews              SavedViewSlice              `boil:"SavedViews" json:"SavedViews" toml:"SavedViews" yaml:"SavedViews"`
+	TimeEntries             TimeEntrySlice              `boil:"TimeEntries" json:"TimeEntries" toml:"TimeEntries" yaml:"TimeEntries"`
+	TodoComments            TodoCommentSlice            `boil:"TodoComments" json:"TodoComments" toml:"TodoComments" yaml:"TodoComments"`
+	TodoReminders           TodoReminderSlice           `boil:"TodoReminders" json:"TodoReminders" toml:"TodoReminders" yaml:"TodoReminders"`
+	ActorTodoRevisions      TodoRevisionSlice           `boil:"ActorTodoRevisions" json:"ActorTodoRevisions" toml:"ActorTodoRevisions" yaml:"ActorTodoRevisions"`
+	TodoShares              TodoShareSlice              `boil:"TodoShares" json:"TodoShares" toml:"TodoShares" yaml:"TodoShares"`
+	TodoTemplates           TodoTemplateSlice           `boil:"TodoTemplates" json:"TodoTemplates" toml:"TodoTemplates" yaml:"TodoTemplates"`
+	AssigneeTodos           TodoSlice                   `boil:"AssigneeTodos" json:"AssigneeTodos" toml:"AssigneeTodos" yaml:"AssigneeTodos"`
+	Todos                   TodoSlice                   `boil:"Todos" json:"Todos" toml:"Todos" yaml:"Todos"`
+	Webhooks                WebhookSlice                `boil:"Webhooks" json:"Webhooks" toml:"Webhooks" yaml:"Webhooks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -101,6 +149,111 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetActorActivities() ActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ActorActivities
+}
+
+func (r *userR) GetActivities() ActivitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Activities
+}
+
+func (r *userR) GetAttachments() AttachmentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Attachments
+}
+
+func (r *userR) GetNotificationPreferences() NotificationPreferenceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.NotificationPreferences
+}
+
+func (r *userR) GetActorNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ActorNotifications
+}
+
+func (r *userR) GetNotifications() NotificationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Notifications
+}
+
+func (r *userR) GetProjects() ProjectSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Projects
+}
+
+func (r *userR) GetSavedViews() SavedViewSlice {
+	if r == nil {
+		return nil
+	}
+	return r.SavedViews
+}
+
+func (r *userR) GetTimeEntries() TimeEntrySlice {
+	if r == nil {
+		return nil
+	}
+	return r.TimeEntries
+}
+
+func (r *userR) GetTodoComments() TodoCommentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoComments
+}
+
+func (r *userR) GetTodoReminders() TodoReminderSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoReminders
+}
+
+func (r *userR) GetActorTodoRevisions() TodoRevisionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ActorTodoRevisions
+}
+
+func (r *userR) GetTodoShares() TodoShareSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoShares
+}
+
+func (r *userR) GetTodoTemplates() TodoTemplateSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TodoTemplates
+}
+
+func (r *userR) GetAssigneeTodos() TodoSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AssigneeTodos
+}
+
 func (r *userR) GetTodos() TodoSlice {
 	if r == nil {
 		return nil
@@ -108,6 +261,13 @@ func (r *userR) GetTodos() TodoSlice {
 	return r.Todos
 }
 
+func (r *userR) GetWebhooks() WebhookSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Webhooks
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -424,6 +584,216 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// ActorActivities retrieves all the activity's Activities with an executor via actor_id column.
+func (o *User) ActorActivities(mods ...qm.QueryMod) activityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`activities`.`actor_id`=?", o.ID),
+	)
+
+	return Activities(queryMods...)
+}
+
+// Activities retrieves all the activity's Activities with an executor.
+func (o *User) Activities(mods ...qm.QueryMod) activityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`activities`.`user_id`=?", o.ID),
+	)
+
+	return Activities(queryMods...)
+}
+
+// Attachments retrieves all the attachment's Attachments with an executor.
+func (o *User) Attachments(mods ...qm.QueryMod) attachmentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`attachments`.`user_id`=?", o.ID),
+	)
+
+	return Attachments(queryMods...)
+}
+
+// NotificationPreferences retrieves all the notification_preference's NotificationPreferences with an executor.
+func (o *User) NotificationPreferences(mods ...qm.QueryMod) notificationPreferenceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notification_preferences`.`user_id`=?", o.ID),
+	)
+
+	return NotificationPreferences(queryMods...)
+}
+
+// ActorNotifications retrieves all the notification's Notifications with an executor via actor_id column.
+func (o *User) ActorNotifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notifications`.`actor_id`=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *User) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`notifications`.`user_id`=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// Projects retrieves all the project's Projects with an executor.
+func (o *User) Projects(mods ...qm.QueryMod) projectQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`projects`.`user_id`=?", o.ID),
+	)
+
+	return Projects(queryMods...)
+}
+
+// SavedViews retrieves all the saved_view's SavedViews with an executor.
+func (o *User) SavedViews(mods ...qm.QueryMod) savedViewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`saved_views`.`user_id`=?", o.ID),
+	)
+
+	return SavedViews(queryMods...)
+}
+
+// TimeEntries retrieves all the time_entry's TimeEntries with an executor.
+func (o *User) TimeEntries(mods ...qm.QueryMod) timeEntryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`time_entries`.`user_id`=?", o.ID),
+	)
+
+	return TimeEntries(queryMods...)
+}
+
+// TodoComments retrieves all the todo_comment's TodoComments with an executor.
+func (o *User) TodoComments(mods ...qm.QueryMod) todoCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_comments`.`user_id`=?", o.ID),
+	)
+
+	return TodoComments(queryMods...)
+}
+
+// TodoReminders retrieves all the todo_reminder's TodoReminders with an executor.
+func (o *User) TodoReminders(mods ...qm.QueryMod) todoReminderQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_reminders`.`user_id`=?", o.ID),
+	)
+
+	return TodoReminders(queryMods...)
+}
+
+// ActorTodoRevisions retrieves all the todo_revision's TodoRevisions with an executor via actor_id column.
+func (o *User) ActorTodoRevisions(mods ...qm.QueryMod) todoRevisionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_revisions`.`actor_id`=?", o.ID),
+	)
+
+	return TodoRevisions(queryMods...)
+}
+
+// TodoShares retrieves all the todo_share's TodoShares with an executor.
+func (o *User) TodoShares(mods ...qm.QueryMod) todoShareQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_shares`.`user_id`=?", o.ID),
+	)
+
+	return TodoShares(queryMods...)
+}
+
+// TodoTemplates retrieves all the todo_template's TodoTemplates with an executor.
+func (o *User) TodoTemplates(mods ...qm.QueryMod) todoTemplateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todo_templates`.`user_id`=?", o.ID),
+	)
+
+	return TodoTemplates(queryMods...)
+}
+
+// AssigneeTodos retrieves all the todo's Todos with an executor via assignee_id column.
+func (o *User) AssigneeTodos(mods ...qm.QueryMod) todoQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`todos`.`assignee_id`=?", o.ID),
+	)
+
+	return Todos(queryMods...)
+}
+
 // Todos retrieves all the todo's Todos with an executor.
 func (o *User) Todos(mods ...qm.QueryMod) todoQuery {
 	var queryMods []qm.QueryMod
@@ -438,9 +808,23 @@ func (o *User) Todos(mods ...qm.QueryMod) todoQuery {
 	return Todos(queryMods...)
 }
 
-// LoadTodos allows an eager lookup of values, cached into the
+// Webhooks retrieves all the webhook's Webhooks with an executor.
+func (o *User) Webhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`webhooks`.`user_id`=?", o.ID),
+	)
+
+	return Webhooks(queryMods...)
+}
+
+// LoadActorActivities allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadTodos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadActorActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -493,8 +877,8 @@ func (userL) LoadTodos(ctx context.Context, e boil.ContextExecutor, singular boo
 	}
 
 	query := NewQuery(
-		qm.From(`todos`),
-		qm.WhereIn(`todos.user_id in ?`, argsSlice...),
+		qm.From(`activities`),
+		qm.WhereIn(`activities.actor_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -502,22 +886,22 @@ func (userL) LoadTodos(ctx context.Context, e boil.ContextExecutor, singular boo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load todos")
+		return errors.Wrap(err, "failed to eager load activities")
 	}
 
-	var resultSlice []*Todo
+	var resultSlice []*Activity
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice todos")
+		return errors.Wrap(err, "failed to bind eager loaded slice activities")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on todos")
+		return errors.Wrap(err, "failed to close results in eager load on activities")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for activities")
 	}
 
-	if len(todoAfterSelectHooks) != 0 {
+	if len(activityAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -525,24 +909,24 @@ func (userL) LoadTodos(ctx context.Context, e boil.ContextExecutor, singular boo
 		}
 	}
 	if singular {
-		object.R.Todos = resultSlice
+		object.R.ActorActivities = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &todoR{}
+				foreign.R = &activityR{}
 			}
-			foreign.R.User = object
+			foreign.R.Actor = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Todos = append(local.R.Todos, foreign)
+			if local.ID == foreign.ActorID {
+				local.R.ActorActivities = append(local.R.ActorActivities, foreign)
 				if foreign.R == nil {
-					foreign.R = &todoR{}
+					foreign.R = &activityR{}
 				}
-				foreign.R.User = local
+				foreign.R.Actor = local
 				break
 			}
 		}
@@ -551,1171 +935,4456 @@ func (userL) LoadTodos(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// AddTodos adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.Todos.
-// Sets related.R.User appropriately.
-func (o *User) AddTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.UserID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE `todos` SET %s WHERE %s",
-				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadActivities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActivities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
-
-			rel.UserID = o.ID
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			Todos: related,
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
 		}
+		args[object.ID] = struct{}{}
 	} else {
-		o.R.Todos = append(o.R.Todos, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &todoR{
-				User: o,
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-		} else {
-			rel.R.User = o
+			args[obj.ID] = struct{}{}
 		}
 	}
-	return nil
-}
 
-// Users retrieves all the records using an executor.
-func Users(mods ...qm.QueryMod) userQuery {
-	mods = append(mods, qm.From("`users`"))
-	q := NewQuery(mods...)
-	if len(queries.GetSelect(q)) == 0 {
-		queries.SetSelect(q, []string{"`users`.*"})
+	if len(args) == 0 {
+		return nil
 	}
 
-	return userQuery{q}
-}
-
-// FindUser retrieves a single record by ID with an executor.
-// If selectCols is empty Find will return all columns.
-func FindUser(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*User, error) {
-	userObj := &User{}
-
-	sel := "*"
-	if len(selectCols) > 0 {
-		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
-	query := fmt.Sprintf(
-		"select %s from `users` where `id`=?", sel,
-	)
 
-	q := queries.Raw(query, iD)
+	query := NewQuery(
+		qm.From(`activities`),
+		qm.WhereIn(`activities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	err := q.Bind(ctx, exec, userObj)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, sql.ErrNoRows
-		}
-		return nil, errors.Wrap(err, "models: unable to select from users")
+		return errors.Wrap(err, "failed to eager load activities")
 	}
 
-	if err = userObj.doAfterSelectHooks(ctx, exec); err != nil {
-		return userObj, err
+	var resultSlice []*Activity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice activities")
 	}
 
-	return userObj, nil
-}
-
-// Insert a single record using an executor.
-// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
-func (o *User) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
-	if o == nil {
-		return errors.New("models: no users provided for insertion")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on activities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for activities")
 	}
 
-	var err error
-	if !boil.TimestampsAreSkipped(ctx) {
-		currTime := time.Now().In(boil.GetLocation())
-
-		if o.CreatedAt.IsZero() {
-			o.CreatedAt = currTime
+	if len(activityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-		if o.UpdatedAt.IsZero() {
-			o.UpdatedAt = currTime
+	}
+	if singular {
+		object.R.Activities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &activityR{}
+			}
+			foreign.R.User = object
 		}
+		return nil
 	}
 
-	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
-		return err
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Activities = append(local.R.Activities, foreign)
+				if foreign.R == nil {
+					foreign.R = &activityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
 	}
 
-	nzDefaults := queries.NonZeroDefaultSet(userColumnsWithDefault, o)
-
-	key := makeCacheKey(columns, nzDefaults)
-	userInsertCacheMut.RLock()
-	cache, cached := userInsertCache[key]
-	userInsertCacheMut.RUnlock()
+	return nil
+}
 
-	if !cached {
-		wl, returnColumns := columns.InsertColumnSet(
-			userAllColumns,
-			userColumnsWithDefault,
-			userColumnsWithoutDefault,
-			nzDefaults,
-		)
+// LoadAttachments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAttachments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-		cache.valueMapping, err = queries.BindMapping(userType, userMapping, wl)
-		if err != nil {
-			return err
-		}
-		cache.retMapping, err = queries.BindMapping(userType, userMapping, returnColumns)
-		if err != nil {
-			return err
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
-		if len(wl) != 0 {
-			cache.query = fmt.Sprintf("INSERT INTO `users` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
 		} else {
-			cache.query = "INSERT INTO `users` () VALUES ()%s%s"
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
 		}
+	}
 
-		var queryOutput, queryReturning string
-
-		if len(cache.retMapping) != 0 {
-			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `users` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns))
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
-
-		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
 	}
 
-	value := reflect.Indirect(reflect.ValueOf(o))
-	vals := queries.ValuesFromMapping(value, cache.valueMapping)
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
+	if len(args) == 0 {
+		return nil
 	}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
 
-	if err != nil {
-		return errors.Wrap(err, "models: unable to insert into users")
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	var lastID int64
-	var identifierCols []interface{}
-
-	if len(cache.retMapping) == 0 {
-		goto CacheNoHooks
+	query := NewQuery(
+		qm.From(`attachments`),
+		qm.WhereIn(`attachments.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	lastID, err = result.LastInsertId()
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return ErrSyncFail
+		return errors.Wrap(err, "failed to eager load attachments")
 	}
 
-	o.ID = int(lastID)
-	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userMapping["id"] {
-		goto CacheNoHooks
+	var resultSlice []*Attachment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice attachments")
 	}
 
-	identifierCols = []interface{}{
-		o.ID,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on attachments")
 	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, identifierCols...)
-	}
-	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
-	if err != nil {
-		return errors.Wrap(err, "models: unable to populate default values for users")
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for attachments")
 	}
 
-CacheNoHooks:
-	if !cached {
-		userInsertCacheMut.Lock()
-		userInsertCache[key] = cache
-		userInsertCacheMut.Unlock()
+	if len(attachmentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
 	}
-
-	return o.doAfterInsertHooks(ctx, exec)
-}
-
-// Update uses an executor to update the User.
-// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
-// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
-func (o *User) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
-	if !boil.TimestampsAreSkipped(ctx) {
-		currTime := time.Now().In(boil.GetLocation())
-
-		o.UpdatedAt = currTime
+	if singular {
+		object.R.Attachments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &attachmentR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
 	}
 
-	var err error
-	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
-		return 0, err
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Attachments = append(local.R.Attachments, foreign)
+				if foreign.R == nil {
+					foreign.R = &attachmentR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
 	}
-	key := makeCacheKey(columns, nil)
-	userUpdateCacheMut.RLock()
-	cache, cached := userUpdateCache[key]
-	userUpdateCacheMut.RUnlock()
 
-	if !cached {
-		wl := columns.UpdateColumnSet(
-			userAllColumns,
-			userPrimaryKeyColumns,
-		)
+	return nil
+}
 
-		if !columns.IsWhitelist() {
-			wl = strmangle.SetComplement(wl, []string{"created_at"})
+// LoadNotificationPreferences allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotificationPreferences(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
-		if len(wl) == 0 {
-			return 0, errors.New("models: unable to update users, could not build whitelist")
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
 		}
+	}
 
-		cache.query = fmt.Sprintf("UPDATE `users` SET %s WHERE %s",
-			strmangle.SetParamNames("`", "`", 0, wl),
-			strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
-		)
-		cache.valueMapping, err = queries.BindMapping(userType, userMapping, append(wl, userPrimaryKeyColumns...))
-		if err != nil {
-			return 0, err
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
 		}
 	}
 
-	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+	if len(args) == 0 {
+		return nil
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, values)
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
-	var result sql.Result
-	result, err = exec.ExecContext(ctx, cache.query, values...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to update users row")
+
+	query := NewQuery(
+		qm.From(`notification_preferences`),
+		qm.WhereIn(`notification_preferences.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	rowsAff, err := result.RowsAffected()
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by update for users")
+		return errors.Wrap(err, "failed to eager load notification_preferences")
 	}
 
-	if !cached {
-		userUpdateCacheMut.Lock()
-		userUpdateCache[key] = cache
-		userUpdateCacheMut.Unlock()
+	var resultSlice []*NotificationPreference
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notification_preferences")
 	}
 
-	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
-}
-
-// UpdateAll updates all rows with the specified column values.
-func (q userQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
-	queries.SetUpdate(q.Query, cols)
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notification_preferences")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notification_preferences")
+	}
 
-	result, err := q.Query.ExecContext(ctx, exec)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to update all for users")
+	if len(notificationPreferenceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.NotificationPreferences = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationPreferenceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for users")
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.NotificationPreferences = append(local.R.NotificationPreferences, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationPreferenceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
 	}
 
-	return rowsAff, nil
+	return nil
 }
 
-// UpdateAll updates all rows with the specified column values, using an executor.
-func (o UserSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
-	ln := int64(len(o))
-	if ln == 0 {
-		return 0, nil
+// LoadActorNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
 	}
 
-	if len(cols) == 0 {
-		return 0, errors.New("models: update all requires at least one column argument")
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	colNames := make([]string, len(cols))
-	args := make([]interface{}, len(cols))
+	if len(args) == 0 {
+		return nil
+	}
 
+	argsSlice := make([]interface{}, len(args))
 	i := 0
-	for name, value := range cols {
-		colNames[i] = name
-		args[i] = value
+	for arg := range args {
+		argsSlice[i] = arg
 		i++
 	}
 
-	// Append all of the primary key values for each column
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.actor_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	sql := fmt.Sprintf("UPDATE `users` SET %s WHERE %s",
-		strmangle.SetParamNames("`", "`", 0, colNames),
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(o)))
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
-	}
-	result, err := exec.ExecContext(ctx, sql, args...)
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to update all in user slice")
+		return errors.Wrap(err, "failed to eager load notifications")
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all user")
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
 	}
-	return rowsAff, nil
-}
-
-var mySQLUserUniqueColumns = []string{
-	"id",
-	"email",
-}
 
-// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
-// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
-func (o *User) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
-	if o == nil {
-		return errors.New("models: no users provided for upsert")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
 	}
-	if !boil.TimestampsAreSkipped(ctx) {
-		currTime := time.Now().In(boil.GetLocation())
 
-		if o.CreatedAt.IsZero() {
-			o.CreatedAt = currTime
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-		o.UpdatedAt = currTime
+	}
+	if singular {
+		object.R.ActorNotifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.Actor = object
+		}
+		return nil
 	}
 
-	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
-		return err
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ActorID) {
+				local.R.ActorNotifications = append(local.R.ActorNotifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
 	}
 
-	nzDefaults := queries.NonZeroDefaultSet(userColumnsWithDefault, o)
-	nzUniques := queries.NonZeroDefaultSet(mySQLUserUniqueColumns, o)
+	return nil
+}
 
-	if len(nzUniques) == 0 {
-		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
 	}
 
-	// Build cache key in-line uglily - mysql vs psql problems
-	buf := strmangle.GetBuffer()
-	buf.WriteString(strconv.Itoa(updateColumns.Kind))
-	for _, c := range updateColumns.Cols {
-		buf.WriteString(c)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
-	buf.WriteByte('.')
-	buf.WriteString(strconv.Itoa(insertColumns.Kind))
-	for _, c := range insertColumns.Cols {
-		buf.WriteString(c)
+
+	if len(args) == 0 {
+		return nil
 	}
-	buf.WriteByte('.')
-	for _, c := range nzDefaults {
-		buf.WriteString(c)
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
-	buf.WriteByte('.')
-	for _, c := range nzUniques {
-		buf.WriteString(c)
+
+	query := NewQuery(
+		qm.From(`notifications`),
+		qm.WhereIn(`notifications.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	key := buf.String()
-	strmangle.PutBuffer(buf)
 
-	userUpsertCacheMut.RLock()
-	cache, cached := userUpsertCache[key]
-	userUpsertCacheMut.RUnlock()
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
 
-	var err error
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
 
-	if !cached {
-		insert, _ := insertColumns.InsertColumnSet(
-			userAllColumns,
-			userColumnsWithDefault,
-			userColumnsWithoutDefault,
-			nzDefaults,
-		)
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
 
-		update := updateColumns.UpdateColumnSet(
-			userAllColumns,
-			userPrimaryKeyColumns,
-		)
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
 
-		if !updateColumns.IsNone() && len(update) == 0 {
-			return errors.New("models: unable to upsert users, could not build update column list")
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.User = local
+				break
+			}
 		}
+	}
 
-		ret := strmangle.SetComplement(userAllColumns, strmangle.SetIntersect(insert, update))
+	return nil
+}
 
-		cache.query = buildUpsertQueryMySQL(dialect, "`users`", update, insert)
-		cache.retQuery = fmt.Sprintf(
-			"SELECT %s FROM `users` WHERE %s",
-			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
-			strmangle.WhereClause("`", "`", 0, nzUniques),
-		)
+// LoadProjects allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadProjects(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
 
-		cache.valueMapping, err = queries.BindMapping(userType, userMapping, insert)
-		if err != nil {
-			return err
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
 		}
-		if len(ret) != 0 {
-			cache.retMapping, err = queries.BindMapping(userType, userMapping, ret)
-			if err != nil {
-				return err
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
 			}
 		}
 	}
 
-	value := reflect.Indirect(reflect.ValueOf(o))
-	vals := queries.ValuesFromMapping(value, cache.valueMapping)
-	var returns []interface{}
-	if len(cache.retMapping) != 0 {
-		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.query)
-		fmt.Fprintln(writer, vals)
+	if len(args) == 0 {
+		return nil
 	}
-	result, err := exec.ExecContext(ctx, cache.query, vals...)
 
-	if err != nil {
-		return errors.Wrap(err, "models: unable to upsert for users")
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
 
-	var lastID int64
-	var uniqueMap []uint64
-	var nzUniqueCols []interface{}
-
-	if len(cache.retMapping) == 0 {
-		goto CacheNoHooks
+	query := NewQuery(
+		qm.From(`projects`),
+		qm.WhereIn(`projects.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	lastID, err = result.LastInsertId()
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return ErrSyncFail
+		return errors.Wrap(err, "failed to eager load projects")
 	}
 
-	o.ID = int(lastID)
-	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userMapping["id"] {
-		goto CacheNoHooks
+	var resultSlice []*Project
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice projects")
 	}
 
-	uniqueMap, err = queries.BindMapping(userType, userMapping, nzUniques)
-	if err != nil {
-		return errors.Wrap(err, "models: unable to retrieve unique values for users")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on projects")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for projects")
 	}
-	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, cache.retQuery)
-		fmt.Fprintln(writer, nzUniqueCols...)
+	if len(projectAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
 	}
-	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
-	if err != nil {
-		return errors.Wrap(err, "models: unable to populate default values for users")
+	if singular {
+		object.R.Projects = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &projectR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
 	}
 
-CacheNoHooks:
-	if !cached {
-		userUpsertCacheMut.Lock()
-		userUpsertCache[key] = cache
-		userUpsertCacheMut.Unlock()
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Projects = append(local.R.Projects, foreign)
+				if foreign.R == nil {
+					foreign.R = &projectR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
 	}
 
-	return o.doAfterUpsertHooks(ctx, exec)
+	return nil
 }
 
-// Delete deletes a single User record with an executor.
-// Delete will match against the primary key column to find the record to delete.
-func (o *User) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	if o == nil {
-		return 0, errors.New("models: no User provided for delete")
+// LoadSavedViews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSavedViews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
 	}
 
-	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
-		return 0, err
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userPrimaryKeyMapping)
-	sql := "DELETE FROM `users` WHERE `id`=?"
+	if len(args) == 0 {
+		return nil
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args...)
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
 	}
-	result, err := exec.ExecContext(ctx, sql, args...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to delete from users")
+
+	query := NewQuery(
+		qm.From(`saved_views`),
+		qm.WhereIn(`saved_views.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	rowsAff, err := result.RowsAffected()
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for users")
+		return errors.Wrap(err, "failed to eager load saved_views")
 	}
 
-	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
-		return 0, err
+	var resultSlice []*SavedView
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice saved_views")
 	}
 
-	return rowsAff, nil
-}
-
-// DeleteAll deletes all matching rows.
-func (q userQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	if q.Query == nil {
-		return 0, errors.New("models: no userQuery provided for delete all")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on saved_views")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for saved_views")
 	}
 
-	queries.SetDelete(q.Query)
-
-	result, err := q.Query.ExecContext(ctx, exec)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to delete all from users")
+	if len(savedViewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SavedViews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &savedViewR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for users")
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.SavedViews = append(local.R.SavedViews, foreign)
+				if foreign.R == nil {
+					foreign.R = &savedViewR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
 	}
 
-	return rowsAff, nil
+	return nil
 }
 
-// DeleteAll deletes all rows in the slice, using an executor.
-func (o UserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	if len(o) == 0 {
-		return 0, nil
+// LoadTimeEntries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTimeEntries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
 	}
 
-	if len(userBeforeDeleteHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
-				return 0, err
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
+			args[obj.ID] = struct{}{}
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	if len(args) == 0 {
+		return nil
 	}
 
-	sql := "DELETE FROM `users` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(o))
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, args)
+	query := NewQuery(
+		qm.From(`time_entries`),
+		qm.WhereIn(`time_entries.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
 	}
-	result, err := exec.ExecContext(ctx, sql, args...)
+
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to delete all from user slice")
+		return errors.Wrap(err, "failed to eager load time_entries")
 	}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for users")
+	var resultSlice []*TimeEntry
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice time_entries")
 	}
 
-	if len(userAfterDeleteHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
-				return 0, err
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on time_entries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for time_entries")
+	}
+
+	if len(timeEntryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TimeEntries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &timeEntryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TimeEntries = append(local.R.TimeEntries, foreign)
+				if foreign.R == nil {
+					foreign.R = &timeEntryR{}
+				}
+				foreign.R.User = local
+				break
 			}
 		}
 	}
 
-	return rowsAff, nil
+	return nil
 }
 
-// Reload refetches the object from the database
-// using the primary keys with an executor.
-func (o *User) Reload(ctx context.Context, exec boil.ContextExecutor) error {
-	ret, err := FindUser(ctx, exec, o.ID)
+// LoadTodoComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTodoComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_comments`),
+		qm.WhereIn(`todo_comments.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return err
+		return errors.Wrap(err, "failed to eager load todo_comments")
+	}
+
+	var resultSlice []*TodoComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_comments")
+	}
+
+	if len(todoCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoCommentR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TodoComments = append(local.R.TodoComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoCommentR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
 	}
 
-	*o = *ret
 	return nil
 }
 
-// ReloadAll refetches every row with matching primary key column values
-// and overwrites the original object slice with the newly updated slice.
-func (o *UserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
-	if o == nil || len(*o) == 0 {
-		return nil
+// LoadTodoReminders allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTodoReminders(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
 	}
 
-	slice := UserSlice{}
-	var args []interface{}
-	for _, obj := range *o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
 	}
 
-	sql := "SELECT `users`.* FROM `users` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(*o))
+	if len(args) == 0 {
+		return nil
+	}
 
-	q := queries.Raw(sql, args...)
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
 
-	err := q.Bind(ctx, exec, &slice)
+	query := NewQuery(
+		qm.From(`todo_reminders`),
+		qm.WhereIn(`todo_reminders.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "models: unable to reload all in UserSlice")
+		return errors.Wrap(err, "failed to eager load todo_reminders")
 	}
 
-	*o = slice
+	var resultSlice []*TodoReminder
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_reminders")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_reminders")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_reminders")
+	}
+
+	if len(todoReminderAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoReminders = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoReminderR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TodoReminders = append(local.R.TodoReminders, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoReminderR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadActorTodoRevisions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadActorTodoRevisions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_revisions`),
+		qm.WhereIn(`todo_revisions.actor_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_revisions")
+	}
+
+	var resultSlice []*TodoRevision
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_revisions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_revisions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_revisions")
+	}
+
+	if len(todoRevisionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorTodoRevisions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoRevisionR{}
+			}
+			foreign.R.Actor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ActorID) {
+				local.R.ActorTodoRevisions = append(local.R.ActorTodoRevisions, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoRevisionR{}
+				}
+				foreign.R.Actor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoShares allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTodoShares(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_shares`),
+		qm.WhereIn(`todo_shares.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_shares")
+	}
+
+	var resultSlice []*TodoShare
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_shares")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_shares")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_shares")
+	}
+
+	if len(todoShareAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoShares = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoShareR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TodoShares = append(local.R.TodoShares, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoShareR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodoTemplates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTodoTemplates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todo_templates`),
+		qm.WhereIn(`todo_templates.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todo_templates")
+	}
+
+	var resultSlice []*TodoTemplate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todo_templates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todo_templates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todo_templates")
+	}
+
+	if len(todoTemplateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TodoTemplates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoTemplateR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TodoTemplates = append(local.R.TodoTemplates, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoTemplateR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssigneeTodos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAssigneeTodos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.assignee_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`todos.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todos")
+	}
+
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todos")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssigneeTodos = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoR{}
+			}
+			foreign.R.Assignee = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AssigneeID) {
+				local.R.AssigneeTodos = append(local.R.AssigneeTodos, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.Assignee = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTodos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTodos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`todos`),
+		qm.WhereIn(`todos.user_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`todos.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load todos")
+	}
+
+	var resultSlice []*Todo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice todos")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on todos")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for todos")
+	}
+
+	if len(todoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Todos = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &todoR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Todos = append(local.R.Todos, foreign)
+				if foreign.R == nil {
+					foreign.R = &todoR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`webhooks`),
+		qm.WhereIn(`webhooks.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhooks")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhooks")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Webhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Webhooks = append(local.R.Webhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddActorActivities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorActivities.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Activity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ActorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `activities` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"actor_id"}),
+				strmangle.WhereClause("`", "`", 0, activityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ActorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorActivities: related,
+		}
+	} else {
+		o.R.ActorActivities = append(o.R.ActorActivities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &activityR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// AddActivities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Activities.
+// Sets related.R.User appropriately.
+func (o *User) AddActivities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Activity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `activities` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, activityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Activities: related,
+		}
+	} else {
+		o.R.Activities = append(o.R.Activities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &activityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAttachments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Attachments.
+// Sets related.R.User appropriately.
+func (o *User) AddAttachments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Attachment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `attachments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, attachmentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Attachments: related,
+		}
+	} else {
+		o.R.Attachments = append(o.R.Attachments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &attachmentR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddNotificationPreferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.NotificationPreferences.
+// Sets related.R.User appropriately.
+func (o *User) AddNotificationPreferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*NotificationPreference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notification_preferences` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPreferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			NotificationPreferences: related,
+		}
+	} else {
+		o.R.NotificationPreferences = append(o.R.NotificationPreferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationPreferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddActorNotifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorNotifications.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ActorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notifications` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"actor_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ActorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorNotifications: related,
+		}
+	} else {
+		o.R.ActorNotifications = append(o.R.ActorNotifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// SetActorNotifications removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Actor's ActorNotifications accordingly.
+// Replaces o.R.ActorNotifications with related.
+// Sets related.R.Actor's ActorNotifications accordingly.
+func (o *User) SetActorNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	query := "update `notifications` set `actor_id` = null where `actor_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ActorNotifications {
+			queries.SetScanner(&rel.ActorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Actor = nil
+		}
+		o.R.ActorNotifications = nil
+	}
+
+	return o.AddActorNotifications(ctx, exec, insert, related...)
+}
+
+// RemoveActorNotifications relationships from objects passed in.
+// Removes related items from R.ActorNotifications (uses pointer comparison, removal does not keep order)
+// Sets related.R.Actor.
+func (o *User) RemoveActorNotifications(ctx context.Context, exec boil.ContextExecutor, related ...*Notification) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ActorID, nil)
+		if rel.R != nil {
+			rel.R.Actor = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("actor_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ActorNotifications {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ActorNotifications)
+			if ln > 1 && i < ln-1 {
+				o.R.ActorNotifications[i] = o.R.ActorNotifications[ln-1]
+			}
+			o.R.ActorNotifications = o.R.ActorNotifications[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.User appropriately.
+func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `notifications` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddProjects adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Projects.
+// Sets related.R.User appropriately.
+func (o *User) AddProjects(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Project) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `projects` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, projectPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Projects: related,
+		}
+	} else {
+		o.R.Projects = append(o.R.Projects, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &projectR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddSavedViews adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.SavedViews.
+// Sets related.R.User appropriately.
+func (o *User) AddSavedViews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SavedView) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `saved_views` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, savedViewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			SavedViews: related,
+		}
+	} else {
+		o.R.SavedViews = append(o.R.SavedViews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &savedViewR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddTimeEntries adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TimeEntries.
+// Sets related.R.User appropriately.
+func (o *User) AddTimeEntries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TimeEntry) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `time_entries` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, timeEntryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TimeEntries: related,
+		}
+	} else {
+		o.R.TimeEntries = append(o.R.TimeEntries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &timeEntryR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddTodoComments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TodoComments.
+// Sets related.R.User appropriately.
+func (o *User) AddTodoComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoComment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_comments` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, todoCommentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TodoComments: related,
+		}
+	} else {
+		o.R.TodoComments = append(o.R.TodoComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoCommentR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddTodoReminders adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TodoReminders.
+// Sets related.R.User appropriately.
+func (o *User) AddTodoReminders(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoReminder) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_reminders` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, todoReminderPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TodoReminders: related,
+		}
+	} else {
+		o.R.TodoReminders = append(o.R.TodoReminders, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoReminderR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddActorTodoRevisions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ActorTodoRevisions.
+// Sets related.R.Actor appropriately.
+func (o *User) AddActorTodoRevisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoRevision) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ActorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_revisions` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"actor_id"}),
+				strmangle.WhereClause("`", "`", 0, todoRevisionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ActorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ActorTodoRevisions: related,
+		}
+	} else {
+		o.R.ActorTodoRevisions = append(o.R.ActorTodoRevisions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoRevisionR{
+				Actor: o,
+			}
+		} else {
+			rel.R.Actor = o
+		}
+	}
+	return nil
+}
+
+// SetActorTodoRevisions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Actor's ActorTodoRevisions accordingly.
+// Replaces o.R.ActorTodoRevisions with related.
+// Sets related.R.Actor's ActorTodoRevisions accordingly.
+func (o *User) SetActorTodoRevisions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoRevision) error {
+	query := "update `todo_revisions` set `actor_id` = null where `actor_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ActorTodoRevisions {
+			queries.SetScanner(&rel.ActorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Actor = nil
+		}
+		o.R.ActorTodoRevisions = nil
+	}
+
+	return o.AddActorTodoRevisions(ctx, exec, insert, related...)
+}
+
+// RemoveActorTodoRevisions relationships from objects passed in.
+// Removes related items from R.ActorTodoRevisions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Actor.
+func (o *User) RemoveActorTodoRevisions(ctx context.Context, exec boil.ContextExecutor, related ...*TodoRevision) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ActorID, nil)
+		if rel.R != nil {
+			rel.R.Actor = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("actor_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ActorTodoRevisions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ActorTodoRevisions)
+			if ln > 1 && i < ln-1 {
+				o.R.ActorTodoRevisions[i] = o.R.ActorTodoRevisions[ln-1]
+			}
+			o.R.ActorTodoRevisions = o.R.ActorTodoRevisions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTodoShares adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TodoShares.
+// Sets related.R.User appropriately.
+func (o *User) AddTodoShares(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoShare) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_shares` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, todoSharePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TodoShares: related,
+		}
+	} else {
+		o.R.TodoShares = append(o.R.TodoShares, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoShareR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddTodoTemplates adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.TodoTemplates.
+// Sets related.R.User appropriately.
+func (o *User) AddTodoTemplates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TodoTemplate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todo_templates` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, todoTemplatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TodoTemplates: related,
+		}
+	} else {
+		o.R.TodoTemplates = append(o.R.TodoTemplates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoTemplateR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAssigneeTodos adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AssigneeTodos.
+// Sets related.R.Assignee appropriately.
+func (o *User) AddAssigneeTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AssigneeID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todos` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"assignee_id"}),
+				strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AssigneeID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AssigneeTodos: related,
+		}
+	} else {
+		o.R.AssigneeTodos = append(o.R.AssigneeTodos, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoR{
+				Assignee: o,
+			}
+		} else {
+			rel.R.Assignee = o
+		}
+	}
+	return nil
+}
+
+// SetAssigneeTodos removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Assignee's AssigneeTodos accordingly.
+// Replaces o.R.AssigneeTodos with related.
+// Sets related.R.Assignee's AssigneeTodos accordingly.
+func (o *User) SetAssigneeTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
+	query := "update `todos` set `assignee_id` = null where `assignee_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AssigneeTodos {
+			queries.SetScanner(&rel.AssigneeID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Assignee = nil
+		}
+		o.R.AssigneeTodos = nil
+	}
+
+	return o.AddAssigneeTodos(ctx, exec, insert, related...)
+}
+
+// RemoveAssigneeTodos relationships from objects passed in.
+// Removes related items from R.AssigneeTodos (uses pointer comparison, removal does not keep order)
+// Sets related.R.Assignee.
+func (o *User) RemoveAssigneeTodos(ctx context.Context, exec boil.ContextExecutor, related ...*Todo) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AssigneeID, nil)
+		if rel.R != nil {
+			rel.R.Assignee = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("assignee_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AssigneeTodos {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AssigneeTodos)
+			if ln > 1 && i < ln-1 {
+				o.R.AssigneeTodos[i] = o.R.AssigneeTodos[ln-1]
+			}
+			o.R.AssigneeTodos = o.R.AssigneeTodos[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTodos adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Todos.
+// Sets related.R.User appropriately.
+func (o *User) AddTodos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Todo) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `todos` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, todoPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Todos: related,
+		}
+	} else {
+		o.R.Todos = append(o.R.Todos, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &todoR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddWebhooks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Webhooks.
+// Sets related.R.User appropriately.
+func (o *User) AddWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `webhooks` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, webhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Webhooks: related,
+		}
+	} else {
+		o.R.Webhooks = append(o.R.Webhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// Users retrieves all the records using an executor.
+func Users(mods ...qm.QueryMod) userQuery {
+	mods = append(mods, qm.From("`users`"))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"`users`.*"})
+	}
+
+	return userQuery{q}
+}
+
+// FindUser retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUser(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*User, error) {
+	userObj := &User{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from `users` where `id`=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, userObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from users")
+	}
+
+	if err = userObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userObj, err
+	}
+
+	return userObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *User) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no users provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userInsertCacheMut.RLock()
+	cache, cached := userInsertCache[key]
+	userInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userAllColumns,
+			userColumnsWithDefault,
+			userColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userType, userMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userType, userMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO `users` (`%s`) %%sVALUES (%s)%%s", strings.Join(wl, "`,`"), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO `users` () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT `%s` FROM `users` WHERE %s", strings.Join(returnColumns, "`,`"), strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into users")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, identifierCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for users")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userInsertCacheMut.Lock()
+		userInsertCache[key] = cache
+		userInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the User.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *User) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userUpdateCacheMut.RLock()
+	cache, cached := userUpdateCache[key]
+	userUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userAllColumns,
+			userPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update users, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE `users` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, wl),
+			strmangle.WhereClause("`", "`", 0, userPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userType, userMapping, append(wl, userPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update users row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for users")
+	}
+
+	if !cached {
+		userUpdateCacheMut.Lock()
+		userUpdateCache[key] = cache
+		userUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for users")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE `users` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in user slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all user")
+	}
+	return rowsAff, nil
+}
+
+var mySQLUserUniqueColumns = []string{
+	"id",
+	"email",
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *User) Upsert(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no users provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userColumnsWithDefault, o)
+	nzUniques := queries.NonZeroDefaultSet(mySQLUserUniqueColumns, o)
+
+	if len(nzUniques) == 0 {
+		return errors.New("cannot upsert with a table that cannot conflict on a unique column")
+	}
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzUniques {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userUpsertCacheMut.RLock()
+	cache, cached := userUpsertCache[key]
+	userUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userAllColumns,
+			userColumnsWithDefault,
+			userColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userAllColumns,
+			userPrimaryKeyColumns,
+		)
+
+		if !updateColumns.IsNone() && len(update) == 0 {
+			return errors.New("models: unable to upsert users, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userAllColumns, strmangle.SetIntersect(insert, update))
+
+		cache.query = buildUpsertQueryMySQL(dialect, "`users`", update, insert)
+		cache.retQuery = fmt.Sprintf(
+			"SELECT %s FROM `users` WHERE %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, ret), ","),
+			strmangle.WhereClause("`", "`", 0, nzUniques),
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userType, userMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userType, userMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert for users")
+	}
+
+	var lastID int64
+	var uniqueMap []uint64
+	var nzUniqueCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == userMapping["id"] {
+		goto CacheNoHooks
+	}
+
+	uniqueMap, err = queries.BindMapping(userType, userMapping, nzUniques)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to retrieve unique values for users")
+	}
+	nzUniqueCols = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), uniqueMap)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.retQuery)
+		fmt.Fprintln(writer, nzUniqueCols...)
+	}
+	err = exec.QueryRowContext(ctx, cache.retQuery, nzUniqueCols...).Scan(returns...)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to populate default values for users")
+	}
+
+CacheNoHooks:
+	if !cached {
+		userUpsertCacheMut.Lock()
+		userUpsertCache[key] = cache
+		userUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single User record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *User) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no User provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userPrimaryKeyMapping)
+	sql := "DELETE FROM `users` WHERE `id`=?"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for users")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no userQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from users")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for users")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM `users` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from user slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for users")
+	}
+
+	if len(userAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *User) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUser(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT `users`.* FROM `users` WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, userPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in UserSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserExists checks if the User row exists.
+func UserExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from `users` where `id`=? limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if users exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the User row exists.
+func (o *User) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserExists(ctx, exec, o.ID)
+}
+
+// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
+// Expose table columns
+var (
+	UserAllColumns            = userAllColumns
+	UserColumnsWithoutDefault = userColumnsWithoutDefault
+	UserColumnsWithDefault    = userColumnsWithDefault
+	UserPrimaryKeyColumns     = userPrimaryKeyColumns
+	UserGeneratedColumns      = userGeneratedColumns
+)
+
+// GetID get ID from model object
+func (o *User) GetID() int {
+	return o.ID
+}
+
+// GetIDs extract IDs from model objects
+func (s UserSlice) GetIDs() []int {
+	result := make([]int, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// GetIntfIDs extract IDs from model objects as interface slice
+func (s UserSlice) GetIntfIDs() []interface{} {
+	result := make([]interface{}, len(s))
+	for i := range s {
+		result[i] = s[i].ID
+	}
+	return result
+}
+
+// ToIDMap convert a slice of model objects to a map with ID as key
+func (s UserSlice) ToIDMap() map[int]*User {
+	result := make(map[int]*User, len(s))
+	for _, o := range s {
+		result[o.ID] = o
+	}
+	return result
+}
+
+// ToUniqueItems construct a slice of unique items from the given slice
+func (s UserSlice) ToUniqueItems() UserSlice {
+	result := make(UserSlice, 0, len(s))
+	mapChk := make(map[int]struct{}, len(s))
+	for i := len(s) - 1; i >= 0; i-- {
+		o := s[i]
+		if _, ok := mapChk[o.ID]; !ok {
+			mapChk[o.ID] = struct{}{}
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindItemByID find item by ID in the slice
+func (s UserSlice) FindItemByID(id int) *User {
+	for _, o := range s {
+		if o.ID == id {
+			return o
+		}
+	}
+	return nil
+}
+
+// FindMissingItemIDs find all item IDs that are not in the list
+// NOTE: the input ID slice should contain unique values
+func (s UserSlice) FindMissingItemIDs(expectedIDs []int) []int {
+	if len(s) == 0 {
+		return expectedIDs
+	}
+	result := []int{}
+	mapChk := s.ToIDMap()
+	for _, id := range expectedIDs {
+		if _, ok := mapChk[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// InsertAll inserts all rows with the specified column values, using an executor.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o UserSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to insert
+	wlCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		wl, _ := columns.InsertColumnSet(
+			userAllColumns,
+			userColumnsWithDefault,
+			userColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(userColumnsWithDefault, row),
+		)
+		for _, col := range wl {
+			wlCols[col] = struct{}{}
+		}
+	}
+	wl := make([]string, 0, len(wlCols))
+	for _, col := range userAllColumns {
+		if _, ok := wlCols[col]; ok {
+			wl = append(wl, col)
+		}
+	}
+
+	var sql string
+	vals := []interface{}{}
+	for i, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+			if row.UpdatedAt.IsZero() {
+				row.UpdatedAt = currTime
+			}
+		}
+
+		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
+
+		if i == 0 {
+			sql = "INSERT INTO `users` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		}
+		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
+		if i != len(o)-1 {
+			sql += ","
+		}
+		valMapping, err := queries.BindMapping(userType, userMapping, wl)
+		if err != nil {
+			return 0, err
+		}
+
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, vals...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to insert all from user slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for users")
+	}
+
+	if len(userAfterInsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o UserSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	return o.UpsertAll(ctx, exec, boil.None(), columns)
+}
+
+// UpsertAll inserts or updates all rows
+// Currently it doesn't support "NoContext" and "NoRowsAffected"
+// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
+func (o UserSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	// Calculate the widest columns from all rows need to upsert
+	insertCols := make(map[string]struct{}, 10)
+	for _, row := range o {
+		nzUniques := queries.NonZeroDefaultSet(mySQLUserUniqueColumns, row)
+		if len(nzUniques) == 0 {
+			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
+		}
+		insert, _ := insertColumns.InsertColumnSet(
+			userAllColumns,
+			userColumnsWithDefault,
+			userColumnsWithoutDefault,
+			queries.NonZeroDefaultSet(userColumnsWithDefault, row),
+		)
+		for _, col := range insert {
+			insertCols[col] = struct{}{}
+		}
+	}
+	insert := make([]string, 0, len(insertCols))
+	for _, col := range userAllColumns {
+		if _, ok := insertCols[col]; ok {
+			insert = append(insert, col)
+		}
+	}
+
+	update := updateColumns.UpdateColumnSet(
+		userAllColumns,
+		userPrimaryKeyColumns,
+	)
+	if !updateColumns.IsNone() && len(update) == 0 {
+		return 0, errors.New("models: unable to upsert users, could not build update column list")
+	}
+
+	buf := strmangle.GetBuffer()
+	defer strmangle.PutBuffer(buf)
+
+	if len(update) == 0 {
+		fmt.Fprintf(
+			buf,
+			"INSERT IGNORE INTO `users`(%s) VALUES %s",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+	} else {
+		fmt.Fprintf(
+			buf,
+			"INSERT INTO `users`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
+			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
+			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
+		)
+
+		for i, v := range update {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
+			buf.WriteString(quoted)
+			buf.WriteString(" = VALUES(")
+			buf.WriteString(quoted)
+			buf.WriteByte(')')
+		}
+	}
+
+	query := buf.String()
+	valueMapping, err := queries.BindMapping(userType, userMapping, insert)
+	if err != nil {
+		return 0, err
+	}
+
+	var vals []interface{}
+	for _, row := range o {
+		if !boil.TimestampsAreSkipped(ctx) {
+			currTime := time.Now().In(boil.GetLocation())
+			if row.CreatedAt.IsZero() {
+				row.CreatedAt = currTime
+			}
+
+			row.UpdatedAt = currTime
+		}
 
-	return nil
-}
+		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
+			return 0, err
+		}
 
-// UserExists checks if the User row exists.
-func UserExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
-	var exists bool
-	sql := "select exists(select 1 from `users` where `id`=? limit 1)"
+		value := reflect.Indirect(reflect.ValueOf(row))
+		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, iD)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, vals)
 	}
-	row := exec.QueryRowContext(ctx, sql, iD)
 
-	err := row.Scan(&exists)
+	result, err := exec.ExecContext(ctx, query, vals...)
 	if err != nil {
-		return false, errors.Wrap(err, "models: unable to check if users exists")
+		return 0, errors.Wrap(err, "models: unable to upsert for users")
 	}
 
-	return exists, nil
-}
-
-// Exists checks if the User row exists.
-func (o *User) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	return UserExists(ctx, exec, o.ID)
-}
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for users")
+	}
 
-// /////////////////////////////// BEGIN EXTENSIONS /////////////////////////////////
-// Expose table columns
-var (
-	UserAllColumns            = userAllColumns
-	UserColumnsWithoutDefault = userColumnsWithoutDefault
-	UserColumnsWithDefault    = userColumnsWithDefault
-	UserPrimaryKeyColumns     = userPrimaryKeyColumns
-	UserGeneratedColumns      = userGeneratedColumns
-)
+	if len(userAfterUpsertHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
 
-// GetID get ID from model object
-func (o *User) GetID() int {
-	return o.ID
+	return rowsAff, nil
 }
 
-// GetIDs extract IDs from model objects
-func (s UserSlice) GetIDs() []int {
-	result := make([]int, len(s))
-	for i := range s {
-		result[i] = s[i].ID
+// DeleteAllByPage delete all User records from the slice.
+// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s UserSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
 	}
-	return result
-}
 
-// GetIntfIDs extract IDs from model objects as interface slice
-func (s UserSlice) GetIntfIDs() []interface{} {
-	result := make([]interface{}, len(s))
-	for i := range s {
-		result[i] = s[i].ID
+	// MySQL max placeholders = 65535
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
 	}
-	return result
-}
-
-// ToIDMap convert a slice of model objects to a map with ID as key
-func (s UserSlice) ToIDMap() map[int]*User {
-	result := make(map[int]*User, len(s))
-	for _, o := range s {
-		result[o.ID] = o
+	if length <= chunkSize {
+		return s.DeleteAll(ctx, exec)
 	}
-	return result
-}
 
-// ToUniqueItems construct a slice of unique items from the given slice
-func (s UserSlice) ToUniqueItems() UserSlice {
-	result := make(UserSlice, 0, len(s))
-	mapChk := make(map[int]struct{}, len(s))
-	for i := len(s) - 1; i >= 0; i-- {
-		o := s[i]
-		if _, ok := mapChk[o.ID]; !ok {
-			mapChk[o.ID] = struct{}{}
-			result = append(result, o)
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].DeleteAll(ctx, exec)
+		if err != nil {
+			return rowsAffected, err
 		}
-	}
-	return result
-}
 
-// FindItemByID find item by ID in the slice
-func (s UserSlice) FindItemByID(id int) *User {
-	for _, o := range s {
-		if o.ID == id {
-			return o
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
 		}
 	}
-	return nil
+	return rowsAffected, nil
 }
 
-// FindMissingItemIDs find all item IDs that are not in the list
-// NOTE: the input ID slice should contain unique values
-func (s UserSlice) FindMissingItemIDs(expectedIDs []int) []int {
-	if len(s) == 0 {
-		return expectedIDs
+// UpdateAllByPage update all User records from the slice.
+// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s UserSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
 	}
-	result := []int{}
-	mapChk := s.ToIDMap()
-	for _, id := range expectedIDs {
-		if _, ok := mapChk[id]; !ok {
-			result = append(result, id)
+
+	// MySQL max placeholders = 65535
+	// NOTE (eric): len(cols) should not be too big
+	chunkSize := DefaultPageSize
+	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.UpdateAll(ctx, exec, cols)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
+		if err != nil {
+			return rowsAffected, err
+		}
+
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
 		}
 	}
-	return result
+	return rowsAffected, nil
 }
 
-// InsertAll inserts all rows with the specified column values, using an executor.
-// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
-func (o UserSlice) InsertAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
-	if len(o) == 0 {
+// InsertAllByPage insert all User records from the slice.
+// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s UserSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
 		return 0, nil
 	}
 
-	// Calculate the widest columns from all rows need to insert
-	wlCols := make(map[string]struct{}, 10)
-	for _, row := range o {
-		wl, _ := columns.InsertColumnSet(
-			userAllColumns,
-			userColumnsWithDefault,
-			userColumnsWithoutDefault,
-			queries.NonZeroDefaultSet(userColumnsWithDefault, row),
-		)
-		for _, col := range wl {
-			wlCols[col] = struct{}{}
-		}
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&UserColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
 	}
-	wl := make([]string, 0, len(wlCols))
-	for _, col := range userAllColumns {
-		if _, ok := wlCols[col]; ok {
-			wl = append(wl, col)
-		}
+	if length <= chunkSize {
+		return s.InsertAll(ctx, exec, columns)
 	}
 
-	var sql string
-	vals := []interface{}{}
-	for i, row := range o {
-		if !boil.TimestampsAreSkipped(ctx) {
-			currTime := time.Now().In(boil.GetLocation())
-			if row.CreatedAt.IsZero() {
-				row.CreatedAt = currTime
-			}
-			if row.UpdatedAt.IsZero() {
-				row.UpdatedAt = currTime
-			}
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
 		}
-
-		if err := row.doBeforeInsertHooks(ctx, exec); err != nil {
-			return 0, err
+		rows, err := s[start:end].InsertAll(ctx, exec, columns)
+		if err != nil {
+			return rowsAffected, err
 		}
 
-		if i == 0 {
-			sql = "INSERT INTO `users` " + "(`" + strings.Join(wl, "`,`") + "`)" + " VALUES "
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
 		}
-		sql += strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), len(vals)+1, len(wl))
-		if i != len(o)-1 {
-			sql += ","
+	}
+	return rowsAffected, nil
+}
+
+// InsertIgnoreAllByPage insert all User records from the slice.
+// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
+func (s UserSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
+	}
+
+	// max number of parameters = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&UserColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
+	}
+	if length <= chunkSize {
+		return s.InsertIgnoreAll(ctx, exec, columns)
+	}
+
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
 		}
-		valMapping, err := queries.BindMapping(userType, userMapping, wl)
+		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
 		if err != nil {
-			return 0, err
+			return rowsAffected, err
 		}
 
-		value := reflect.Indirect(reflect.ValueOf(row))
-		vals = append(vals, queries.ValuesFromMapping(value, valMapping)...)
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
 	}
+	return rowsAffected, nil
+}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, sql)
-		fmt.Fprintln(writer, vals)
+// UpsertAllByPage upsert all User records from the slice.
+// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
+// Mysql Error 1390: Prepared statement contains too many placeholders.
+func (s UserSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
+	length := len(s)
+	if length == 0 {
+		return 0, nil
 	}
 
-	result, err := exec.ExecContext(ctx, sql, vals...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to insert all from user slice")
+	// MySQL max placeholders = 65535
+	chunkSize := MaxPageSize / reflect.ValueOf(&UserColumns).Elem().NumField()
+	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
+		chunkSize = limits[0]
 	}
-
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by insertall for users")
+	if length <= chunkSize {
+		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
 	}
 
-	if len(userAfterInsertHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterInsertHooks(ctx, exec); err != nil {
-				return 0, err
-			}
+	rowsAffected := int64(0)
+	start := 0
+	for {
+		end := start + chunkSize
+		if end > length {
+			end = length
+		}
+		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
+		if err != nil {
+			return rowsAffected, err
 		}
-	}
 
-	return rowsAff, nil
+		rowsAffected += rows
+		start = end
+		if start >= length {
+			break
+		}
+	}
+	return rowsAffected, nil
 }
 
-// InsertIgnoreAll inserts all rows with ignoring the existing ones having the same primary key values.
-// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
-func (o UserSlice) InsertIgnoreAll(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
-	return o.UpsertAll(ctx, exec, boil.None(), columns)
+// LoadActorActivitiesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadActorActivitiesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadActorActivitiesByPageEx(ctx, e, DefaultPageSize, mods...)
 }
-
-// UpsertAll inserts or updates all rows
-// Currently it doesn't support "NoContext" and "NoRowsAffected"
-// IMPORTANT: this will calculate the widest columns from all items in the slice, be careful if you want to use default column values
-func (o UserSlice) UpsertAll(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns) (int64, error) {
-	if len(o) == 0 {
-		return 0, nil
+func (s UserSlice) LoadActorActivitiesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	// Calculate the widest columns from all rows need to upsert
-	insertCols := make(map[string]struct{}, 10)
-	for _, row := range o {
-		nzUniques := queries.NonZeroDefaultSet(mySQLUserUniqueColumns, row)
-		if len(nzUniques) == 0 {
-			return 0, errors.New("cannot upsert with a table that cannot conflict on a unique column")
-		}
-		insert, _ := insertColumns.InsertColumnSet(
-			userAllColumns,
-			userColumnsWithDefault,
-			userColumnsWithoutDefault,
-			queries.NonZeroDefaultSet(userColumnsWithDefault, row),
-		)
-		for _, col := range insert {
-			insertCols[col] = struct{}{}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadActorActivities(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	insert := make([]string, 0, len(insertCols))
-	for _, col := range userAllColumns {
-		if _, ok := insertCols[col]; ok {
-			insert = append(insert, col)
+	return nil
+}
+
+func (s UserSlice) GetLoadedActorActivities() ActivitySlice {
+	result := make(ActivitySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.ActorActivities == nil {
+			continue
 		}
+		result = append(result, item.R.ActorActivities...)
 	}
+	return result
+}
 
-	update := updateColumns.UpdateColumnSet(
-		userAllColumns,
-		userPrimaryKeyColumns,
-	)
-	if !updateColumns.IsNone() && len(update) == 0 {
-		return 0, errors.New("models: unable to upsert users, could not build update column list")
+// LoadActivitiesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadActivitiesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadActivitiesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadActivitiesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	buf := strmangle.GetBuffer()
-	defer strmangle.PutBuffer(buf)
-
-	if len(update) == 0 {
-		fmt.Fprintf(
-			buf,
-			"INSERT IGNORE INTO `users`(%s) VALUES %s",
-			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
-			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
-		)
-	} else {
-		fmt.Fprintf(
-			buf,
-			"INSERT INTO `users`(%s) VALUES %s ON DUPLICATE KEY UPDATE ",
-			strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, insert), ","),
-			strmangle.Placeholders(false, len(insert)*len(o), 1, len(insert)),
-		)
-
-		for i, v := range update {
-			if i != 0 {
-				buf.WriteByte(',')
-			}
-			quoted := strmangle.IdentQuote(dialect.LQ, dialect.RQ, v)
-			buf.WriteString(quoted)
-			buf.WriteString(" = VALUES(")
-			buf.WriteString(quoted)
-			buf.WriteByte(')')
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadActivities(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	query := buf.String()
-	valueMapping, err := queries.BindMapping(userType, userMapping, insert)
-	if err != nil {
-		return 0, err
+func (s UserSlice) GetLoadedActivities() ActivitySlice {
+	result := make(ActivitySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Activities == nil {
+			continue
+		}
+		result = append(result, item.R.Activities...)
 	}
+	return result
+}
 
-	var vals []interface{}
-	for _, row := range o {
-		if !boil.TimestampsAreSkipped(ctx) {
-			currTime := time.Now().In(boil.GetLocation())
-			if row.CreatedAt.IsZero() {
-				row.CreatedAt = currTime
-			}
-
-			row.UpdatedAt = currTime
+// LoadAttachmentsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadAttachmentsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadAttachmentsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadAttachmentsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadAttachments(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if err := row.doBeforeUpsertHooks(ctx, exec); err != nil {
-			return 0, err
+func (s UserSlice) GetLoadedAttachments() AttachmentSlice {
+	result := make(AttachmentSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Attachments == nil {
+			continue
 		}
-
-		value := reflect.Indirect(reflect.ValueOf(row))
-		vals = append(vals, queries.ValuesFromMapping(value, valueMapping)...)
+		result = append(result, item.R.Attachments...)
 	}
+	return result
+}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, vals)
+// LoadNotificationPreferencesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadNotificationPreferencesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadNotificationPreferencesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadNotificationPreferencesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	result, err := exec.ExecContext(ctx, query, vals...)
-	if err != nil {
-		return 0, errors.Wrap(err, "models: unable to upsert for users")
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadNotificationPreferences(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAff, err := result.RowsAffected()
-	if err != nil {
-		return 0, errors.Wrap(err, "models: failed to get rows affected by upsert for users")
+func (s UserSlice) GetLoadedNotificationPreferences() NotificationPreferenceSlice {
+	result := make(NotificationPreferenceSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.NotificationPreferences == nil {
+			continue
+		}
+		result = append(result, item.R.NotificationPreferences...)
 	}
+	return result
+}
 
-	if len(userAfterUpsertHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterUpsertHooks(ctx, exec); err != nil {
-				return 0, err
-			}
+// LoadActorNotificationsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadActorNotificationsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadActorNotificationsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadActorNotificationsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadActorNotifications(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-
-	return rowsAff, nil
+	return nil
 }
 
-// DeleteAllByPage delete all User records from the slice.
-// This function deletes data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s UserSlice) DeleteAllByPage(ctx context.Context, exec boil.ContextExecutor, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s UserSlice) GetLoadedActorNotifications() NotificationSlice {
+	result := make(NotificationSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.ActorNotifications == nil {
+			continue
+		}
+		result = append(result, item.R.ActorNotifications...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	chunkSize := DefaultPageSize
-	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
-		chunkSize = limits[0]
-	}
-	if length <= chunkSize {
-		return s.DeleteAll(ctx, exec)
+// LoadNotificationsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadNotificationsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadNotificationsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadNotificationsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadNotifications(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
-		rows, err := s[start:end].DeleteAll(ctx, exec)
-		if err != nil {
-			return rowsAffected, err
+	}
+	return nil
+}
+
+func (s UserSlice) GetLoadedNotifications() NotificationSlice {
+	result := make(NotificationSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Notifications == nil {
+			continue
 		}
+		result = append(result, item.R.Notifications...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadProjectsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadProjectsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadProjectsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadProjectsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadProjects(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// UpdateAllByPage update all User records from the slice.
-// This function updates data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s UserSlice) UpdateAllByPage(ctx context.Context, exec boil.ContextExecutor, cols M, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s UserSlice) GetLoadedProjects() ProjectSlice {
+	result := make(ProjectSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Projects == nil {
+			continue
+		}
+		result = append(result, item.R.Projects...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	// NOTE (eric): len(cols) should not be too big
-	chunkSize := DefaultPageSize
-	if len(limits) > 0 && limits[0] > 0 && limits[0] <= MaxPageSize {
-		chunkSize = limits[0]
+// LoadSavedViewsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadSavedViewsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadSavedViewsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadSavedViewsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.UpdateAll(ctx, exec, cols)
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadSavedViews(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
-		}
-		rows, err := s[start:end].UpdateAll(ctx, exec, cols)
-		if err != nil {
-			return rowsAffected, err
+func (s UserSlice) GetLoadedSavedViews() SavedViewSlice {
+	result := make(SavedViewSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.SavedViews == nil {
+			continue
 		}
+		result = append(result, item.R.SavedViews...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadTimeEntriesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadTimeEntriesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTimeEntriesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadTimeEntriesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadTimeEntries(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// InsertAllByPage insert all User records from the slice.
-// This function inserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s UserSlice) InsertAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s UserSlice) GetLoadedTimeEntries() TimeEntrySlice {
+	result := make(TimeEntrySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TimeEntries == nil {
+			continue
+		}
+		result = append(result, item.R.TimeEntries...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	chunkSize := MaxPageSize / reflect.ValueOf(&UserColumns).Elem().NumField()
-	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
-		chunkSize = limits[0]
+// LoadTodoCommentsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadTodoCommentsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoCommentsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadTodoCommentsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.InsertAll(ctx, exec, columns)
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadTodoComments(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
-		}
-		rows, err := s[start:end].InsertAll(ctx, exec, columns)
-		if err != nil {
-			return rowsAffected, err
+func (s UserSlice) GetLoadedTodoComments() TodoCommentSlice {
+	result := make(TodoCommentSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoComments == nil {
+			continue
 		}
+		result = append(result, item.R.TodoComments...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadTodoRemindersByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadTodoRemindersByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoRemindersByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadTodoRemindersByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadTodoReminders(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// InsertIgnoreAllByPage insert all User records from the slice.
-// This function inserts data by pages to avoid exceeding Postgres limitation (max parameters: 65535)
-func (s UserSlice) InsertIgnoreAllByPage(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s UserSlice) GetLoadedTodoReminders() TodoReminderSlice {
+	result := make(TodoReminderSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoReminders == nil {
+			continue
+		}
+		result = append(result, item.R.TodoReminders...)
 	}
+	return result
+}
 
-	// max number of parameters = 65535
-	chunkSize := MaxPageSize / reflect.ValueOf(&UserColumns).Elem().NumField()
-	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
-		chunkSize = limits[0]
+// LoadActorTodoRevisionsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadActorTodoRevisionsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadActorTodoRevisionsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadActorTodoRevisionsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.InsertIgnoreAll(ctx, exec, columns)
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadActorTodoRevisions(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
-		}
-		rows, err := s[start:end].InsertIgnoreAll(ctx, exec, columns)
-		if err != nil {
-			return rowsAffected, err
+func (s UserSlice) GetLoadedActorTodoRevisions() TodoRevisionSlice {
+	result := make(TodoRevisionSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.ActorTodoRevisions == nil {
+			continue
 		}
+		result = append(result, item.R.ActorTodoRevisions...)
+	}
+	return result
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+// LoadTodoSharesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadTodoSharesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoSharesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadTodoSharesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadTodoShares(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
 	}
-	return rowsAffected, nil
+	return nil
 }
 
-// UpsertAllByPage upsert all User records from the slice.
-// This function upserts data by pages to avoid exceeding Mysql limitation (max placeholders: 65535)
-// Mysql Error 1390: Prepared statement contains too many placeholders.
-func (s UserSlice) UpsertAllByPage(ctx context.Context, exec boil.ContextExecutor, updateColumns, insertColumns boil.Columns, limits ...int) (int64, error) {
-	length := len(s)
-	if length == 0 {
-		return 0, nil
+func (s UserSlice) GetLoadedTodoShares() TodoShareSlice {
+	result := make(TodoShareSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoShares == nil {
+			continue
+		}
+		result = append(result, item.R.TodoShares...)
 	}
+	return result
+}
 
-	// MySQL max placeholders = 65535
-	chunkSize := MaxPageSize / reflect.ValueOf(&UserColumns).Elem().NumField()
-	if len(limits) > 0 && limits[0] > 0 && limits[0] < chunkSize {
-		chunkSize = limits[0]
+// LoadTodoTemplatesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadTodoTemplatesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTodoTemplatesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadTodoTemplatesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
 	}
-	if length <= chunkSize {
-		return s.UpsertAll(ctx, exec, updateColumns, insertColumns)
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadTodoTemplates(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	rowsAffected := int64(0)
-	start := 0
-	for {
-		end := start + chunkSize
-		if end > length {
-			end = length
+func (s UserSlice) GetLoadedTodoTemplates() TodoTemplateSlice {
+	result := make(TodoTemplateSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.TodoTemplates == nil {
+			continue
 		}
-		rows, err := s[start:end].UpsertAll(ctx, exec, updateColumns, insertColumns)
-		if err != nil {
-			return rowsAffected, err
+		result = append(result, item.R.TodoTemplates...)
+	}
+	return result
+}
+
+// LoadAssigneeTodosByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadAssigneeTodosByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadAssigneeTodosByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadAssigneeTodosByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadAssigneeTodos(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		rowsAffected += rows
-		start = end
-		if start >= length {
-			break
+func (s UserSlice) GetLoadedAssigneeTodos() TodoSlice {
+	result := make(TodoSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.AssigneeTodos == nil {
+			continue
 		}
+		result = append(result, item.R.AssigneeTodos...)
 	}
-	return rowsAffected, nil
+	return result
 }
 
 // LoadTodosByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
@@ -1745,4 +5414,31 @@ func (s UserSlice) GetLoadedTodos() TodoSlice {
 	return result
 }
 
+// LoadWebhooksByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadWebhooksByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadWebhooksByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadWebhooksByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadWebhooks(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s UserSlice) GetLoadedWebhooks() WebhookSlice {
+	result := make(WebhookSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Webhooks == nil {
+			continue
+		}
+		result = append(result, item.R.Webhooks...)
+	}
+	return result
+}
+
 ///////////////////////////////// END EXTENSIONS /////////////////////////////////
@@ -3,14 +3,25 @@ package main
 import (
 	"app/db"
 	"app/lib"
+	"app/lib/scheduler"
+	"app/services"
+	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/playground"
 )
 
-const defaultPort = "8080"
+const (
+	defaultPort     = "8080"
+	shutdownTimeout = 30 * time.Second
+)
 
 func main() {
 	port := os.Getenv("PORT")
@@ -20,10 +31,57 @@ func main() {
 
 	// NOTE: DB接続
 	dbCon := db.Init()
+	defer db.Close(dbCon)
 
 	http.Handle("/", playground.Handler("GraphQL playground", "/query"))
 	http.Handle("/query", lib.GetGraphQLHttpHandler(dbCon))
+	http.Handle(services.AttachmentDownloadPath, lib.GetAttachmentHttpHandler(dbCon))
+
+	// NOTE: SIGINT/SIGTERMを受けたらHTTPサーバとバックグラウンド処理を停止する
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// NOTE: バックグラウンド処理(リマインダー配信、ゴミ箱の定期削除、期日が近いTodoの通知、Webhookの配信)
+	var wg sync.WaitGroup
+	for _, sched := range []*scheduler.Scheduler{
+		lib.NewReminderScheduler(dbCon),
+		lib.NewTrashPurgeScheduler(dbCon),
+		lib.NewDueSoonNotificationScheduler(dbCon),
+		lib.NewWebhookDeliveryScheduler(dbCon),
+	} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sched.Run(ctx)
+		}()
+	}
+
+	server := &http.Server{Addr: ":" + port}
+	go func() {
+		log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal(err)
+		}
+	}()
 
-	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+	<-ctx.Done()
+	log.Println("shutting down server...")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("failed to shutdown server: %v", err)
+	}
+
+	// NOTE: 実行中のリマインダー配信が完了するまで待つ
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-shutdownCtx.Done():
+		log.Println("timed out waiting for background jobs")
+	}
 }
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	activityVerbCreated   = "created"
+	activityVerbCompleted = "completed"
+	activityVerbCommented = "commented"
+	activityVerbAssigned  = "assigned"
+	activityVerbShared    = "shared"
+)
+
+// NOTE: 操作の種類はDBに文字列で保存する
+var activityVerbs = map[model.ActivityVerb]string{
+	model.ActivityVerbCreated:   activityVerbCreated,
+	model.ActivityVerbCompleted: activityVerbCompleted,
+	model.ActivityVerbCommented: activityVerbCommented,
+	model.ActivityVerbAssigned:  activityVerbAssigned,
+	model.ActivityVerbShared:    activityVerbShared,
+}
+
+// NOTE: アクティビティとして記録するTodoの変更(Webhookのイベントとの対応)
+var todoActivityVerbs = map[string]string{
+	webhookEventTodoCreated:   activityVerbCreated,
+	webhookEventTodoCompleted: activityVerbCompleted,
+}
+
+type ActivityService interface {
+	FetchActivityFeed(ctx context.Context, projectID int, userID int, first *int, after *string) (*model.ActivityConnection, error)
+	FetchMyActivity(ctx context.Context, userID int, first *int, after *string) (*model.ActivityConnection, error)
+}
+
+type activityService struct {
+	db *sql.DB
+}
+
+func NewActivityService(db *sql.DB) ActivityService {
+	return &activityService{db}
+}
+
+// NOTE: プロジェクト内のTodoに対するアクティビティを新しいものから順に返す
+// プロジェクトの所有者以外(共同作業者)には、共有されたTodoに対するアクティビティのみ返す
+func (as *activityService) FetchActivityFeed(ctx context.Context, projectID int, userID int, first *int, after *string) (*model.ActivityConnection, error) {
+	owner, err := models.Projects(qm.Where("id = ? AND user_id = ?", projectID, userID)).Exists(ctx, as.db)
+	if err != nil {
+		return &model.ActivityConnection{}, view.NewInternalServerErrorView(err)
+	}
+	if owner {
+		return as.fetchActivities(ctx, qm.Where("project_id = ?", projectID), first, after)
+	}
+
+	shared, err := models.TodoShares(
+		qm.InnerJoin("todos ON todos.id = todo_shares.todo_id"),
+		qm.Where("todo_shares.user_id = ? AND todos.project_id = ?", userID, projectID),
+	).Exists(ctx, as.db)
+	if err != nil {
+		return &model.ActivityConnection{}, view.NewInternalServerErrorView(err)
+	}
+	if !shared {
+		return &model.ActivityConnection{}, view.NewNotFoundView(fmt.Errorf("プロジェクトが見つかりません。"))
+	}
+	return as.fetchActivities(ctx, qm.Where("project_id = ? AND todo_id IN (SELECT todo_id FROM todo_shares WHERE user_id = ?)", projectID, userID), first, after)
+}
+
+// NOTE: ログインユーザのTodoと、ログインユーザに共有されたTodoに対するアクティビティを新しいものから順に返す
+func (as *activityService) FetchMyActivity(ctx context.Context, userID int, first *int, after *string) (*model.ActivityConnection, error) {
+	return as.fetchActivities(ctx, qm.Where("(user_id = ? OR todo_id IN (SELECT todo_id FROM todo_shares WHERE user_id = ?))", userID, userID), first, after)
+}
+
+func (as *activityService) fetchActivities(ctx context.Context, condition qm.QueryMod, first *int, after *string) (*model.ActivityConnection, error) {
+	limit := pageSize(first)
+	mods := []qm.QueryMod{condition}
+
+	totalCount, err := models.Activities(mods...).Count(ctx, as.db)
+	if err != nil {
+		return &model.ActivityConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	if after != nil {
+		afterID, err := decodeCursor(*after)
+		if err != nil {
+			return &model.ActivityConnection{}, view.NewBadRequestView(err)
+		}
+		mods = append(mods, qm.Where("id < ?", afterID))
+	}
+	// NOTE: 次ページの有無を判定するため1件多く取得する
+	mods = append(mods,
+		qm.OrderBy("id DESC"),
+		qm.Limit(limit+1),
+		qm.Load(models.ActivityRels.Actor),
+		qm.Load(models.ActivityRels.Todo),
+		qm.Load(models.ActivityRels.Project),
+		qm.Load(models.ActivityRels.TodoComment),
+	)
+	activities, err := models.Activities(mods...).All(ctx, as.db)
+	if err != nil {
+		return &model.ActivityConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	hasNextPage := len(activities) > limit
+	if hasNextPage {
+		activities = activities[:limit]
+	}
+
+	edges := make([]*model.ActivityEdge, 0, len(activities))
+	for _, activity := range activities {
+		edges = append(edges, &model.ActivityEdge{Cursor: encodeCursor(activity.ID), Node: activity})
+	}
+	pageInfo := &model.PageInfo{HasNextPage: hasNextPage, HasPreviousPage: after != nil}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.ActivityConnection{Edges: edges, PageInfo: pageInfo, TotalCount: int(totalCount)}, nil
+}
+
+// NOTE: Todoの作成・完了をアクティビティとして記録する(それ以外の変更は記録しない)
+func insertTodoActivity(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, event string) error {
+	verb, ok := todoActivityVerbs[event]
+	if !ok {
+		return nil
+	}
+	return insertActivity(ctx, exec, &models.Activity{
+		UserID:    todo.UserID,
+		Verb:      verb,
+		TodoID:    null.IntFrom(todo.ID),
+		ProjectID: todo.ProjectID,
+	})
+}
+
+// NOTE: Todoの共有・割り当てをアクティビティとして記録する
+func insertTodoShareActivity(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, verb string) error {
+	return insertActivity(ctx, exec, &models.Activity{
+		UserID:    todo.UserID,
+		Verb:      verb,
+		TodoID:    null.IntFrom(todo.ID),
+		ProjectID: todo.ProjectID,
+	})
+}
+
+// NOTE: 操作したユーザはリクエストのログインユーザとする(スケジューラ等による操作の場合は対象の所有者)
+func insertActivity(ctx context.Context, exec boil.ContextExecutor, activity *models.Activity) error {
+	activity.ActorID = activity.UserID
+	if user := auth.GetUser(ctx); user != nil {
+		activity.ActorID = user.ID
+	}
+	return activity.Insert(ctx, exec, boil.Infer())
+}
+
+// NOTE: DBに保存された操作の種類をGraphQLの型へ変換する
+func ActivityVerbFromType(verb string) model.ActivityVerb {
+	for activityVerb, v := range activityVerbs {
+		if v == verb {
+			return activityVerb
+		}
+	}
+	return ""
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestActivityServiceSuite struct {
+	WithDBSuite
+}
+
+var testActivityService ActivityService
+
+func (s *TestActivityServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testActivityService = NewActivityService(DBCon)
+	testTodoService = newTestTodoService(s.T())
+}
+
+func (s *TestActivityServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestActivityServiceSuite) createProjectTodo() (*models.Project, *models.Todo) {
+	project, err := NewProjectService(DBCon).CreateProject(ctx, model.CreateProjectInput{Name: "project 1"}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test projects %v", err)
+	}
+	projectID := strconv.Itoa(project.ID)
+	todo, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: "", ProjectID: &projectID}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	return project, todo
+}
+
+func (s *TestActivityServiceSuite) TestFetchActivityFeed() {
+	project, todo := s.createProjectTodo()
+	// NOTE: 作成・完了以外の変更は記録されないことの確認
+	if _, err := testTodoService.UpdateTodo(ctx, todo.ID, model.UpdateTodoInput{Title: omittableString("b"), ExpectedVersion: todo.Version}, user.ID); err != nil {
+		s.T().Fatalf("failed to update test todos %v", err)
+	}
+	if _, err := testTodoService.CompleteTodo(ctx, todo.ID, false, user.ID); err != nil {
+		s.T().Fatalf("failed to complete test todos %v", err)
+	}
+	comment, err := NewCommentService(DBCon).AddComment(ctx, model.AddCommentInput{TodoID: strconv.Itoa(todo.ID), Content: "コメント"}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test comments %v", err)
+	}
+
+	connection, err := testActivityService.FetchActivityFeed(ctx, project.ID, user.ID, nil, nil)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, connection.TotalCount)
+	verbs := []model.ActivityVerb{}
+	for _, edge := range connection.Edges {
+		verbs = append(verbs, ActivityVerbFromType(edge.Node.Verb))
+		assert.Equal(s.T(), todo.ID, edge.Node.R.Todo.ID)
+		assert.Equal(s.T(), project.ID, edge.Node.R.Project.ID)
+	}
+	assert.Equal(s.T(), []model.ActivityVerb{model.ActivityVerbCommented, model.ActivityVerbCompleted, model.ActivityVerbCreated}, verbs)
+	assert.Equal(s.T(), comment.ID, connection.Edges[0].Node.R.TodoComment.ID)
+}
+
+func (s *TestActivityServiceSuite) TestFetchActivityFeed_NotFound() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	project, _ := s.createProjectTodo()
+
+	_, err := testActivityService.FetchActivityFeed(ctx, project.ID, otherUser.ID, nil, nil)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestActivityServiceSuite) TestFetchActivityFeed_Collaborator() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := collaborator.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	project, todo := s.createProjectTodo()
+	projectID := strconv.Itoa(project.ID)
+	if _, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "共有しない", Content: "", ProjectID: &projectID}, user.ID); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	if _, err := testTodoService.ShareTodo(ctx, todo.ID, "test_2@example.com", user.ID); err != nil {
+		s.T().Fatalf("failed to share test todos %v", err)
+	}
+	if _, err := testTodoService.AssignTodo(ctx, todo.ID, &collaborator.ID, user.ID); err != nil {
+		s.T().Fatalf("failed to assign test todos %v", err)
+	}
+
+	connection, err := testActivityService.FetchActivityFeed(ctx, project.ID, collaborator.ID, nil, nil)
+
+	// NOTE: 共同作業者には共有されたTodoのアクティビティのみ返ることの確認
+	assert.Nil(s.T(), err)
+	verbs := []model.ActivityVerb{}
+	for _, edge := range connection.Edges {
+		verbs = append(verbs, ActivityVerbFromType(edge.Node.Verb))
+		assert.Equal(s.T(), todo.ID, edge.Node.R.Todo.ID)
+	}
+	assert.Equal(s.T(), []model.ActivityVerb{model.ActivityVerbAssigned, model.ActivityVerbShared, model.ActivityVerbCreated}, verbs)
+	mine, _ := testActivityService.FetchMyActivity(ctx, collaborator.ID, nil, nil)
+	assert.Equal(s.T(), 3, mine.TotalCount)
+	owned, _ := testActivityService.FetchActivityFeed(ctx, project.ID, user.ID, nil, nil)
+	assert.Equal(s.T(), 4, owned.TotalCount)
+}
+
+func (s *TestActivityServiceSuite) TestFetchMyActivity() {
+	for i := 0; i < 3; i++ {
+		if _, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID); err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+	}
+
+	first := 2
+	connection, err := testActivityService.FetchMyActivity(ctx, user.ID, &first, nil)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, connection.TotalCount)
+	assert.Len(s.T(), connection.Edges, 2)
+	assert.True(s.T(), connection.PageInfo.HasNextPage)
+
+	next, err := testActivityService.FetchMyActivity(ctx, user.ID, &first, connection.PageInfo.EndCursor)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), next.Edges, 1)
+	assert.False(s.T(), next.PageInfo.HasNextPage)
+	assert.Less(s.T(), next.Edges[0].Node.ID, connection.Edges[1].Node.ID)
+}
+
+func (s *TestActivityServiceSuite) TestInsertActivity_Actor() {
+	activity := &models.Activity{UserID: user.ID, Verb: activityVerbShared}
+
+	// NOTE: ログインユーザがいない場合は所有者を操作したユーザとすることの確認
+	err := insertActivity(ctx, DBCon, activity)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), user.ID, activity.ActorID)
+	assert.Equal(s.T(), model.ActivityVerbShared, ActivityVerbFromType(activity.Verb))
+}
+
+func TestActivityService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestActivityServiceSuite))
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"app/lib/storage"
+	models "app/models/generated"
+	"app/view"
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// MaxAttachmentSize 添付ファイル1件あたりの上限サイズ(10MB)
+	MaxAttachmentSize = 10 << 20
+	// AttachmentDownloadPath 添付ファイルのダウンロード用エンドポイント
+	AttachmentDownloadPath = "/attachments/download"
+
+	attachmentURLExpiration = 15 * time.Minute
+)
+
+// NOTE: 添付を許可するMIMEタイプ(ファイル内容から判定した値で照合する)
+var allowedAttachmentTypes = map[string]struct{}{
+	"image/png":       {},
+	"image/jpeg":      {},
+	"image/gif":       {},
+	"image/webp":      {},
+	"application/pdf": {},
+	"text/plain":      {},
+}
+
+type AttachmentService interface {
+	AttachFile(ctx context.Context, todoID int, file graphql.Upload, userID int) (*models.Attachment, error)
+	RemoveAttachment(ctx context.Context, id int, userID int) (string, error)
+	FetchAttachments(ctx context.Context, todoID int) ([]*models.Attachment, error)
+	SignedDownloadURL(attachment *models.Attachment) string
+	OpenSignedAttachment(ctx context.Context, query url.Values) (*models.Attachment, io.ReadCloser, error)
+}
+
+type attachmentService struct {
+	db         *sql.DB
+	storage    storage.Storage
+	signingKey []byte
+}
+
+func NewAttachmentService(db *sql.DB, blobStorage storage.Storage, signingKey []byte) AttachmentService {
+	return &attachmentService{db, blobStorage, signingKey}
+}
+
+func (as *attachmentService) AttachFile(ctx context.Context, todoID int, file graphql.Upload, userID int) (*models.Attachment, error) {
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, as.db)
+	if err != nil {
+		return &models.Attachment{}, view.NewNotFoundView(err)
+	}
+
+	// NOTE: サイズとMIMEタイプのチェック
+	if file.Size <= 0 || file.Size > MaxAttachmentSize {
+		return &models.Attachment{}, view.NewBadRequestView(fmt.Errorf("添付ファイルは%dMBまででお願いします。", MaxAttachmentSize>>20))
+	}
+	reader := bufio.NewReaderSize(file.File, 512)
+	head, _ := reader.Peek(512)
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if _, ok := allowedAttachmentTypes[contentType]; !ok {
+		return &models.Attachment{}, view.NewBadRequestView(fmt.Errorf("この形式のファイルは添付できません。"))
+	}
+
+	storageKey, err := newStorageKey(todo.ID, file.Filename)
+	if err != nil {
+		return &models.Attachment{}, view.NewInternalServerErrorView(err)
+	}
+	// NOTE: 申告サイズを超えるデータは保存しない
+	if err := as.storage.Put(ctx, storageKey, io.LimitReader(reader, MaxAttachmentSize)); err != nil {
+		return &models.Attachment{}, view.NewInternalServerErrorView(err)
+	}
+
+	attachment := &models.Attachment{}
+	attachment.TodoID = todo.ID
+	attachment.UserID = userID
+	attachment.FileName = filepath.Base(file.Filename)
+	attachment.ContentType = contentType
+	attachment.Size = file.Size
+	attachment.StorageKey = storageKey
+
+	// NOTE: Create処理
+	if err := attachment.Insert(ctx, as.db, boil.Infer()); err != nil {
+		as.storage.Delete(ctx, storageKey)
+		return &models.Attachment{}, view.NewInternalServerErrorView(err)
+	}
+	return attachment, nil
+}
+
+func (as *attachmentService) RemoveAttachment(ctx context.Context, id int, userID int) (string, error) {
+	attachment, err := models.Attachments(
+		qm.InnerJoin("todos on todos.id = attachments.todo_id"),
+		qm.Where("attachments.id = ? AND todos.user_id = ? AND todos.deleted_at IS NULL", id, userID),
+	).One(ctx, as.db)
+	if err != nil {
+		return strconv.Itoa(id), view.NewNotFoundView(err)
+	}
+
+	if _, err := attachment.Delete(ctx, as.db); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	if err := as.storage.Delete(ctx, attachment.StorageKey); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (as *attachmentService) FetchAttachments(ctx context.Context, todoID int) ([]*models.Attachment, error) {
+	attachments, err := models.Attachments(qm.Where("todo_id = ?", todoID), qm.OrderBy("id ASC")).All(ctx, as.db)
+	if err != nil {
+		return models.AttachmentSlice{}, view.NewInternalServerErrorView(err)
+	}
+
+	return attachments, nil
+}
+
+// NOTE: 有効期限付きの署名済みダウンロードURLを発行する
+func (as *attachmentService) SignedDownloadURL(attachment *models.Attachment) string {
+	expires := strconv.FormatInt(time.Now().Add(attachmentURLExpiration).Unix(), 10)
+	query := url.Values{}
+	query.Set("id", strconv.Itoa(attachment.ID))
+	query.Set("expires", expires)
+	query.Set("signature", as.sign(strconv.Itoa(attachment.ID), expires))
+
+	return AttachmentDownloadPath + "?" + query.Encode()
+}
+
+// NOTE: 署名済みURLのクエリを検証し、添付ファイルを開く
+func (as *attachmentService) OpenSignedAttachment(ctx context.Context, query url.Values) (*models.Attachment, io.ReadCloser, error) {
+	id, expires, signature := query.Get("id"), query.Get("expires"), query.Get("signature")
+	if !hmac.Equal([]byte(signature), []byte(as.sign(id, expires))) {
+		return nil, nil, view.NewForbiddenView(fmt.Errorf("署名が不正です。"))
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > expiresAt {
+		return nil, nil, view.NewForbiddenView(fmt.Errorf("URLの有効期限が切れています。"))
+	}
+
+	intID, _ := strconv.Atoi(id)
+	attachment, err := models.FindAttachment(ctx, as.db, intID)
+	if err != nil {
+		return nil, nil, view.NewNotFoundView(err)
+	}
+	file, err := as.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, view.NewNotFoundView(err)
+	}
+	return attachment, file, nil
+}
+
+func (as *attachmentService) sign(id string, expires string) string {
+	mac := hmac.New(sha256.New, as.signingKey)
+	mac.Write([]byte(id + ":" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NOTE: 推測されないようランダムなストレージキーを生成する(拡張子のみ元ファイル名から引き継ぐ)
+func newStorageKey(todoID int, fileName string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("todos/%d/%s%s", todoID, hex.EncodeToString(b), filepath.Ext(filepath.Base(fileName))), nil
+}
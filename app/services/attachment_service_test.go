@@ -0,0 +1,121 @@
+package services
+
+import (
+	"app/lib/storage"
+	models "app/models/generated"
+	"app/test/factories"
+	"bytes"
+	"io"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestAttachmentServiceSuite struct {
+	WithDBSuite
+}
+
+var (
+	testAttachmentService AttachmentService
+	// NOTE: 1x1のPNG画像
+	testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\x01\x00\x00\x00\x01\x08\x06\x00\x00\x00\x1f\x15\xc4\x89\x00\x00\x00\rIDATx\x9cc\xf8\x0f\x00\x00\x01\x01\x00\x05\x18\xd8N\x00\x00\x00\x00IEND\xaeB`\x82")
+)
+
+func (s *TestAttachmentServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザとTodoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo = &models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	testAttachmentService = NewAttachmentService(DBCon, storage.NewLocalStorage(s.T().TempDir()), []byte("test-signing-key"))
+}
+
+func (s *TestAttachmentServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestAttachmentServiceSuite) TestAttachFile() {
+	file := graphql.Upload{File: bytes.NewReader(testPNG), Filename: "image.png", Size: int64(len(testPNG)), ContentType: "image/png"}
+
+	attachment, err := testAttachmentService.AttachFile(ctx, testTodo.ID, file, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "image.png", attachment.FileName)
+	assert.Equal(s.T(), "image/png", attachment.ContentType)
+
+	// NOTE: 署名済みURLからファイルを取得できることを確認
+	downloadURL, _ := url.Parse(testAttachmentService.SignedDownloadURL(attachment))
+	_, reader, err := testAttachmentService.OpenSignedAttachment(ctx, downloadURL.Query())
+	assert.Nil(s.T(), err)
+	defer reader.Close()
+	body, _ := io.ReadAll(reader)
+	assert.Equal(s.T(), testPNG, body)
+}
+
+func (s *TestAttachmentServiceSuite) TestAttachFile_UnsupportedType() {
+	content := []byte("#!/bin/sh\necho hello\n\x00\x01")
+	file := graphql.Upload{File: bytes.NewReader(content), Filename: "script.png", Size: int64(len(content)), ContentType: "image/png"}
+
+	_, err := testAttachmentService.AttachFile(ctx, testTodo.ID, file, user.ID)
+
+	assert.NotNil(s.T(), err)
+	count, _ := models.Attachments().Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestAttachmentServiceSuite) TestAttachFile_TooLarge() {
+	file := graphql.Upload{File: bytes.NewReader(testPNG), Filename: "image.png", Size: MaxAttachmentSize + 1, ContentType: "image/png"}
+
+	_, err := testAttachmentService.AttachFile(ctx, testTodo.ID, file, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestAttachmentServiceSuite) TestOpenSignedAttachment_InvalidSignature() {
+	file := graphql.Upload{File: bytes.NewReader(testPNG), Filename: "image.png", Size: int64(len(testPNG)), ContentType: "image/png"}
+	attachment, err := testAttachmentService.AttachFile(ctx, testTodo.ID, file, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test attachment %v", err)
+	}
+
+	downloadURL, _ := url.Parse(testAttachmentService.SignedDownloadURL(attachment))
+	query := downloadURL.Query()
+	query.Set("id", strconv.Itoa(attachment.ID+1))
+	_, _, err = testAttachmentService.OpenSignedAttachment(ctx, query)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestAttachmentServiceSuite) TestRemoveAttachment() {
+	file := graphql.Upload{File: bytes.NewReader(testPNG), Filename: "image.png", Size: int64(len(testPNG)), ContentType: "image/png"}
+	attachment, err := testAttachmentService.AttachFile(ctx, testTodo.ID, file, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test attachment %v", err)
+	}
+
+	id, err := testAttachmentService.RemoveAttachment(ctx, attachment.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), strconv.Itoa(attachment.ID), id)
+	// NOTE: 添付ファイルが削除されていることの確認
+	reloadErr := attachment.Reload(ctx, DBCon)
+	assert.NotNil(s.T(), reloadErr)
+}
+
+func TestAttachmentService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestAttachmentServiceSuite))
+}
@@ -0,0 +1,422 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/rank"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: ボード作成時に用意する列
+var defaultBoardColumns = []model.BoardColumnInput{
+	{Name: "未着手", Status: func() *model.TodoStatus { s := model.TodoStatusOpen; return &s }()},
+	{Name: "進行中"},
+	{Name: "完了", Status: func() *model.TodoStatus { s := model.TodoStatusCompleted; return &s }()},
+}
+
+type BoardService interface {
+	CreateBoard(ctx context.Context, projectID int, userID int) (*models.KanbanBoard, error)
+	AddBoardColumn(ctx context.Context, boardID int, requestParams model.BoardColumnInput, userID int) (*models.KanbanBoard, error)
+	UpdateBoardColumn(ctx context.Context, id int, requestParams model.BoardColumnInput, userID int) (*models.KanbanBoard, error)
+	DeleteBoardColumn(ctx context.Context, id int, userID int) (*models.KanbanBoard, error)
+	MoveCard(ctx context.Context, todoID int, columnID int, position int, userID int) (*models.KanbanBoard, error)
+	FetchBoard(ctx context.Context, projectID int, userID int) (*models.KanbanBoard, error)
+}
+
+type boardService struct {
+	db     *sql.DB
+	broker *TodoChangeBroker
+}
+
+func NewBoardService(db *sql.DB, broker *TodoChangeBroker) BoardService {
+	return &boardService{db, broker}
+}
+
+func (bs *boardService) CreateBoard(ctx context.Context, projectID int, userID int) (*models.KanbanBoard, error) {
+	tx, err := bs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	project, err := models.Projects(qm.Where("id = ? AND user_id = ?", projectID, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewNotFoundView(err)
+	}
+	exists, err := models.KanbanBoards(qm.Where("project_id = ?", project.ID)).Exists(ctx, tx)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	if exists {
+		return &models.KanbanBoard{}, view.NewBadRequestView(fmt.Errorf("既にボードが作成されています。"))
+	}
+
+	board := &models.KanbanBoard{ProjectID: project.ID}
+	if err := board.Insert(ctx, tx, boil.Infer()); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	positions := rank.Spread(len(defaultBoardColumns))
+	for i, input := range defaultBoardColumns {
+		column := newBoardColumn(board.ID, input, positions[i])
+		if err := column.Insert(ctx, tx, boil.Infer()); err != nil {
+			return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+		}
+	}
+
+	if err := loadBoard(ctx, tx, board); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	return board, nil
+}
+
+func (bs *boardService) AddBoardColumn(ctx context.Context, boardID int, requestParams model.BoardColumnInput, userID int) (*models.KanbanBoard, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateBoardColumn(requestParams)
+	if validationErrors != nil {
+		return &models.KanbanBoard{}, view.NewBadRequestView(validationErrors)
+	}
+
+	board, err := models.KanbanBoards(qm.Where("id = ?", boardID), ownedBoardMod(userID)).One(ctx, bs.db)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewNotFoundView(err)
+	}
+
+	// NOTE: 末尾の列として追加する
+	last, err := models.BoardColumns(qm.Where("board_id = ?", board.ID), qm.OrderBy("position DESC")).One(ctx, bs.db)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	lastPosition := ""
+	if last != nil {
+		lastPosition = last.Position
+	}
+	position, err := rank.Between(lastPosition, "")
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+
+	column := newBoardColumn(board.ID, requestParams, position)
+	if err := column.Insert(ctx, bs.db, boil.Infer()); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := loadBoard(ctx, bs.db, board); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	return board, nil
+}
+
+func (bs *boardService) UpdateBoardColumn(ctx context.Context, id int, requestParams model.BoardColumnInput, userID int) (*models.KanbanBoard, error) {
+	column, err := models.BoardColumns(qm.Where("id = ?", id), ownedBoardColumnMod(userID)).One(ctx, bs.db)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewNotFoundView(err)
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateBoardColumn(requestParams)
+	if validationErrors != nil {
+		return &models.KanbanBoard{}, view.NewBadRequestView(validationErrors)
+	}
+
+	// NOTE: WIP制限を現在の件数より小さくした場合も、既にある列内のTodoはそのまま残す(以降の移動のみ制限する)
+	updated := newBoardColumn(column.BoardID, requestParams, column.Position)
+	column.Name = updated.Name
+	column.Status = updated.Status
+	column.WipLimit = updated.WipLimit
+	if _, err := column.Update(ctx, bs.db, boil.Infer()); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+
+	return bs.fetchBoardByID(ctx, column.BoardID)
+}
+
+// NOTE: 列を削除しても列内のTodoは削除せず、ステータスに対応する列に表示する
+func (bs *boardService) DeleteBoardColumn(ctx context.Context, id int, userID int) (*models.KanbanBoard, error) {
+	tx, err := bs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	column, err := models.BoardColumns(qm.Where("id = ?", id), ownedBoardColumnMod(userID)).One(ctx, tx)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewNotFoundView(err)
+	}
+	if _, err := models.Todos(qm.WithDeleted(), qm.Where("board_column_id = ?", column.ID)).UpdateAll(ctx, tx, models.M{
+		models.TodoColumns.BoardColumnID: nil,
+		models.TodoColumns.BoardPosition: nil,
+	}); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	if _, err := column.Delete(ctx, tx); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+
+	board, err := models.FindKanbanBoard(ctx, tx, column.BoardID)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	if err := loadBoard(ctx, tx, board); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	return board, nil
+}
+
+// NOTE: Todoを指定した列のposition番目(0始まり)に移動する
+// ステータスに対応する列へ移動した場合はTodoのステータスも変更し、WIP制限を超える移動はできない
+func (bs *boardService) MoveCard(ctx context.Context, todoID int, columnID int, position int, userID int) (*models.KanbanBoard, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := bs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	column, err := models.BoardColumns(qm.Where("id = ?", columnID), ownedBoardColumnMod(userID)).One(ctx, tx)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewNotFoundView(err)
+	}
+	// NOTE: WIP制限の確認と移動が同時に行われないよう、ボード単位で排他制御する
+	board, err := models.KanbanBoards(qm.Where("id = ?", column.BoardID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ? AND archived_at IS NULL", todoID, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewNotFoundView(err)
+	}
+	if !todo.ProjectID.Valid || todo.ProjectID.Int != board.ProjectID {
+		return &models.KanbanBoard{}, view.NewBadRequestView(fmt.Errorf("ボードのプロジェクトに属するTodoではありません。"))
+	}
+
+	if err := loadBoard(ctx, tx, board); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	var current *models.BoardColumn
+	var cards models.TodoSlice
+	for _, c := range board.R.BoardBoardColumns {
+		for _, card := range c.R.Todos {
+			if card.ID == todo.ID {
+				current = c
+			} else if c.ID == column.ID {
+				cards = append(cards, card)
+			}
+		}
+	}
+	if column.WipLimit.Valid && (current == nil || current.ID != column.ID) && len(cards) >= column.WipLimit.Int {
+		return &models.KanbanBoard{}, view.NewBadRequestView(fmt.Errorf("WIP制限(%d件)を超えるため移動できません。", column.WipLimit.Int))
+	}
+
+	// NOTE: 列のステータスに合わせてTodoを完了、または未完了に戻す
+	completed := false
+	if column.Status.Valid && column.Status.String != string(TodoStatusOf(todo)) {
+		switch model.TodoStatus(column.Status.String) {
+		case model.TodoStatusCompleted:
+			blockerIDs, err := openBlockerIDs(ctx, tx, []int{todo.ID})
+			if err != nil {
+				return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+			}
+			if len(blockerIDs[todo.ID]) > 0 {
+				return &models.KanbanBoard{}, view.NewBadRequestView(fmt.Errorf("未完了の先行Todoがあるため完了できません。"))
+			}
+			if _, err := completeTodo(ctx, tx, todo, time.Now()); err != nil {
+				return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+			}
+			completed = true
+		case model.TodoStatusOpen:
+			// NOTE: 繰り返し設定のあるTodoは完了時に次回分を生成済みのため、未完了に戻すと次回分が重複する
+			// 次回分は編集されている可能性もあり削除できないため、未完了に戻す移動はできないものとする
+			if todo.CompletedAt.Valid && todo.RecurrenceRule.Valid {
+				return &models.KanbanBoard{}, view.NewBadRequestView(fmt.Errorf("繰り返し設定のある完了済みTodoは、次回分が生成済みのため未完了に戻せません。"))
+			}
+			todo.CompletedAt = null.Time{}
+		}
+	}
+	// NOTE: 編集中の他の画面からの更新と競合させるため、versionを進める(完了した場合はcompleteTodoで進めている)
+	if !completed {
+		todo.Version++
+	}
+
+	if err := placeCard(ctx, tx, todo, column.ID, cards, position); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := loadBoard(ctx, tx, board); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	if err := tx.Commit(); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(bs.broker, collected)
+	return board, nil
+}
+
+// NOTE: ボードが作成されていない場合はnilを返す
+func (bs *boardService) FetchBoard(ctx context.Context, projectID int, userID int) (*models.KanbanBoard, error) {
+	exists, err := models.Projects(qm.Where("id = ? AND user_id = ?", projectID, userID)).Exists(ctx, bs.db)
+	if err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+	if !exists {
+		return nil, view.NewNotFoundView(fmt.Errorf("プロジェクトが見つかりません。"))
+	}
+
+	board, err := models.KanbanBoards(qm.Where("project_id = ?", projectID)).One(ctx, bs.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+	if err := loadBoard(ctx, bs.db, board); err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+	return board, nil
+}
+
+func (bs *boardService) fetchBoardByID(ctx context.Context, id int) (*models.KanbanBoard, error) {
+	board, err := models.FindKanbanBoard(ctx, bs.db, id)
+	if err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	if err := loadBoard(ctx, bs.db, board); err != nil {
+		return &models.KanbanBoard{}, view.NewInternalServerErrorView(err)
+	}
+	return board, nil
+}
+
+// NOTE: プロジェクト、列、各列のTodoを読み込む(board.R.BoardBoardColumns、各列のR.Todosに設定する)
+// 列が指定されていないTodoは、ステータスが対応する先頭の列に表示する
+func loadBoard(ctx context.Context, exec boil.ContextExecutor, board *models.KanbanBoard) error {
+	project, err := models.FindProject(ctx, exec, board.ProjectID)
+	if err != nil {
+		return err
+	}
+	columns, err := models.BoardColumns(
+		qm.Where("board_id = ?", board.ID),
+		qm.OrderBy("position ASC, id ASC"),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+	todos, err := models.Todos(
+		qm.Where("project_id = ? AND archived_at IS NULL", board.ProjectID),
+		qm.OrderBy("board_position IS NULL, board_position ASC, position ASC, id ASC"),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for _, column := range columns {
+		column.R = column.R.NewStruct()
+		column.R.Todos = models.TodoSlice{}
+	}
+	for _, todo := range todos {
+		if column := boardColumnOf(todo, columns); column != nil {
+			column.R.Todos = append(column.R.Todos, todo)
+		}
+	}
+	board.R = board.R.NewStruct()
+	board.R.Project = project
+	board.R.BoardBoardColumns = columns
+	return nil
+}
+
+// NOTE: Todoを表示する列を返す(表示する列がない場合はnil)
+// 指定された列のステータスがTodoのステータスと異なる場合(他の画面から完了された場合等)は、ステータスに対応する列に表示する
+func boardColumnOf(todo *models.Todo, columns models.BoardColumnSlice) *models.BoardColumn {
+	status := string(TodoStatusOf(todo))
+	if todo.BoardColumnID.Valid {
+		for _, column := range columns {
+			if column.ID == todo.BoardColumnID.Int && (!column.Status.Valid || column.Status.String == status) {
+				return column
+			}
+		}
+	}
+	for _, column := range columns {
+		if column.Status.Valid && column.Status.String == status {
+			return column
+		}
+	}
+	return nil
+}
+
+// NOTE: 列内のTodo(移動するTodoを除く)のposition番目にTodoを配置する
+// 前後のTodoに順位がない場合や、順位が長くなりすぎた場合は列内の順位を振り直す
+func placeCard(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, columnID int, cards models.TodoSlice, position int) error {
+	position = max(0, min(position, len(cards)))
+
+	todo.BoardColumnID = null.IntFrom(columnID)
+	ranked := true
+	for _, card := range cards {
+		if !card.BoardPosition.Valid {
+			ranked = false
+			break
+		}
+	}
+	if ranked {
+		var prev, next string
+		if position > 0 {
+			prev = cards[position-1].BoardPosition.String
+		}
+		if position < len(cards) {
+			next = cards[position].BoardPosition.String
+		}
+		boardPosition, err := rank.Between(prev, next)
+		if err == nil && len(boardPosition) <= maxTodoPositionLength {
+			todo.BoardPosition = null.StringFrom(boardPosition)
+			_, err := todo.Update(ctx, exec, boil.Infer())
+			return err
+		}
+	}
+
+	ordered := append(append(append(models.TodoSlice{}, cards[:position]...), todo), cards[position:]...)
+	for i, boardPosition := range rank.Spread(len(ordered)) {
+		ordered[i].BoardColumnID = null.IntFrom(columnID)
+		ordered[i].BoardPosition = null.StringFrom(boardPosition)
+		columns := boil.Whitelist(models.TodoColumns.BoardColumnID, models.TodoColumns.BoardPosition)
+		if ordered[i] == todo {
+			columns = boil.Infer()
+		}
+		if _, err := ordered[i].Update(ctx, exec, columns); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newBoardColumn(boardID int, input model.BoardColumnInput, position string) *models.BoardColumn {
+	column := &models.BoardColumn{}
+	column.BoardID = boardID
+	column.Name = input.Name
+	if input.Status != nil {
+		column.Status = null.StringFrom(string(*input.Status))
+	}
+	column.WipLimit = null.IntFromPtr(input.WipLimit)
+	column.Position = position
+	return column
+}
+
+func ownedBoardMod(userID int) qm.QueryMod {
+	return qm.Where("project_id IN (SELECT id FROM projects WHERE user_id = ?)", userID)
+}
+
+func ownedBoardColumnMod(userID int) qm.QueryMod {
+	return qm.Where("board_id IN (SELECT kanban_boards.id FROM kanban_boards INNER JOIN projects ON projects.id = kanban_boards.project_id WHERE projects.user_id = ?)", userID)
+}
@@ -0,0 +1,195 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestBoardServiceSuite struct {
+	WithDBSuite
+	broker *TodoChangeBroker
+}
+
+var (
+	testBoardService BoardService
+	testProject      *models.Project
+)
+
+func (s *TestBoardServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザとプロジェクトの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testProject = &models.Project{Name: "project 1", UserID: user.ID}
+	if err := testProject.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test projects %v", err)
+	}
+
+	s.broker = NewTodoChangeBroker()
+	testBoardService = NewBoardService(DBCon, s.broker)
+}
+
+func (s *TestBoardServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestBoardServiceSuite) createProjectTodos(titles ...string) []*models.Todo {
+	todos := []*models.Todo{}
+	for _, title := range titles {
+		todo := &models.Todo{Title: title, UserID: user.ID, ProjectID: null.IntFrom(testProject.ID)}
+		if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+		todos = append(todos, todo)
+	}
+	return todos
+}
+
+func boardColumnTitles(board *models.KanbanBoard) [][]string {
+	titles := [][]string{}
+	for _, column := range board.R.BoardBoardColumns {
+		columnTitles := []string{}
+		for _, todo := range column.R.Todos {
+			columnTitles = append(columnTitles, todo.Title)
+		}
+		titles = append(titles, columnTitles)
+	}
+	return titles
+}
+
+func (s *TestBoardServiceSuite) TestCreateBoard() {
+	s.createProjectTodos("a", "b")
+
+	board, err := testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 列が未指定のTodoは未着手の列に表示されることの確認
+	assert.Equal(s.T(), [][]string{{"a", "b"}, {}, {}}, boardColumnTitles(board))
+
+	_, err = testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestBoardServiceSuite) TestMoveCard() {
+	todos := s.createProjectTodos("a", "b", "c")
+	board, _ := testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+	columns := board.R.BoardBoardColumns
+
+	if _, err := testBoardService.MoveCard(ctx, todos[0].ID, columns[1].ID, 0, user.ID); err != nil {
+		s.T().Fatalf("failed to move test cards %v", err)
+	}
+	board, err := testBoardService.MoveCard(ctx, todos[2].ID, columns[1].ID, 0, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), [][]string{{"b"}, {"c", "a"}, {}}, boardColumnTitles(board))
+}
+
+func (s *TestBoardServiceSuite) TestMoveCard_PublishesTodoChange() {
+	todos := s.createProjectTodos("a")
+	board, _ := testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+	columns := board.R.BoardBoardColumns
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	changes := s.broker.Subscribe(subscriptionCtx)
+
+	if _, err := testBoardService.MoveCard(ctx, todos[0].ID, columns[2].ID, 0, user.ID); err != nil {
+		s.T().Fatalf("failed to move test cards %v", err)
+	}
+
+	// NOTE: ボード上の移動による完了も購読者へ配信されることの確認
+	change := receivePublishedTodoChange(s.T(), changes)
+	assert.Equal(s.T(), model.TodoChangeActionUpdated, change.Event.Action)
+	assert.Equal(s.T(), todos[0].ID, change.Event.Todo.ID)
+	assert.True(s.T(), change.Event.Todo.CompletedAt.Valid)
+}
+
+func (s *TestBoardServiceSuite) TestMoveCard_ChangesStatus() {
+	todos := s.createProjectTodos("a")
+	board, _ := testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+	columns := board.R.BoardBoardColumns
+
+	board, err := testBoardService.MoveCard(ctx, todos[0].ID, columns[2].ID, 0, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), [][]string{{}, {}, {"a"}}, boardColumnTitles(board))
+	assert.True(s.T(), board.R.BoardBoardColumns[2].R.Todos[0].CompletedAt.Valid)
+
+	// NOTE: 未着手の列に戻すと未完了に戻ることの確認
+	board, err = testBoardService.MoveCard(ctx, todos[0].ID, columns[0].ID, 0, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), board.R.BoardBoardColumns[0].R.Todos[0].CompletedAt.Valid)
+}
+
+func (s *TestBoardServiceSuite) TestMoveCard_CompletedRecurringTodo() {
+	todos := s.createProjectTodos("a")
+	todos[0].RecurrenceRule = null.StringFrom("FREQ=DAILY;INTERVAL=1")
+	if _, err := todos[0].Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update test todos %v", err)
+	}
+	board, _ := testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+	columns := board.R.BoardBoardColumns
+	if _, err := testBoardService.MoveCard(ctx, todos[0].ID, columns[2].ID, 0, user.ID); err != nil {
+		s.T().Fatalf("failed to move test cards %v", err)
+	}
+
+	// NOTE: 次回分が生成済みのため、未着手の列に戻せないことの確認
+	_, err := testBoardService.MoveCard(ctx, todos[0].ID, columns[0].ID, 0, user.ID)
+
+	assert.NotNil(s.T(), err)
+	_ = todos[0].Reload(ctx, DBCon)
+	assert.True(s.T(), todos[0].CompletedAt.Valid)
+	count, _ := models.Todos(qm.Where("recurrence_parent_id = ?", todos[0].ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestBoardServiceSuite) TestMoveCard_WipLimit() {
+	todos := s.createProjectTodos("a", "b")
+	board, _ := testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+	limit := 1
+	board, err := testBoardService.AddBoardColumn(ctx, board.ID, model.BoardColumnInput{Name: "レビュー", WipLimit: &limit}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to add test columns %v", err)
+	}
+	review := board.R.BoardBoardColumns[3]
+
+	_, err = testBoardService.MoveCard(ctx, todos[0].ID, review.ID, 0, user.ID)
+	assert.Nil(s.T(), err)
+	// NOTE: 既に列内にあるTodoの並び替えは制限されないことの確認
+	_, err = testBoardService.MoveCard(ctx, todos[0].ID, review.ID, 0, user.ID)
+	assert.Nil(s.T(), err)
+
+	_, err = testBoardService.MoveCard(ctx, todos[1].ID, review.ID, 0, user.ID)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestBoardServiceSuite) TestDeleteBoardColumn() {
+	todos := s.createProjectTodos("a")
+	board, _ := testBoardService.CreateBoard(ctx, testProject.ID, user.ID)
+	columns := board.R.BoardBoardColumns
+	if _, err := testBoardService.MoveCard(ctx, todos[0].ID, columns[1].ID, 0, user.ID); err != nil {
+		s.T().Fatalf("failed to move test cards %v", err)
+	}
+
+	board, err := testBoardService.DeleteBoardColumn(ctx, columns[1].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), [][]string{{"a"}, {}}, boardColumnTitles(board))
+}
+
+func TestBoardService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestBoardServiceSuite))
+}
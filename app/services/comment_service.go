@@ -0,0 +1,249 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: "@ユーザ名" 形式のメンションを抽出する
+var mentionPattern = regexp.MustCompile(`@([\p{L}\p{N}_.\-]+)`)
+
+type CommentService interface {
+	AddComment(ctx context.Context, requestParams model.AddCommentInput, userID int) (*models.TodoComment, error)
+	EditComment(ctx context.Context, id int, requestParams model.EditCommentInput, userID int) (*models.TodoComment, error)
+	DeleteComment(ctx context.Context, id int, userID int) (string, error)
+	FetchComments(ctx context.Context, todoID int, first *int, after *string) (*model.TodoCommentConnection, error)
+}
+
+type commentService struct {
+	db *sql.DB
+}
+
+func NewCommentService(db *sql.DB) CommentService {
+	return &commentService{db}
+}
+
+func (cs *commentService) AddComment(ctx context.Context, requestParams model.AddCommentInput, userID int) (*models.TodoComment, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateAddComment(requestParams)
+	if validationErrors != nil {
+		return &models.TodoComment{}, view.NewBadRequestView(validationErrors)
+	}
+
+	// NOTE: コメント対象のTodoが閲覧可能であることを確認
+	todoID, _ := strconv.Atoi(requestParams.TodoID)
+	todo, err := models.Todos(qm.Where("id = ?", todoID), visibleTodoMod(userID)).One(ctx, cs.db)
+	if err != nil {
+		return &models.TodoComment{}, view.NewNotFoundView(err)
+	}
+
+	comment := &models.TodoComment{}
+	comment.TodoID = todo.ID
+	comment.UserID = userID
+	comment.Content = requestParams.Content
+
+	tx, err := cs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	// NOTE: Create処理
+	if err := comment.Insert(ctx, tx, boil.Infer()); err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+	// NOTE: メンションされたユーザへの通知を作成
+	if err := cs.notifyMentions(ctx, tx, comment, parseMentions(comment.Content)); err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+	if err := insertActivity(ctx, tx, &models.Activity{
+		UserID:        todo.UserID,
+		Verb:          activityVerbCommented,
+		TodoID:        null.IntFrom(todo.ID),
+		ProjectID:     todo.ProjectID,
+		TodoCommentID: null.IntFrom(comment.ID),
+	}); err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+	return comment, nil
+}
+
+func (cs *commentService) EditComment(ctx context.Context, id int, requestParams model.EditCommentInput, userID int) (*models.TodoComment, error) {
+	comment, err := cs.fetchOwnComment(ctx, id, userID)
+	if err != nil {
+		return &models.TodoComment{}, err
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateEditComment(requestParams)
+	if validationErrors != nil {
+		return &models.TodoComment{}, view.NewBadRequestView(validationErrors)
+	}
+
+	// NOTE: 編集で新たに追加されたメンションのみ通知対象とする
+	previousMentions := make(map[string]struct{})
+	for _, name := range parseMentions(comment.Content) {
+		previousMentions[name] = struct{}{}
+	}
+	var newMentions []string
+	for _, name := range parseMentions(requestParams.Content) {
+		if _, ok := previousMentions[name]; !ok {
+			newMentions = append(newMentions, name)
+		}
+	}
+
+	comment.Content = requestParams.Content
+
+	tx, err := cs.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	// NOTE: Update処理
+	if _, err := comment.Update(ctx, tx, boil.Infer()); err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+	if err := cs.notifyMentions(ctx, tx, comment, newMentions); err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.TodoComment{}, view.NewInternalServerErrorView(err)
+	}
+	return comment, nil
+}
+
+func (cs *commentService) DeleteComment(ctx context.Context, id int, userID int) (string, error) {
+	comment, err := cs.fetchOwnComment(ctx, id, userID)
+	if err != nil {
+		return strconv.Itoa(id), err
+	}
+
+	_, deleteError := comment.Delete(ctx, cs.db)
+	if deleteError != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(deleteError)
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (cs *commentService) FetchComments(ctx context.Context, todoID int, first *int, after *string) (*model.TodoCommentConnection, error) {
+	limit := pageSize(first)
+	mods := []qm.QueryMod{qm.Where("todo_id = ?", todoID)}
+
+	totalCount, err := models.TodoComments(mods...).Count(ctx, cs.db)
+	if err != nil {
+		return &model.TodoCommentConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	if after != nil {
+		afterID, err := decodeCursor(*after)
+		if err != nil {
+			return &model.TodoCommentConnection{}, view.NewBadRequestView(err)
+		}
+		mods = append(mods, qm.Where("id > ?", afterID))
+	}
+	// NOTE: 次ページの有無を判定するため1件多く取得する
+	mods = append(mods, qm.OrderBy("id ASC"), qm.Limit(limit+1))
+	comments, err := models.TodoComments(mods...).All(ctx, cs.db)
+	if err != nil {
+		return &model.TodoCommentConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	hasNextPage := len(comments) > limit
+	if hasNextPage {
+		comments = comments[:limit]
+	}
+
+	edges := make([]*model.TodoCommentEdge, 0, len(comments))
+	for _, comment := range comments {
+		edges = append(edges, &model.TodoCommentEdge{Cursor: encodeCursor(comment.ID), Node: comment})
+	}
+	pageInfo := &model.PageInfo{HasNextPage: hasNextPage, HasPreviousPage: after != nil}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.TodoCommentConnection{Edges: edges, PageInfo: pageInfo, TotalCount: int(totalCount)}, nil
+}
+
+// NOTE: 編集・削除はコメントの投稿者本人のみ許可する
+func (cs *commentService) fetchOwnComment(ctx context.Context, id int, userID int) (*models.TodoComment, error) {
+	comment, err := models.FindTodoComment(ctx, cs.db, id)
+	if err != nil {
+		return &models.TodoComment{}, view.NewNotFoundView(err)
+	}
+	if comment.UserID != userID {
+		return &models.TodoComment{}, view.NewForbiddenView(fmt.Errorf("コメントの投稿者のみ操作できます。"))
+	}
+	return comment, nil
+}
+
+// NOTE: メンションされたユーザ(投稿者本人を除く)に通知を作成する
+// 通知にはTodoの内容が含まれるため、Todoを閲覧できるユーザ(所有者と共同作業者)へのメンションのみ通知する
+func (cs *commentService) notifyMentions(ctx context.Context, exec boil.ContextExecutor, comment *models.TodoComment, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		args = append(args, name)
+	}
+	mentionedUsers, err := models.Users(
+		qm.WhereIn("name IN ?", args...),
+		qm.Where("id <> ?", comment.UserID),
+		todoViewerMod(comment.TodoID),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	var notifications models.NotificationSlice
+	for _, mentionedUser := range mentionedUsers {
+		notifications = append(notifications, &models.Notification{
+			UserID:        mentionedUser.ID,
+			ActorID:       null.IntFrom(comment.UserID),
+			EventType:     notificationTypeMentioned,
+			TodoID:        null.IntFrom(comment.TodoID),
+			TodoCommentID: null.IntFrom(comment.ID),
+		})
+	}
+	_, err = createNotifications(ctx, exec, notifications)
+	return err
+}
+
+// NOTE: 本文中のメンションからユーザ名を重複なく抽出する
+func parseMentions(content string) []string {
+	var names []string
+	seen := make(map[string]struct{})
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		name := strings.TrimRight(match[1], ".-")
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names
+}
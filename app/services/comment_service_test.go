@@ -0,0 +1,209 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestCommentServiceSuite struct {
+	WithDBSuite
+}
+
+var (
+	testTodo           *models.Todo
+	testCommentService CommentService
+)
+
+func (s *TestCommentServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザとTodoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo = &models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	testCommentService = NewCommentService(DBCon)
+}
+
+func (s *TestCommentServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestCommentServiceSuite) TestAddComment() {
+	requestParams := model.AddCommentInput{TodoID: strconv.Itoa(testTodo.ID), Content: "test comment 1"}
+
+	comment, err := testCommentService.AddComment(ctx, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "test comment 1", comment.Content)
+	// NOTE: コメントが作成されていることを確認
+	isExistComment, _ := models.TodoComments(
+		qm.Where("todo_id = ? AND content = ?", testTodo.ID, "test comment 1"),
+	).Exists(ctx, DBCon)
+	assert.True(s.T(), isExistComment)
+}
+
+func (s *TestCommentServiceSuite) createCollaborator(name string, email string) *models.User {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Name": name, "Email": email}).(*models.User)
+	if err := collaborator.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	share := &models.TodoShare{TodoID: testTodo.ID, UserID: collaborator.ID}
+	if err := share.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test shares %v", err)
+	}
+	return collaborator
+}
+
+func (s *TestCommentServiceSuite) TestAddComment_Mention() {
+	mentionedUser := s.createCollaborator("hanako", "test_2@example.com")
+	outsider := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Name": "jiro", "Email": "test_3@example.com"}).(*models.User)
+	if err := outsider.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	requestParams := model.AddCommentInput{TodoID: strconv.Itoa(testTodo.ID), Content: "@hanako 確認お願いします。@jiro @unknown"}
+
+	comment, err := testCommentService.AddComment(ctx, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 共同作業者へのメンションのみ通知され、Todoを閲覧できないユーザへのメンションは通知されないことを確認
+	notifications, _ := models.Notifications(qm.Where("todo_comment_id = ?", comment.ID)).All(ctx, DBCon)
+	assert.Len(s.T(), notifications, 1)
+	assert.Equal(s.T(), mentionedUser.ID, notifications[0].UserID)
+	assert.Equal(s.T(), user.ID, notifications[0].ActorID.Int)
+	assert.Equal(s.T(), notificationTypeMentioned, notifications[0].EventType)
+}
+
+func (s *TestCommentServiceSuite) TestAddComment_MentionByCollaborator() {
+	collaborator := s.createCollaborator("hanako", "test_2@example.com")
+	user.Name = "taro"
+	if _, err := user.Update(ctx, DBCon, boil.Whitelist(models.UserColumns.Name)); err != nil {
+		s.T().Fatalf("failed to update test user %v", err)
+	}
+	requestParams := model.AddCommentInput{TodoID: strconv.Itoa(testTodo.ID), Content: "@taro 確認しました。@hanako"}
+
+	comment, err := testCommentService.AddComment(ctx, requestParams, collaborator.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 共同作業者のコメントから所有者へ通知され、投稿者本人には通知されないことを確認
+	notifications, _ := models.Notifications(qm.Where("todo_comment_id = ?", comment.ID)).All(ctx, DBCon)
+	assert.Len(s.T(), notifications, 1)
+	assert.Equal(s.T(), user.ID, notifications[0].UserID)
+	assert.Equal(s.T(), collaborator.ID, notifications[0].ActorID.Int)
+}
+
+func (s *TestCommentServiceSuite) TestAddComment_NotFound() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	requestParams := model.AddCommentInput{TodoID: strconv.Itoa(testTodo.ID), Content: "test comment 1"}
+
+	_, err := testCommentService.AddComment(ctx, requestParams, otherUser.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestCommentServiceSuite) TestEditComment() {
+	testComment := models.TodoComment{TodoID: testTodo.ID, UserID: user.ID, Content: "test comment 1"}
+	if err := testComment.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	requestParams := model.EditCommentInput{Content: "test updated comment 1"}
+	comment, err := testCommentService.EditComment(ctx, testComment.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "test updated comment 1", comment.Content)
+	// NOTE: コメントが更新されていることの確認
+	if err := testComment.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload test comment %v", err)
+	}
+	assert.Equal(s.T(), "test updated comment 1", testComment.Content)
+}
+
+func (s *TestCommentServiceSuite) TestEditComment_Forbidden() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testComment := models.TodoComment{TodoID: testTodo.ID, UserID: user.ID, Content: "test comment 1"}
+	if err := testComment.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	requestParams := model.EditCommentInput{Content: "test updated comment 1"}
+	_, err := testCommentService.EditComment(ctx, testComment.ID, requestParams, otherUser.ID)
+
+	assert.NotNil(s.T(), err)
+	// NOTE: コメントが更新されていないこと
+	if err := testComment.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload test comment %v", err)
+	}
+	assert.Equal(s.T(), "test comment 1", testComment.Content)
+}
+
+func (s *TestCommentServiceSuite) TestDeleteComment() {
+	testComment := models.TodoComment{TodoID: testTodo.ID, UserID: user.ID, Content: "test comment 1"}
+	if err := testComment.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test comment %v", err)
+	}
+
+	id, err := testCommentService.DeleteComment(ctx, testComment.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), strconv.Itoa(testComment.ID), id)
+	// NOTE: コメントが削除されていることの確認
+	reloadErr := testComment.Reload(ctx, DBCon)
+	assert.NotNil(s.T(), reloadErr)
+}
+
+func (s *TestCommentServiceSuite) TestFetchComments() {
+	var commentsSlice models.TodoCommentSlice
+	for i := 1; i <= 3; i++ {
+		commentsSlice = append(commentsSlice, &models.TodoComment{TodoID: testTodo.ID, UserID: user.ID, Content: "test comment " + strconv.Itoa(i)})
+	}
+	if _, err := commentsSlice.InsertAll(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create TestFetchComments Data: %v", err)
+	}
+
+	first := 2
+	connection, err := testCommentService.FetchComments(ctx, testTodo.ID, &first, nil)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, connection.TotalCount)
+	assert.Len(s.T(), connection.Edges, 2)
+	assert.True(s.T(), connection.PageInfo.HasNextPage)
+
+	// NOTE: 次ページの取得
+	nextConnection, err := testCommentService.FetchComments(ctx, testTodo.ID, &first, connection.PageInfo.EndCursor)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), nextConnection.Edges, 1)
+	assert.Equal(s.T(), "test comment 3", nextConnection.Edges[0].Node.Content)
+	assert.False(s.T(), nextConnection.PageInfo.HasNextPage)
+}
+
+func TestParseMentions(t *testing.T) {
+	assert.Equal(t, []string{"taro", "hanako"}, parseMentions("@taro さんと @hanako. 確認お願いします @taro"))
+	assert.Nil(t, parseMentions("メンションなし"))
+}
+
+func TestCommentService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestCommentServiceSuite))
+}
@@ -0,0 +1,325 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	notificationTypeAssigned  = "assigned"
+	notificationTypeMentioned = "mentioned"
+	notificationTypeDueSoon   = "due_soon"
+	notificationTypeShared    = "shared"
+
+	dueSoonNotificationBatchSize = 100
+)
+
+// NOTE: 通知の種類はDBに文字列で保存する
+var notificationEventTypes = map[model.NotificationEvent]string{
+	model.NotificationEventAssigned:  notificationTypeAssigned,
+	model.NotificationEventMentioned: notificationTypeMentioned,
+	model.NotificationEventDueSoon:   notificationTypeDueSoon,
+	model.NotificationEventShared:    notificationTypeShared,
+}
+
+type NotificationService interface {
+	FetchNotifications(ctx context.Context, userID int, unreadOnly bool, first *int, after *string) (*model.NotificationConnection, error)
+	MarkNotificationRead(ctx context.Context, id int, userID int) (*models.Notification, error)
+	MarkAllRead(ctx context.Context, userID int) (int, error)
+	FetchNotificationPayload(ctx context.Context, notification *models.Notification) (model.NotificationPayload, error)
+	FetchNotificationPreferences(ctx context.Context, userID int) ([]*models.NotificationPreference, error)
+	UpdateNotificationPreference(ctx context.Context, event model.NotificationEvent, enabled bool, userID int) (*models.NotificationPreference, error)
+	NotifyDueSoonTodos(ctx context.Context, now time.Time) (int, error)
+}
+
+type notificationService struct {
+	db *sql.DB
+}
+
+func NewNotificationService(db *sql.DB) NotificationService {
+	return &notificationService{db}
+}
+
+// NOTE: 新しい通知から順に返す(ゴミ箱内のTodoの通知は対象外)
+func (ns *notificationService) FetchNotifications(ctx context.Context, userID int, unreadOnly bool, first *int, after *string) (*model.NotificationConnection, error) {
+	limit := pageSize(first)
+	mods := []qm.QueryMod{
+		qm.Where("user_id = ?", userID),
+		qm.Where("(todo_id IS NULL OR todo_id IN (SELECT id FROM todos WHERE deleted_at IS NULL))"),
+	}
+
+	unreadCount, err := models.Notifications(append(mods, qm.Where("read_at IS NULL"))...).Count(ctx, ns.db)
+	if err != nil {
+		return &model.NotificationConnection{}, view.NewInternalServerErrorView(err)
+	}
+	if unreadOnly {
+		mods = append(mods, qm.Where("read_at IS NULL"))
+	}
+	totalCount, err := models.Notifications(mods...).Count(ctx, ns.db)
+	if err != nil {
+		return &model.NotificationConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	if after != nil {
+		afterID, err := decodeCursor(*after)
+		if err != nil {
+			return &model.NotificationConnection{}, view.NewBadRequestView(err)
+		}
+		mods = append(mods, qm.Where("id < ?", afterID))
+	}
+	// NOTE: 次ページの有無を判定するため1件多く取得する
+	mods = append(mods,
+		qm.OrderBy("id DESC"),
+		qm.Limit(limit+1),
+		qm.Load(models.NotificationRels.Actor),
+		qm.Load(models.NotificationRels.Todo),
+		qm.Load(models.NotificationRels.TodoComment),
+	)
+	notifications, err := models.Notifications(mods...).All(ctx, ns.db)
+	if err != nil {
+		return &model.NotificationConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	hasNextPage := len(notifications) > limit
+	if hasNextPage {
+		notifications = notifications[:limit]
+	}
+
+	edges := make([]*model.NotificationEdge, 0, len(notifications))
+	for _, notification := range notifications {
+		edges = append(edges, &model.NotificationEdge{Cursor: encodeCursor(notification.ID), Node: notification})
+	}
+	pageInfo := &model.PageInfo{HasNextPage: hasNextPage, HasPreviousPage: after != nil}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.NotificationConnection{Edges: edges, PageInfo: pageInfo, TotalCount: int(totalCount), UnreadCount: int(unreadCount)}, nil
+}
+
+func (ns *notificationService) MarkNotificationRead(ctx context.Context, id int, userID int) (*models.Notification, error) {
+	notification, err := models.Notifications(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ns.db)
+	if err != nil {
+		return &models.Notification{}, view.NewNotFoundView(err)
+	}
+	// NOTE: 既読の場合は最初に既読にした日時のままとする
+	if notification.ReadAt.Valid {
+		return notification, nil
+	}
+
+	notification.ReadAt = null.TimeFrom(time.Now())
+	if _, err := notification.Update(ctx, ns.db, boil.Whitelist(
+		models.NotificationColumns.ReadAt,
+		models.NotificationColumns.UpdatedAt,
+	)); err != nil {
+		return &models.Notification{}, view.NewInternalServerErrorView(err)
+	}
+	return notification, nil
+}
+
+// NOTE: 未読の通知をすべて既読にし、既読にした件数を返す
+func (ns *notificationService) MarkAllRead(ctx context.Context, userID int) (int, error) {
+	now := time.Now()
+	rowsAff, err := models.Notifications(qm.Where("user_id = ? AND read_at IS NULL", userID)).UpdateAll(ctx, ns.db, models.M{
+		models.NotificationColumns.ReadAt:    now,
+		models.NotificationColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		return 0, view.NewInternalServerErrorView(err)
+	}
+	return int(rowsAff), nil
+}
+
+// NOTE: 通知の種類に応じた内容を返す(一覧の取得時に読み込み済みの関連はそのまま使用する)
+func (ns *notificationService) FetchNotificationPayload(ctx context.Context, notification *models.Notification) (model.NotificationPayload, error) {
+	var todo *models.Todo
+	var comment *models.TodoComment
+	if notification.R != nil {
+		todo, comment = notification.R.Todo, notification.R.TodoComment
+	}
+	if todo == nil {
+		var err error
+		if todo, err = notification.Todo().One(ctx, ns.db); err != nil {
+			return nil, view.NewNotFoundView(err)
+		}
+	}
+
+	switch notification.EventType {
+	case notificationTypeAssigned:
+		return model.AssignedPayload{Todo: todo}, nil
+	case notificationTypeMentioned:
+		if comment == nil {
+			var err error
+			if comment, err = notification.TodoComment().One(ctx, ns.db); err != nil {
+				return nil, view.NewNotFoundView(err)
+			}
+		}
+		return model.MentionedPayload{Todo: todo, Comment: comment}, nil
+	case notificationTypeDueSoon:
+		return model.DueSoonPayload{Todo: todo, DueDate: todo.DueDate.Time.Format(dueDateLayout)}, nil
+	case notificationTypeShared:
+		return model.SharedPayload{Todo: todo}, nil
+	}
+	return nil, view.NewInternalServerErrorView(fmt.Errorf("unknown notification event type: %q", notification.EventType))
+}
+
+// NOTE: 通知の種類ごとの設定を返す(未設定の種類は通知する設定とする)
+func (ns *notificationService) FetchNotificationPreferences(ctx context.Context, userID int) ([]*models.NotificationPreference, error) {
+	saved, err := models.NotificationPreferences(qm.Where("user_id = ?", userID)).All(ctx, ns.db)
+	if err != nil {
+		return models.NotificationPreferenceSlice{}, view.NewInternalServerErrorView(err)
+	}
+	savedByType := map[string]*models.NotificationPreference{}
+	for _, preference := range saved {
+		savedByType[preference.EventType] = preference
+	}
+
+	preferences := make(models.NotificationPreferenceSlice, 0, len(model.AllNotificationEvent))
+	for _, event := range model.AllNotificationEvent {
+		preference, ok := savedByType[notificationEventTypes[event]]
+		if !ok {
+			preference = &models.NotificationPreference{UserID: userID, EventType: notificationEventTypes[event], Enabled: true}
+		}
+		preferences = append(preferences, preference)
+	}
+	return preferences, nil
+}
+
+func (ns *notificationService) UpdateNotificationPreference(ctx context.Context, event model.NotificationEvent, enabled bool, userID int) (*models.NotificationPreference, error) {
+	eventType, ok := notificationEventTypes[event]
+	if !ok {
+		return &models.NotificationPreference{}, view.NewBadRequestView(fmt.Errorf("通知の種類が不正です。"))
+	}
+
+	preference := &models.NotificationPreference{UserID: userID, EventType: eventType, Enabled: enabled}
+	if err := preference.Upsert(ctx, ns.db, boil.Whitelist(
+		models.NotificationPreferenceColumns.Enabled,
+		models.NotificationPreferenceColumns.UpdatedAt,
+	), boil.Infer()); err != nil {
+		return &models.NotificationPreference{}, view.NewInternalServerErrorView(err)
+	}
+	return preference, nil
+}
+
+// NOTE: 期日が今日または明日の未完了のTodoについて、所有者に期日が近いことを通知し、通知件数を返す
+// 期日の前日以降に通知済みのTodoは対象外とする(期日が変更された場合は改めて通知する)
+// 通知しない設定のユーザのTodoは通知が作成されず毎回対象となり、他のTodoの通知を妨げるため、取得時に除外する
+// 複数のサーバで同時に実行されても二重に通知しないよう、対象行をSKIP LOCKEDで排他的に取得する
+func (ns *notificationService) NotifyDueSoonTodos(ctx context.Context, now time.Time) (int, error) {
+	tx, err := ns.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	today := startOfDay(now)
+	todos, err := models.Todos(
+		qm.Where("due_date BETWEEN ? AND ?", today, today.AddDate(0, 0, 1)),
+		qm.Where("completed_at IS NULL AND archived_at IS NULL"),
+		qm.Where(`NOT EXISTS (
+			SELECT 1 FROM notifications
+			WHERE notifications.todo_id = todos.id AND notifications.event_type = ?
+				AND notifications.created_at >= DATE_SUB(todos.due_date, INTERVAL 1 DAY)
+		)`, notificationTypeDueSoon),
+		qm.Where(`NOT EXISTS (
+			SELECT 1 FROM notification_preferences
+			WHERE notification_preferences.user_id = todos.user_id AND notification_preferences.event_type = ?
+				AND notification_preferences.enabled = FALSE
+		)`, notificationTypeDueSoon),
+		qm.OrderBy("due_date ASC, id ASC"),
+		qm.Limit(dueSoonNotificationBatchSize),
+		qm.For("UPDATE OF todos SKIP LOCKED"),
+	).All(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+
+	notifications := models.NotificationSlice{}
+	for _, todo := range todos {
+		notifications = append(notifications, &models.Notification{
+			UserID:    todo.UserID,
+			EventType: notificationTypeDueSoon,
+			TodoID:    null.IntFrom(todo.ID),
+		})
+	}
+	created, err := createNotifications(ctx, tx, notifications)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return created, nil
+}
+
+// NOTE: Todoの共有や割り当てなど、他のユーザの操作を受信者に通知する
+func notifyTodoEvent(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, eventType string, recipientID int, actorID int) error {
+	_, err := createNotifications(ctx, exec, models.NotificationSlice{{
+		UserID:    recipientID,
+		ActorID:   null.IntFrom(actorID),
+		EventType: eventType,
+		TodoID:    null.IntFrom(todo.ID),
+	}})
+	return err
+}
+
+// NOTE: 受信者が通知しない設定にしている種類の通知を除外して作成し、作成件数を返す
+func createNotifications(ctx context.Context, exec boil.ContextExecutor, notifications models.NotificationSlice) (int, error) {
+	if len(notifications) == 0 {
+		return 0, nil
+	}
+
+	userIDs := make([]interface{}, 0, len(notifications))
+	for _, notification := range notifications {
+		userIDs = append(userIDs, notification.UserID)
+	}
+	disabledPreferences, err := models.NotificationPreferences(
+		qm.WhereIn("user_id IN ?", userIDs...),
+		qm.Where("enabled = ?", false),
+	).All(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+	disabled := map[int]map[string]bool{}
+	for _, preference := range disabledPreferences {
+		if disabled[preference.UserID] == nil {
+			disabled[preference.UserID] = map[string]bool{}
+		}
+		disabled[preference.UserID][preference.EventType] = true
+	}
+
+	enabled := models.NotificationSlice{}
+	for _, notification := range notifications {
+		if !disabled[notification.UserID][notification.EventType] {
+			enabled = append(enabled, notification)
+		}
+	}
+	if len(enabled) == 0 {
+		return 0, nil
+	}
+	if _, err := enabled.InsertAll(ctx, exec, boil.Infer()); err != nil {
+		return 0, err
+	}
+	return len(enabled), nil
+}
+
+// NOTE: DBに保存された通知の種類をGraphQLの型へ変換する
+func NotificationEventFromType(eventType string) model.NotificationEvent {
+	for event, t := range notificationEventTypes {
+		if t == eventType {
+			return event
+		}
+	}
+	return ""
+}
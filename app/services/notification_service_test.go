@@ -0,0 +1,178 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestNotificationServiceSuite struct {
+	WithDBSuite
+}
+
+var testNotificationService NotificationService
+
+func (s *TestNotificationServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Name": "taro", "Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testNotificationService = NewNotificationService(DBCon)
+	testTodoService = newTestTodoService(s.T())
+}
+
+func (s *TestNotificationServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestNotificationServiceSuite) createTodo(title string, dueDate *string) *models.Todo {
+	todo, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: title, Content: "", DueDate: dueDate}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	return todo
+}
+
+func (s *TestNotificationServiceSuite) createNotifications(todo *models.Todo, count int) models.NotificationSlice {
+	notifications := models.NotificationSlice{}
+	for i := 0; i < count; i++ {
+		notification := &models.Notification{UserID: user.ID, EventType: notificationTypeAssigned, TodoID: null.IntFrom(todo.ID)}
+		if err := notification.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create test notifications %v", err)
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications
+}
+
+func (s *TestNotificationServiceSuite) TestFetchNotifications() {
+	notifications := s.createNotifications(s.createTodo("a", nil), 3)
+	first := 2
+
+	connection, err := testNotificationService.FetchNotifications(ctx, user.ID, false, &first, nil)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, connection.TotalCount)
+	assert.Equal(s.T(), 3, connection.UnreadCount)
+	assert.True(s.T(), connection.PageInfo.HasNextPage)
+	// NOTE: 新しい通知から順に返すことの確認
+	assert.Equal(s.T(), notifications[2].ID, connection.Edges[0].Node.ID)
+
+	connection, _ = testNotificationService.FetchNotifications(ctx, user.ID, false, &first, connection.PageInfo.EndCursor)
+	assert.Len(s.T(), connection.Edges, 1)
+	assert.Equal(s.T(), notifications[0].ID, connection.Edges[0].Node.ID)
+}
+
+func (s *TestNotificationServiceSuite) TestMarkNotificationRead() {
+	notifications := s.createNotifications(s.createTodo("a", nil), 2)
+
+	notification, err := testNotificationService.MarkNotificationRead(ctx, notifications[0].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), notification.ReadAt.Valid)
+	connection, _ := testNotificationService.FetchNotifications(ctx, user.ID, true, nil, nil)
+	assert.Len(s.T(), connection.Edges, 1)
+	assert.Equal(s.T(), notifications[1].ID, connection.Edges[0].Node.ID)
+	assert.Equal(s.T(), 1, connection.UnreadCount)
+}
+
+func (s *TestNotificationServiceSuite) TestMarkAllRead() {
+	s.createNotifications(s.createTodo("a", nil), 3)
+
+	marked, err := testNotificationService.MarkAllRead(ctx, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 3, marked)
+	connection, _ := testNotificationService.FetchNotifications(ctx, user.ID, false, nil, nil)
+	assert.Equal(s.T(), 3, connection.TotalCount)
+	assert.Equal(s.T(), 0, connection.UnreadCount)
+}
+
+func (s *TestNotificationServiceSuite) TestUpdateNotificationPreference() {
+	preference, err := testNotificationService.UpdateNotificationPreference(ctx, model.NotificationEventMentioned, false, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), preference.Enabled)
+	preferences, _ := testNotificationService.FetchNotificationPreferences(ctx, user.ID)
+	assert.Len(s.T(), preferences, len(model.AllNotificationEvent))
+	for _, p := range preferences {
+		assert.Equal(s.T(), p.EventType != notificationTypeMentioned, p.Enabled, p.EventType)
+	}
+
+	// NOTE: 通知しない設定の種類の通知は作成されないことの確認
+	todo := s.createTodo("a", nil)
+	created, err := createNotifications(ctx, DBCon, models.NotificationSlice{
+		{UserID: user.ID, EventType: notificationTypeMentioned, TodoID: null.IntFrom(todo.ID)},
+	})
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, created)
+	exists, _ := models.Notifications(qm.Where("user_id = ?", user.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), exists)
+}
+
+func (s *TestNotificationServiceSuite) TestNotifyDueSoonTodos() {
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(dueDateLayout)
+	nextWeek := time.Now().AddDate(0, 0, 7).Format(dueDateLayout)
+	todo := s.createTodo("明日が期日", &tomorrow)
+	s.createTodo("来週が期日", &nextWeek)
+
+	created, err := testNotificationService.NotifyDueSoonTodos(ctx, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, created)
+	connection, _ := testNotificationService.FetchNotifications(ctx, user.ID, false, nil, nil)
+	payload, err := testNotificationService.FetchNotificationPayload(ctx, connection.Edges[0].Node)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.DueSoonPayload{Todo: connection.Edges[0].Node.R.Todo, DueDate: tomorrow}, payload)
+	assert.Equal(s.T(), todo.ID, payload.(model.DueSoonPayload).Todo.ID)
+
+	// NOTE: 通知済みのTodoは再度通知しないことの確認
+	created, _ = testNotificationService.NotifyDueSoonTodos(ctx, time.Now())
+	assert.Equal(s.T(), 0, created)
+}
+
+func (s *TestNotificationServiceSuite) TestNotifyDueSoonTodos_DisabledPreference() {
+	optedOutUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := optedOutUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	if _, err := testNotificationService.UpdateNotificationPreference(ctx, model.NotificationEventDueSoon, false, optedOutUser.ID); err != nil {
+		s.T().Fatalf("failed to update test preferences %v", err)
+	}
+	// NOTE: 通知しない設定のユーザのTodoを、1回で通知する件数より多く、期日の早い順で先に並ぶように作成する
+	today := startOfDay(time.Now())
+	for i := 0; i <= dueSoonNotificationBatchSize; i++ {
+		todo := &models.Todo{Title: "今日が期日", UserID: optedOutUser.ID, DueDate: null.TimeFrom(today)}
+		if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+	}
+	tomorrow := time.Now().AddDate(0, 0, 1).Format(dueDateLayout)
+	todo := s.createTodo("明日が期日", &tomorrow)
+
+	created, err := testNotificationService.NotifyDueSoonTodos(ctx, time.Now())
+
+	// NOTE: 通知しない設定のユーザのTodoに妨げられず、他のユーザに通知されることの確認
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, created)
+	notifications, _ := models.Notifications(qm.Where("event_type = ?", notificationTypeDueSoon)).All(ctx, DBCon)
+	assert.Len(s.T(), notifications, 1)
+	assert.Equal(s.T(), todo.ID, notifications[0].TodoID.Int)
+}
+
+func TestNotificationService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestNotificationServiceSuite))
+}
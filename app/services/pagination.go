@@ -0,0 +1,72 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+	cursorPrefix    = "cursor:"
+	keysetPrefix    = "keyset:"
+)
+
+// NOTE: キーセットページネーション用のカーソル(並び替えの項目と値、同値の場合の並びを決めるID)
+type keysetCursor struct {
+	Field string `json:"f"`
+	Key   string `json:"k"`
+	ID    int    `json:"id"`
+}
+
+// NOTE: 1ページあたりの取得件数を決定する(未指定時はデフォルト値、上限はmaxPageSize)
+func pageSize(first *int) int {
+	if first == nil || *first <= 0 {
+		return defaultPageSize
+	}
+	if *first > maxPageSize {
+		return maxPageSize
+	}
+	return *first
+}
+
+// NOTE: IDをクライアントに返すカーソル文字列へ変換する
+func encodeCursor(id int) string {
+	return base64.StdEncoding.EncodeToString([]byte(cursorPrefix + strconv.Itoa(id)))
+}
+
+// NOTE: カーソル文字列からIDを取り出す
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("不正なカーソルです。")
+	}
+	id, err := strconv.Atoi(strings.TrimPrefix(string(decoded), cursorPrefix))
+	if err != nil || !strings.HasPrefix(string(decoded), cursorPrefix) {
+		return 0, fmt.Errorf("不正なカーソルです。")
+	}
+	return id, nil
+}
+
+// NOTE: 並び替えの値とIDをクライアントに返すカーソル文字列へ変換する
+func encodeKeysetCursor(cursor keysetCursor) string {
+	encoded, _ := json.Marshal(cursor)
+	return base64.StdEncoding.EncodeToString(append([]byte(keysetPrefix), encoded...))
+}
+
+// NOTE: カーソル文字列から並び替えの値とIDを取り出す(並び替えの項目が異なるカーソルは不正とする)
+func decodeKeysetCursor(cursor string, field string) (keysetCursor, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), keysetPrefix) {
+		return keysetCursor{}, fmt.Errorf("不正なカーソルです。")
+	}
+
+	result := keysetCursor{}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(string(decoded), keysetPrefix)), &result); err != nil || result.Field != field {
+		return keysetCursor{}, fmt.Errorf("不正なカーソルです。")
+	}
+	return result, nil
+}
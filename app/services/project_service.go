@@ -0,0 +1,101 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"strconv"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ProjectService interface {
+	CreateProject(ctx context.Context, requestParams model.CreateProjectInput, userID int) (*models.Project, error)
+	UpdateProject(ctx context.Context, id int, requestParams model.UpdateProjectInput, userID int) (*models.Project, error)
+	DeleteProject(ctx context.Context, id int, userID int) (string, error)
+	FetchProjects(ctx context.Context, userID int) ([]*models.Project, error)
+	FetchProject(ctx context.Context, id int, userID int) (*models.Project, error)
+}
+
+type projectService struct {
+	db *sql.DB
+}
+
+func NewProjectService(db *sql.DB) ProjectService {
+	return &projectService{db}
+}
+
+func (ps *projectService) CreateProject(ctx context.Context, requestParams model.CreateProjectInput, userID int) (*models.Project, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateProject(requestParams)
+	if validationErrors != nil {
+		return &models.Project{}, view.NewBadRequestView(validationErrors)
+	}
+
+	project := &models.Project{}
+	project.Name = requestParams.Name
+	project.UserID = userID
+
+	// NOTE: Create処理
+	if err := project.Insert(ctx, ps.db, boil.Infer()); err != nil {
+		return &models.Project{}, view.NewInternalServerErrorView(err)
+	}
+	return project, nil
+}
+
+func (ps *projectService) UpdateProject(ctx context.Context, id int, requestParams model.UpdateProjectInput, userID int) (*models.Project, error) {
+	project, err := models.Projects(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ps.db)
+	if err != nil {
+		return &models.Project{}, view.NewNotFoundView(err)
+	}
+
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateUpdateProject(requestParams)
+	if validationErrors != nil {
+		return &models.Project{}, view.NewBadRequestView(validationErrors)
+	}
+
+	project.Name = requestParams.Name
+
+	// NOTE: Update処理
+	if _, err := project.Update(ctx, ps.db, boil.Infer()); err != nil {
+		return &models.Project{}, view.NewInternalServerErrorView(err)
+	}
+	return project, nil
+}
+
+// NOTE: プロジェクトを削除しても所属していたTodoは残す(プロジェクト未設定になる)
+func (ps *projectService) DeleteProject(ctx context.Context, id int, userID int) (string, error) {
+	project, err := models.Projects(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ps.db)
+	if err != nil {
+		return strconv.Itoa(id), view.NewNotFoundView(err)
+	}
+
+	if _, err := project.Delete(ctx, ps.db); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (ps *projectService) FetchProjects(ctx context.Context, userID int) ([]*models.Project, error) {
+	projects, err := models.Projects(
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("name ASC, id ASC"),
+	).All(ctx, ps.db)
+	if err != nil {
+		return models.ProjectSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return projects, nil
+}
+
+func (ps *projectService) FetchProject(ctx context.Context, id int, userID int) (*models.Project, error) {
+	project, err := models.Projects(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ps.db)
+	if err != nil {
+		return &models.Project{}, view.NewNotFoundView(err)
+	}
+	return project, nil
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestProjectServiceSuite struct {
+	WithDBSuite
+}
+
+var testProjectService ProjectService
+
+func (s *TestProjectServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testProjectService = NewProjectService(DBCon)
+}
+
+func (s *TestProjectServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestProjectServiceSuite) TestCreateProject() {
+	project, err := testProjectService.CreateProject(ctx, model.CreateProjectInput{Name: "project 1"}, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "project 1", project.Name)
+	projects, _ := testProjectService.FetchProjects(ctx, user.ID)
+	assert.Len(s.T(), projects, 1)
+}
+
+func (s *TestProjectServiceSuite) TestCreateProject_ValidationError() {
+	_, err := testProjectService.CreateProject(ctx, model.CreateProjectInput{Name: ""}, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestProjectServiceSuite) TestUpdateProject() {
+	project, _ := testProjectService.CreateProject(ctx, model.CreateProjectInput{Name: "project 1"}, user.ID)
+
+	updated, err := testProjectService.UpdateProject(ctx, project.ID, model.UpdateProjectInput{Name: "project 2"}, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "project 2", updated.Name)
+}
+
+func (s *TestProjectServiceSuite) TestUpdateProject_NotFound() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	project, _ := testProjectService.CreateProject(ctx, model.CreateProjectInput{Name: "project 1"}, otherUser.ID)
+
+	_, err := testProjectService.UpdateProject(ctx, project.ID, model.UpdateProjectInput{Name: "project 2"}, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestProjectServiceSuite) TestDeleteProject() {
+	project, _ := testProjectService.CreateProject(ctx, model.CreateProjectInput{Name: "project 1"}, user.ID)
+	todo := models.Todo{Title: "test title 1", UserID: user.ID, ProjectID: null.IntFrom(project.ID)}
+	if err := todo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	_, err := testProjectService.DeleteProject(ctx, project.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 所属していたTodoは削除されず、プロジェクト未設定になることの確認
+	if err := todo.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload test todos %v", err)
+	}
+	assert.False(s.T(), todo.ProjectID.Valid)
+}
+
+func TestProjectService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestProjectServiceSuite))
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/recurrence"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	dueDateLayout          = "2006-01-02"
+	defaultOccurrenceCount = 5
+	maxOccurrenceCount     = 50
+)
+
+var weekdaysByModel = map[model.Weekday]time.Weekday{
+	model.WeekdayMonday:    time.Monday,
+	model.WeekdayTuesday:   time.Tuesday,
+	model.WeekdayWednesday: time.Wednesday,
+	model.WeekdayThursday:  time.Thursday,
+	model.WeekdayFriday:    time.Friday,
+	model.WeekdaySaturday:  time.Saturday,
+	model.WeekdaySunday:    time.Sunday,
+}
+
+// NOTE: GraphQLの入力値からDBに保存する繰り返しルールを生成する(未指定時は繰り返しなし)
+func recurrenceRuleFromInput(input *model.RecurrenceInput) null.String {
+	if input == nil {
+		return null.String{}
+	}
+
+	rule := recurrence.Rule{Frequency: recurrence.Frequency(input.Frequency), Interval: 1}
+	if input.Interval != nil {
+		rule.Interval = *input.Interval
+	}
+	if input.MonthDay != nil {
+		rule.MonthDay = *input.MonthDay
+	}
+	for _, weekday := range input.Weekdays {
+		rule.Weekdays = append(rule.Weekdays, weekdaysByModel[weekday])
+	}
+	return null.String{String: rule.String(), Valid: true}
+}
+
+// NOTE: DBに保存された繰り返しルールをGraphQLの型へ変換する
+func RecurrenceFromRule(src null.String) (*model.Recurrence, error) {
+	if !src.Valid || src.String == "" {
+		return nil, nil
+	}
+
+	rule, err := recurrence.Parse(src.String)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.Recurrence{
+		Frequency: model.RecurrenceFrequency(rule.Frequency),
+		Interval:  rule.Interval,
+		Weekdays:  []model.Weekday{},
+		Rule:      rule.String(),
+	}
+	if rule.MonthDay > 0 {
+		result.MonthDay = &rule.MonthDay
+	}
+	for _, weekday := range rule.Weekdays {
+		for modelWeekday, timeWeekday := range weekdaysByModel {
+			if timeWeekday == weekday {
+				result.Weekdays = append(result.Weekdays, modelWeekday)
+			}
+		}
+	}
+	return result, nil
+}
+
+// NOTE: 期日の入力値(YYYY-MM-DD)を変換する(バリデーション済みの前提)
+func dueDateFromInput(input *string) null.Time {
+	if input == nil || *input == "" {
+		return null.Time{}
+	}
+
+	dueDate, err := time.ParseInLocation(dueDateLayout, *input, time.Local)
+	if err != nil {
+		return null.Time{}
+	}
+	return null.Time{Time: dueDate, Valid: true}
+}
+
+// NOTE: プレビューする発生日の件数を決定する(未指定時はデフォルト値、上限はmaxOccurrenceCount)
+func occurrenceCount(count *int) int {
+	if count == nil || *count <= 0 {
+		return defaultOccurrenceCount
+	}
+	if *count > maxOccurrenceCount {
+		return maxOccurrenceCount
+	}
+	return *count
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/notifier"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	dateTimeLayout = "2006-01-02 15:04:05"
+
+	reminderBatchSize   = 20
+	reminderMaxAttempts = 5
+	// NOTE: 1回の実行でバッチ全件の全配信先への送信がタイムアウトしても、他のサーバが再送しない長さにする
+	reminderLease = 15 * time.Minute
+)
+
+type ReminderService interface {
+	AddReminder(ctx context.Context, requestParams model.AddReminderInput, userID int) (*models.TodoReminder, error)
+	RemoveReminder(ctx context.Context, id int, userID int) (string, error)
+	FetchReminders(ctx context.Context, todoID int) ([]*models.TodoReminder, error)
+	DispatchDueReminders(ctx context.Context, now time.Time) (int, error)
+}
+
+type reminderService struct {
+	db       *sql.DB
+	channels []notifier.Channel
+}
+
+func NewReminderService(db *sql.DB, channels []notifier.Channel) ReminderService {
+	return &reminderService{db, channels}
+}
+
+func (rs *reminderService) AddReminder(ctx context.Context, requestParams model.AddReminderInput, userID int) (*models.TodoReminder, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateAddReminder(requestParams)
+	if validationErrors != nil {
+		return &models.TodoReminder{}, view.NewBadRequestView(validationErrors)
+	}
+
+	todoID, _ := strconv.Atoi(requestParams.TodoID)
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, rs.db)
+	if err != nil {
+		return &models.TodoReminder{}, view.NewNotFoundView(err)
+	}
+
+	reminder := newTodoReminder(todo, requestParams.RemindAt)
+	if err := reminder.Insert(ctx, rs.db, boil.Infer()); err != nil {
+		return &models.TodoReminder{}, view.NewInternalServerErrorView(err)
+	}
+	return reminder, nil
+}
+
+func (rs *reminderService) RemoveReminder(ctx context.Context, id int, userID int) (string, error) {
+	reminder, err := models.TodoReminders(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, rs.db)
+	if err != nil {
+		return strconv.Itoa(id), view.NewNotFoundView(err)
+	}
+
+	if _, err := reminder.Delete(ctx, rs.db); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (rs *reminderService) FetchReminders(ctx context.Context, todoID int) ([]*models.TodoReminder, error) {
+	reminders, err := models.TodoReminders(
+		qm.Where("todo_id = ?", todoID),
+		qm.OrderBy("remind_at ASC, id ASC"),
+	).All(ctx, rs.db)
+	if err != nil {
+		return models.TodoReminderSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return reminders, nil
+}
+
+// NOTE: 配信時刻を過ぎた未送信のリマインダーを通知し、配信件数を返す
+// 送信中に行ロックを保持しないよう、対象行を確保してからトランザクションの外で送信し、結果は1件ずつ記録する
+// 一部の配信先のみ失敗した場合は、次回の実行で失敗した配信先にのみ再送する(上限回数に達したものは対象外)
+func (rs *reminderService) DispatchDueReminders(ctx context.Context, now time.Time) (int, error) {
+	reminders, err := claimDueReminders(ctx, rs.db, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, reminder := range reminders {
+		delivered := map[string]bool{}
+		for _, delivery := range reminder.R.TodoReminderDeliveries {
+			delivered[delivery.Channel] = true
+		}
+
+		message := reminderMessage(reminder)
+		var errs []error
+		for _, channel := range rs.channels {
+			if delivered[channel.Name] {
+				continue
+			}
+			if err := channel.Notifier.Notify(ctx, message); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			delivery := &models.TodoReminderDelivery{TodoReminderID: reminder.ID, Channel: channel.Name, SentAt: now}
+			if err := delivery.Insert(ctx, rs.db, boil.Infer()); err != nil {
+				return sent, err
+			}
+		}
+
+		if len(errs) > 0 {
+			reminder.Attempts++
+			reminder.LastError = null.String{String: errors.Join(errs...).Error(), Valid: true}
+		} else {
+			reminder.SentAt = null.Time{Time: now, Valid: true}
+			sent++
+		}
+		reminder.LeaseExpiresAt = null.Time{}
+		if _, err := reminder.Update(ctx, rs.db, boil.Whitelist(
+			models.TodoReminderColumns.SentAt,
+			models.TodoReminderColumns.Attempts,
+			models.TodoReminderColumns.LastError,
+			models.TodoReminderColumns.LeaseExpiresAt,
+			models.TodoReminderColumns.UpdatedAt,
+		)); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// NOTE: 配信時刻を過ぎた未送信のリマインダーを取得し、猶予期間の間は他のサーバが送信しないよう確保する
+// 複数のサーバで同時に実行されても二重送信されないよう、対象行をSKIP LOCKEDで排他的に取得する
+// 送信結果を記録する前にプロセスが停止した場合も、猶予期間が過ぎれば再送される
+func claimDueReminders(ctx context.Context, db *sql.DB, now time.Time) (models.TodoReminderSlice, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	reminders, err := models.TodoReminders(
+		qm.Where("sent_at IS NULL AND remind_at <= ? AND attempts < ?", now, reminderMaxAttempts),
+		qm.Where("(lease_expires_at IS NULL OR lease_expires_at <= ?)", now),
+		// NOTE: 完了済み、アーカイブ済み、またはゴミ箱内のTodoのリマインダーは送信しない
+		qm.Where("todo_id IN (SELECT id FROM todos WHERE completed_at IS NULL AND archived_at IS NULL AND deleted_at IS NULL)"),
+		qm.OrderBy("remind_at ASC, id ASC"),
+		qm.Limit(reminderBatchSize),
+		qm.For("UPDATE OF todo_reminders SKIP LOCKED"),
+		qm.Load(models.TodoReminderRels.Todo),
+		qm.Load(models.TodoReminderRels.User),
+		qm.Load(models.TodoReminderRels.TodoReminderDeliveries),
+	).All(ctx, tx)
+	if err != nil || len(reminders) == 0 {
+		return nil, err
+	}
+
+	if _, err := reminders.UpdateAll(ctx, tx, models.M{
+		models.TodoReminderColumns.LeaseExpiresAt: now.Add(reminderLease),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+// NOTE: remindAtはバリデーション済みの前提
+func newTodoReminder(todo *models.Todo, remindAt string) *models.TodoReminder {
+	reminder := &models.TodoReminder{}
+	reminder.TodoID = todo.ID
+	reminder.UserID = todo.UserID
+	reminder.RemindAt, _ = time.ParseInLocation(dateTimeLayout, remindAt, time.Local)
+	return reminder
+}
+
+func reminderMessage(reminder *models.TodoReminder) notifier.Message {
+	todo := reminder.R.Todo
+	recipient := reminder.R.User
+
+	body := fmt.Sprintf("「%s」のリマインダーです。", todo.Title)
+	if todo.DueDate.Valid {
+		body += fmt.Sprintf("\n期日: %s", todo.DueDate.Time.Format(dueDateLayout))
+	}
+
+	return notifier.Message{
+		Recipient: notifier.Recipient{UserID: recipient.ID, Name: recipient.Name, Email: recipient.Email},
+		Subject:   "リマインダー: " + todo.Title,
+		Body:      body,
+		Metadata: map[string]string{
+			"event":      "todo.reminder",
+			"todoId":     strconv.Itoa(todo.ID),
+			"reminderId": strconv.Itoa(reminder.ID),
+			"remindAt":   reminder.RemindAt.Format(dateTimeLayout),
+		},
+	}
+}
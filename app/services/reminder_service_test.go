@@ -0,0 +1,218 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/notifier"
+	models "app/models/generated"
+	"app/test/factories"
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestReminderServiceSuite struct {
+	WithDBSuite
+}
+
+// NOTE: 配信されたメッセージを記録するテスト用のNotifier
+type recordingNotifier struct {
+	messages []notifier.Message
+	err      error
+}
+
+func (rn *recordingNotifier) Notify(ctx context.Context, message notifier.Message) error {
+	if rn.err != nil {
+		return rn.err
+	}
+	rn.messages = append(rn.messages, message)
+	return nil
+}
+
+var (
+	testReminderService ReminderService
+	testNotifier        *recordingNotifier
+)
+
+func (s *TestReminderServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザとTodoの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	testTodo = &models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	testNotifier = &recordingNotifier{}
+	testReminderService = NewReminderService(DBCon, []notifier.Channel{{Name: "test", Notifier: testNotifier}})
+}
+
+func (s *TestReminderServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestReminderServiceSuite) createReminder(remindAt time.Time) *models.TodoReminder {
+	reminder := &models.TodoReminder{TodoID: testTodo.ID, UserID: user.ID, RemindAt: remindAt}
+	if err := reminder.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test reminder %v", err)
+	}
+	return reminder
+}
+
+func (s *TestReminderServiceSuite) TestAddReminder() {
+	remindAt := time.Now().Add(time.Hour).Format("2006-01-02 15:04:05")
+	requestParams := model.AddReminderInput{TodoID: strconv.Itoa(testTodo.ID), RemindAt: remindAt}
+
+	reminder, err := testReminderService.AddReminder(ctx, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), testTodo.ID, reminder.TodoID)
+	assert.Equal(s.T(), remindAt, reminder.RemindAt.Format("2006-01-02 15:04:05"))
+}
+
+func (s *TestReminderServiceSuite) TestAddReminder_PastDateTime() {
+	remindAt := time.Now().Add(-time.Hour).Format("2006-01-02 15:04:05")
+	requestParams := model.AddReminderInput{TodoID: strconv.Itoa(testTodo.ID), RemindAt: remindAt}
+
+	_, err := testReminderService.AddReminder(ctx, requestParams, user.ID)
+
+	assert.NotNil(s.T(), err)
+	count, _ := models.TodoReminders().Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(0), count)
+}
+
+func (s *TestReminderServiceSuite) TestRemoveReminder() {
+	reminder := s.createReminder(time.Now().Add(time.Hour))
+
+	id, err := testReminderService.RemoveReminder(ctx, reminder.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), strconv.Itoa(reminder.ID), id)
+	// NOTE: リマインダーが削除されていることの確認
+	reloadErr := reminder.Reload(ctx, DBCon)
+	assert.NotNil(s.T(), reloadErr)
+}
+
+func (s *TestReminderServiceSuite) TestFetchReminders() {
+	s.createReminder(time.Now().Add(2 * time.Hour))
+	s.createReminder(time.Now().Add(time.Hour))
+
+	reminders, err := testReminderService.FetchReminders(ctx, testTodo.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), reminders, 2)
+	assert.True(s.T(), reminders[0].RemindAt.Before(reminders[1].RemindAt))
+}
+
+func (s *TestReminderServiceSuite) TestDispatchDueReminders() {
+	dueReminder := s.createReminder(time.Now().Add(-time.Minute))
+	futureReminder := s.createReminder(time.Now().Add(time.Hour))
+
+	sent, err := testReminderService.DispatchDueReminders(ctx, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, sent)
+	assert.Len(s.T(), testNotifier.messages, 1)
+	assert.Equal(s.T(), user.Email, testNotifier.messages[0].Recipient.Email)
+	// NOTE: 配信時刻を過ぎたリマインダーのみ送信済みになっていることの確認
+	dueReminder.Reload(ctx, DBCon)
+	futureReminder.Reload(ctx, DBCon)
+	assert.True(s.T(), dueReminder.SentAt.Valid)
+	assert.False(s.T(), futureReminder.SentAt.Valid)
+
+	// NOTE: 送信済みのリマインダーは再送されないことの確認
+	sent, err = testReminderService.DispatchDueReminders(ctx, time.Now())
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, sent)
+	assert.Len(s.T(), testNotifier.messages, 1)
+}
+
+func (s *TestReminderServiceSuite) TestDispatchDueReminders_CompletedTodo() {
+	testTodo.CompletedAt = null.Time{Time: time.Now(), Valid: true}
+	if _, err := testTodo.Update(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to update test todos %v", err)
+	}
+	s.createReminder(time.Now().Add(-time.Minute))
+
+	sent, err := testReminderService.DispatchDueReminders(ctx, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, sent)
+	assert.Len(s.T(), testNotifier.messages, 0)
+}
+
+func (s *TestReminderServiceSuite) TestDispatchDueReminders_NotifierError() {
+	reminder := s.createReminder(time.Now().Add(-time.Minute))
+	testNotifier.err = errors.New("failed to notify")
+
+	sent, err := testReminderService.DispatchDueReminders(ctx, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, sent)
+	// NOTE: 失敗回数とエラー内容が記録され、未送信のままであることの確認
+	reminder.Reload(ctx, DBCon)
+	assert.False(s.T(), reminder.SentAt.Valid)
+	assert.Equal(s.T(), 1, reminder.Attempts)
+	assert.Equal(s.T(), null.String{String: "failed to notify", Valid: true}, reminder.LastError)
+}
+
+func (s *TestReminderServiceSuite) TestDispatchDueReminders_PartialFailure() {
+	reminder := s.createReminder(time.Now().Add(-time.Minute))
+	failingNotifier := &recordingNotifier{err: errors.New("failed to notify")}
+	reminderService := NewReminderService(DBCon, []notifier.Channel{
+		{Name: "test", Notifier: testNotifier},
+		{Name: "failing", Notifier: failingNotifier},
+	})
+
+	sent, err := reminderService.DispatchDueReminders(ctx, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, sent)
+	reminder.Reload(ctx, DBCon)
+	assert.False(s.T(), reminder.SentAt.Valid)
+	assert.Equal(s.T(), 1, reminder.Attempts)
+
+	// NOTE: 再送時は失敗した配信先にのみ送信し、配信済みの配信先には再送しないことの確認
+	failingNotifier.err = nil
+	sent, err = reminderService.DispatchDueReminders(ctx, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, sent)
+	assert.Len(s.T(), testNotifier.messages, 1)
+	assert.Len(s.T(), failingNotifier.messages, 1)
+	reminder.Reload(ctx, DBCon)
+	assert.True(s.T(), reminder.SentAt.Valid)
+}
+
+func (s *TestReminderServiceSuite) TestDispatchDueReminders_Claimed() {
+	s.createReminder(time.Now().Add(-time.Minute))
+	now := time.Now()
+	if _, err := claimDueReminders(ctx, DBCon, now); err != nil {
+		s.T().Fatalf("failed to claim test reminders %v", err)
+	}
+
+	// NOTE: 他のサーバが確保したリマインダーは、猶予期間が過ぎるまで送信しないことの確認
+	sent, err := testReminderService.DispatchDueReminders(ctx, now)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, sent)
+	assert.Len(s.T(), testNotifier.messages, 0)
+
+	sent, _ = testReminderService.DispatchDueReminders(ctx, now.Add(reminderLease))
+	assert.Equal(s.T(), 1, sent)
+}
+
+func TestReminderService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestReminderServiceSuite))
+}
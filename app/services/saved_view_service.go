@@ -0,0 +1,272 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// NOTE: 保存形式のバージョン(形式を変更した場合は上げ、古い形式は読み込み時に変換する)
+// 1: GraphQLの入力型をそのまま保存(プロジェクトIDは文字列)
+// 2: サービスで定義した形式で保存(プロジェクトIDは数値)
+const savedViewDefinitionVersion = 2
+
+// NOTE: 保存する絞り込み条件と並び順
+// GraphQLのスキーマ変更が保存済みのビューに影響しないよう、入力型とは別に定義して相互に変換する
+type savedViewDefinition struct {
+	Version int             `json:"version"`
+	Filter  savedViewFilter `json:"filter"`
+	OrderBy *savedViewOrder `json:"orderBy,omitempty"`
+}
+
+type savedViewFilter struct {
+	TitleContains   *string         `json:"titleContains,omitempty"`
+	ContentContains *string         `json:"contentContains,omitempty"`
+	Statuses        []string        `json:"statuses,omitempty"`
+	Priorities      []string        `json:"priorities,omitempty"`
+	CreatedAt       *savedViewRange `json:"createdAt,omitempty"`
+	UpdatedAt       *savedViewRange `json:"updatedAt,omitempty"`
+	DueDate         *savedViewRange `json:"dueDate,omitempty"`
+	Tags            []string        `json:"tags,omitempty"`
+	ProjectID       *int            `json:"projectId,omitempty"`
+	HasAttachments  *bool           `json:"hasAttachments,omitempty"`
+}
+
+type savedViewRange struct {
+	From *string `json:"from,omitempty"`
+	To   *string `json:"to,omitempty"`
+}
+
+type savedViewOrder struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+}
+
+// NOTE: バージョン1の形式(プロジェクトID以外はバージョン2と同じ)
+type savedViewDefinitionV1 struct {
+	Filter struct {
+		savedViewFilter
+		ProjectID *string `json:"projectId,omitempty"`
+	} `json:"filter"`
+	OrderBy *savedViewOrder `json:"orderBy,omitempty"`
+}
+
+type SavedViewService interface {
+	CreateSavedView(ctx context.Context, requestParams model.CreateSavedViewInput, userID int) (*models.SavedView, error)
+	DeleteSavedView(ctx context.Context, id int, userID int) (string, error)
+	FetchSavedViews(ctx context.Context, userID int) ([]*models.SavedView, error)
+	FetchSavedView(ctx context.Context, id int, userID int) (*models.SavedView, error)
+	LoadSavedView(ctx context.Context, id int, userID int) (*model.TodoFilter, *model.TodoOrder, error)
+}
+
+type savedViewService struct {
+	db *sql.DB
+}
+
+func NewSavedViewService(db *sql.DB) SavedViewService {
+	return &savedViewService{db}
+}
+
+func (ss *savedViewService) CreateSavedView(ctx context.Context, requestParams model.CreateSavedViewInput, userID int) (*models.SavedView, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateSavedView(requestParams)
+	if validationErrors != nil {
+		return &models.SavedView{}, view.NewBadRequestView(validationErrors)
+	}
+	if requestParams.Filter == nil {
+		requestParams.Filter = &model.TodoFilter{}
+	}
+	projectID, err := todoProjectID(ctx, ss.db, requestParams.Filter.ProjectID, userID)
+	if err != nil {
+		return &models.SavedView{}, err
+	}
+	exists, err := models.SavedViews(qm.Where("user_id = ? AND name = ?", userID, requestParams.Name)).Exists(ctx, ss.db)
+	if err != nil {
+		return &models.SavedView{}, view.NewInternalServerErrorView(err)
+	}
+	if exists {
+		return &models.SavedView{}, view.NewBadRequestView(fmt.Errorf("同じ名前のビューが既に存在します。"))
+	}
+
+	definition, err := json.Marshal(newSavedViewDefinition(*requestParams.Filter, projectID, requestParams.OrderBy))
+	if err != nil {
+		return &models.SavedView{}, view.NewInternalServerErrorView(err)
+	}
+
+	savedView := &models.SavedView{}
+	savedView.UserID = userID
+	savedView.Name = requestParams.Name
+	savedView.Definition = types.JSON(definition)
+
+	// NOTE: Create処理
+	if err := savedView.Insert(ctx, ss.db, boil.Infer()); err != nil {
+		return &models.SavedView{}, view.NewInternalServerErrorView(err)
+	}
+	return savedView, nil
+}
+
+func (ss *savedViewService) DeleteSavedView(ctx context.Context, id int, userID int) (string, error) {
+	savedView, err := models.SavedViews(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ss.db)
+	if err != nil {
+		return strconv.Itoa(id), view.NewNotFoundView(err)
+	}
+
+	if _, err := savedView.Delete(ctx, ss.db); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (ss *savedViewService) FetchSavedViews(ctx context.Context, userID int) ([]*models.SavedView, error) {
+	savedViews, err := models.SavedViews(
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("name ASC, id ASC"),
+	).All(ctx, ss.db)
+	if err != nil {
+		return models.SavedViewSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return savedViews, nil
+}
+
+func (ss *savedViewService) FetchSavedView(ctx context.Context, id int, userID int) (*models.SavedView, error) {
+	savedView, err := models.SavedViews(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ss.db)
+	if err != nil {
+		return &models.SavedView{}, view.NewNotFoundView(err)
+	}
+	return savedView, nil
+}
+
+// NOTE: 保存された絞り込み条件と並び順を、Todoの取得に使用できる状態で読み込む
+// 保存後に入力ルールが変わっている可能性があるため、読み込み時にも再度バリデーションする
+func (ss *savedViewService) LoadSavedView(ctx context.Context, id int, userID int) (*model.TodoFilter, *model.TodoOrder, error) {
+	savedView, err := ss.FetchSavedView(ctx, id, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter, orderBy, err := DecodeSavedView(savedView)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if validationErrors := validator.ValidateTodoFilter(*filter); validationErrors != nil {
+		return nil, nil, view.NewBadRequestView(validationErrors)
+	}
+	if orderBy != nil {
+		if validationErrors := validator.ValidateTodoOrder(*orderBy); validationErrors != nil {
+			return nil, nil, view.NewBadRequestView(validationErrors)
+		}
+	}
+	if _, err := todoProjectID(ctx, ss.db, filter.ProjectID, userID); err != nil {
+		return nil, nil, err
+	}
+	return filter, orderBy, nil
+}
+
+// DecodeSavedView 保存された絞り込み条件と並び順を取り出す(古い形式の場合は現在の形式へ変換する)
+func DecodeSavedView(savedView *models.SavedView) (*model.TodoFilter, *model.TodoOrder, error) {
+	definition, err := decodeSavedViewDefinition(savedView.Definition)
+	if err != nil {
+		return nil, nil, view.NewInternalServerErrorView(err)
+	}
+	filter, orderBy := definition.todoFilter()
+	return filter, orderBy, nil
+}
+
+func decodeSavedViewDefinition(data types.JSON) (*savedViewDefinition, error) {
+	header := struct {
+		Version int `json:"version"`
+	}{}
+	if err := data.Unmarshal(&header); err != nil {
+		return nil, err
+	}
+
+	switch header.Version {
+	case 1:
+		legacy := savedViewDefinitionV1{}
+		if err := data.Unmarshal(&legacy); err != nil {
+			return nil, err
+		}
+		definition := &savedViewDefinition{Version: savedViewDefinitionVersion, Filter: legacy.Filter.savedViewFilter, OrderBy: legacy.OrderBy}
+		if legacy.Filter.ProjectID != nil {
+			projectID, err := strconv.Atoi(*legacy.Filter.ProjectID)
+			if err != nil {
+				return nil, err
+			}
+			definition.Filter.ProjectID = &projectID
+		}
+		return definition, nil
+	case savedViewDefinitionVersion:
+		definition := &savedViewDefinition{}
+		if err := data.Unmarshal(definition); err != nil {
+			return nil, err
+		}
+		return definition, nil
+	default:
+		return nil, fmt.Errorf("unsupported saved view version: %d", header.Version)
+	}
+}
+
+func newSavedViewDefinition(filter model.TodoFilter, projectID null.Int, orderBy *model.TodoOrder) savedViewDefinition {
+	definition := savedViewDefinition{
+		Version: savedViewDefinitionVersion,
+		Filter: savedViewFilter{
+			TitleContains:   filter.TitleContains,
+			ContentContains: filter.ContentContains,
+			CreatedAt:       (*savedViewRange)(filter.CreatedAt),
+			UpdatedAt:       (*savedViewRange)(filter.UpdatedAt),
+			DueDate:         (*savedViewRange)(filter.DueDate),
+			Tags:            filter.Tags,
+			ProjectID:       projectID.Ptr(),
+			HasAttachments:  filter.HasAttachments,
+		},
+	}
+	for _, status := range filter.Statuses {
+		definition.Filter.Statuses = append(definition.Filter.Statuses, string(status))
+	}
+	for _, priority := range filter.Priorities {
+		definition.Filter.Priorities = append(definition.Filter.Priorities, string(priority))
+	}
+	if orderBy != nil {
+		definition.OrderBy = &savedViewOrder{Field: string(orderBy.Field), Direction: string(orderBy.Direction)}
+	}
+	return definition
+}
+
+func (definition *savedViewDefinition) todoFilter() (*model.TodoFilter, *model.TodoOrder) {
+	filter := &model.TodoFilter{
+		TitleContains:   definition.Filter.TitleContains,
+		ContentContains: definition.Filter.ContentContains,
+		CreatedAt:       (*model.DateTimeRange)(definition.Filter.CreatedAt),
+		UpdatedAt:       (*model.DateTimeRange)(definition.Filter.UpdatedAt),
+		DueDate:         (*model.DateRange)(definition.Filter.DueDate),
+		Tags:            definition.Filter.Tags,
+		HasAttachments:  definition.Filter.HasAttachments,
+	}
+	for _, status := range definition.Filter.Statuses {
+		filter.Statuses = append(filter.Statuses, model.TodoStatus(status))
+	}
+	for _, priority := range definition.Filter.Priorities {
+		filter.Priorities = append(filter.Priorities, model.TodoPriority(priority))
+	}
+	if definition.Filter.ProjectID != nil {
+		projectID := strconv.Itoa(*definition.Filter.ProjectID)
+		filter.ProjectID = &projectID
+	}
+
+	var orderBy *model.TodoOrder
+	if definition.OrderBy != nil {
+		orderBy = &model.TodoOrder{Field: model.TodoOrderField(definition.OrderBy.Field), Direction: model.OrderDirection(definition.OrderBy.Direction)}
+	}
+	return filter, orderBy
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type TestSavedViewServiceSuite struct {
+	WithDBSuite
+}
+
+var testSavedViewService SavedViewService
+
+func (s *TestSavedViewServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testSavedViewService = NewSavedViewService(DBCon)
+}
+
+func (s *TestSavedViewServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestSavedViewServiceSuite) TestCreateSavedView() {
+	from, to := "2024-11-25", "2024-12-01"
+	requestParams := model.CreateSavedViewInput{
+		Name: "今週期日の優先度高",
+		Filter: &model.TodoFilter{
+			Priorities: []model.TodoPriority{model.TodoPriorityHigh},
+			DueDate:    &model.DateRange{From: &from, To: &to},
+		},
+		OrderBy: &model.TodoOrder{Field: model.TodoOrderFieldDueDate, Direction: model.OrderDirectionAsc},
+	}
+
+	savedView, err := testSavedViewService.CreateSavedView(ctx, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	filter, orderBy, err := testSavedViewService.LoadSavedView(ctx, savedView.ID, user.ID)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), requestParams.Filter, filter)
+	assert.Equal(s.T(), requestParams.OrderBy, orderBy)
+}
+
+func (s *TestSavedViewServiceSuite) TestCreateSavedView_ValidationError() {
+	from, to := "2024-12-01", "2024-11-25"
+	requestParams := model.CreateSavedViewInput{
+		Name:   "",
+		Filter: &model.TodoFilter{DueDate: &model.DateRange{From: &from, To: &to}},
+	}
+
+	_, err := testSavedViewService.CreateSavedView(ctx, requestParams, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestSavedViewServiceSuite) TestCreateSavedView_DuplicateName() {
+	requestParams := model.CreateSavedViewInput{Name: "view", Filter: &model.TodoFilter{}}
+	if _, err := testSavedViewService.CreateSavedView(ctx, requestParams, user.ID); err != nil {
+		s.T().Fatalf("failed to create test saved views %v", err)
+	}
+
+	_, err := testSavedViewService.CreateSavedView(ctx, requestParams, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestSavedViewServiceSuite) TestLoadSavedView_InvalidDefinition() {
+	// NOTE: 保存後に不正となった条件や、未対応の形式は読み込めないことの確認
+	definitions := []string{
+		`{"version": 1, "filter": {"statuses": ["UNKNOWN"]}}`,
+		`{"version": 1, "filter": {}, "orderBy": {"field": "UNKNOWN", "direction": "ASC"}}`,
+		`{"version": 99, "filter": {}}`,
+	}
+	for i, definition := range definitions {
+		savedView := models.SavedView{UserID: user.ID, Name: string(rune('a' + i)), Definition: types.JSON(definition)}
+		if err := savedView.Insert(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to create test saved views %v", err)
+		}
+
+		_, _, err := testSavedViewService.LoadSavedView(ctx, savedView.ID, user.ID)
+
+		assert.NotNil(s.T(), err, definition)
+	}
+}
+
+func (s *TestSavedViewServiceSuite) TestLoadSavedView_Upgrade() {
+	project := models.Project{Name: "project 1", UserID: user.ID}
+	if err := project.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test projects %v", err)
+	}
+	projectID := strconv.Itoa(project.ID)
+	// NOTE: バージョン1の形式(プロジェクトIDが文字列)で保存されたビューも読み込めることの確認
+	definition := fmt.Sprintf(`{"version": 1, "filter": {"statuses": ["OPEN"], "projectId": "%s"}, "orderBy": {"field": "DUE_DATE", "direction": "ASC"}}`, projectID)
+	savedView := models.SavedView{UserID: user.ID, Name: "legacy", Definition: types.JSON(definition)}
+	if err := savedView.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test saved views %v", err)
+	}
+
+	filter, orderBy, err := testSavedViewService.LoadSavedView(ctx, savedView.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), &model.TodoFilter{Statuses: []model.TodoStatus{model.TodoStatusOpen}, ProjectID: &projectID}, filter)
+	assert.Equal(s.T(), &model.TodoOrder{Field: model.TodoOrderFieldDueDate, Direction: model.OrderDirectionAsc}, orderBy)
+
+	// NOTE: 新たに保存するビューは現在の形式(プロジェクトIDが数値)で保存されることの確認
+	created, _ := testSavedViewService.CreateSavedView(ctx, model.CreateSavedViewInput{Name: "view", Filter: filter, OrderBy: orderBy}, user.ID)
+	stored := savedViewDefinition{}
+	_ = created.Definition.Unmarshal(&stored)
+	assert.Equal(s.T(), savedViewDefinitionVersion, stored.Version)
+	assert.Equal(s.T(), &project.ID, stored.Filter.ProjectID)
+}
+
+func (s *TestSavedViewServiceSuite) TestDeleteSavedView() {
+	savedView, _ := testSavedViewService.CreateSavedView(ctx, model.CreateSavedViewInput{Name: "view", Filter: &model.TodoFilter{}}, user.ID)
+
+	_, err := testSavedViewService.DeleteSavedView(ctx, savedView.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	savedViews, _ := testSavedViewService.FetchSavedViews(ctx, user.ID)
+	assert.Len(s.T(), savedViews, 0)
+}
+
+func TestSavedViewService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestSavedViewServiceSuite))
+}
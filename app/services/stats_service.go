@@ -0,0 +1,173 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/view"
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const (
+	defaultStatsDays = 7
+	maxStatsDays     = 90
+)
+
+type StatsService interface {
+	FetchTodoStats(ctx context.Context, userID int, days *int) (*model.TodoStats, error)
+}
+
+type statsService struct {
+	db *sql.DB
+}
+
+func NewStatsService(db *sql.DB) StatsService {
+	return &statsService{db}
+}
+
+type todoSummaryRow struct {
+	OpenCount                int      `boil:"open_count"`
+	CompletedCount           int      `boil:"completed_count"`
+	ArchivedCount            int      `boil:"archived_count"`
+	OverdueCount             int      `boil:"overdue_count"`
+	AverageCompletionSeconds null.Int `boil:"average_completion_seconds"`
+}
+
+type dailyCompletionRow struct {
+	Date  string `boil:"date"`
+	Count int    `boil:"count"`
+}
+
+type completionStreakRow struct {
+	Length  int       `boil:"length"`
+	LastDay time.Time `boil:"last_day"`
+}
+
+// NOTE: ダッシュボード用の集計を返す(ゴミ箱内のTodoは対象外)
+// 集計はDB側で行い、Go側では集計結果の整形のみ行う
+func (ss *statsService) FetchTodoStats(ctx context.Context, userID int, days *int) (*model.TodoStats, error) {
+	today := startOfDay(time.Now())
+
+	summary, err := ss.fetchTodoSummary(ctx, userID, today)
+	if err != nil {
+		return &model.TodoStats{}, view.NewInternalServerErrorView(err)
+	}
+	completedPerDay, err := ss.fetchCompletedPerDay(ctx, userID, today, statsDays(days))
+	if err != nil {
+		return &model.TodoStats{}, view.NewInternalServerErrorView(err)
+	}
+	currentStreak, longestStreak, err := ss.fetchCompletionStreaks(ctx, userID, today)
+	if err != nil {
+		return &model.TodoStats{}, view.NewInternalServerErrorView(err)
+	}
+
+	return &model.TodoStats{
+		StatusCounts: []*model.TodoStatusCount{
+			{Status: model.TodoStatusOpen, Count: summary.OpenCount},
+			{Status: model.TodoStatusCompleted, Count: summary.CompletedCount},
+			{Status: model.TodoStatusArchived, Count: summary.ArchivedCount},
+		},
+		OverdueCount:             summary.OverdueCount,
+		CompletedPerDay:          completedPerDay,
+		AverageCompletionSeconds: summary.AverageCompletionSeconds.Ptr(),
+		CurrentStreak:            currentStreak,
+		LongestStreak:            longestStreak,
+	}, nil
+}
+
+// NOTE: ステータスごとの件数、期日切れの件数、作成から完了までの平均時間(秒)を1回の集計で取得する
+func (ss *statsService) fetchTodoSummary(ctx context.Context, userID int, today time.Time) (*todoSummaryRow, error) {
+	summary := &todoSummaryRow{}
+	err := queries.Raw(
+		"SELECT"+
+			" COALESCE(SUM("+todoStatusConditions[model.TodoStatusOpen]+"), 0) AS open_count,"+
+			" COALESCE(SUM("+todoStatusConditions[model.TodoStatusCompleted]+"), 0) AS completed_count,"+
+			" COALESCE(SUM("+todoStatusConditions[model.TodoStatusArchived]+"), 0) AS archived_count,"+
+			" COALESCE(SUM("+todoStatusConditions[model.TodoStatusOpen]+" AND due_date < ?), 0) AS overdue_count,"+
+			" CAST(AVG(TIMESTAMPDIFF(SECOND, created_at, completed_at)) AS SIGNED) AS average_completion_seconds"+
+			" FROM todos"+
+			" WHERE user_id = ? AND deleted_at IS NULL",
+		today, userID,
+	).Bind(ctx, ss.db, summary)
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// NOTE: 直近days日間(今日を含む)の日ごとの完了件数を返す(完了のない日は0件とする)
+func (ss *statsService) fetchCompletedPerDay(ctx context.Context, userID int, today time.Time, days int) ([]*model.DailyCompletionCount, error) {
+	since := today.AddDate(0, 0, -(days - 1))
+	rows := []*dailyCompletionRow{}
+	err := queries.Raw(`
+		SELECT DATE_FORMAT(completed_at, '%Y-%m-%d') AS date, COUNT(*) AS count
+		FROM todos
+		WHERE user_id = ? AND deleted_at IS NULL AND completed_at >= ?
+		GROUP BY date`,
+		userID, since,
+	).Bind(ctx, ss.db, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, row := range rows {
+		counts[row.Date] = row.Count
+	}
+	completedPerDay := make([]*model.DailyCompletionCount, 0, days)
+	for day := since; !day.After(today); day = day.AddDate(0, 0, 1) {
+		date := day.Format(dueDateLayout)
+		completedPerDay = append(completedPerDay, &model.DailyCompletionCount{Date: date, Count: counts[date]})
+	}
+	return completedPerDay, nil
+}
+
+// NOTE: 1件以上完了した日が連続する日数(現在と最長)を返す
+// 完了日から連番の日数を引いた値が同じ日は連続しているため、その値でグループ化して連続日数を求める
+// 今日まだ完了していない場合も、昨日まで連続していれば現在の連続日数として扱う
+func (ss *statsService) fetchCompletionStreaks(ctx context.Context, userID int, today time.Time) (int, int, error) {
+	rows := []*completionStreakRow{}
+	err := queries.Raw(`
+		SELECT COUNT(*) AS length, MAX(day) AS last_day
+		FROM (
+			SELECT day, ROW_NUMBER() OVER (ORDER BY day) AS day_number
+			FROM (
+				SELECT DISTINCT DATE(completed_at) AS day
+				FROM todos
+				WHERE user_id = ? AND deleted_at IS NULL AND completed_at IS NOT NULL
+			) AS completed_days
+		) AS numbered_days
+		GROUP BY DATE_SUB(day, INTERVAL day_number DAY)`,
+		userID,
+	).Bind(ctx, ss.db, &rows)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	current, longest := 0, 0
+	yesterday := today.AddDate(0, 0, -1)
+	for _, row := range rows {
+		if row.Length > longest {
+			longest = row.Length
+		}
+		lastDay := row.LastDay.Format(dueDateLayout)
+		if lastDay == today.Format(dueDateLayout) || lastDay == yesterday.Format(dueDateLayout) {
+			current = row.Length
+		}
+	}
+	return current, longest, nil
+}
+
+// NOTE: 日ごとの完了件数を集計する日数を決定する(未指定時はデフォルト値、上限はmaxStatsDays)
+func statsDays(days *int) int {
+	if days == nil || *days <= 0 {
+		return defaultStatsDays
+	}
+	if *days > maxStatsDays {
+		return maxStatsDays
+	}
+	return *days
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestStatsServiceSuite struct {
+	WithDBSuite
+}
+
+var testStatsService StatsService
+
+func (s *TestStatsServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testStatsService = NewStatsService(DBCon)
+	testTodoService = newTestTodoService(s.T())
+}
+
+func (s *TestStatsServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestStatsServiceSuite) createTodo(title string, columns models.M) *models.Todo {
+	todo, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: title, Content: ""}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	if len(columns) > 0 {
+		if _, err := models.Todos(qm.Where("id = ?", todo.ID)).UpdateAll(ctx, DBCon, columns); err != nil {
+			s.T().Fatalf("failed to update test todos %v", err)
+		}
+	}
+	return todo
+}
+
+func (s *TestStatsServiceSuite) TestFetchTodoStats() {
+	now := time.Now()
+	today := startOfDay(now)
+	s.createTodo("今日完了", models.M{"completed_at": now})
+	s.createTodo("昨日完了", models.M{"created_at": today.AddDate(0, 0, -3), "completed_at": today.AddDate(0, 0, -1)})
+	s.createTodo("5日前に完了", models.M{"created_at": today.AddDate(0, 0, -6), "completed_at": today.AddDate(0, 0, -5), "archived_at": now})
+	s.createTodo("期日切れ", models.M{"due_date": today.AddDate(0, 0, -1)})
+	s.createTodo("未着手", nil)
+
+	stats, err := testStatsService.FetchTodoStats(ctx, user.ID, nil)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []*model.TodoStatusCount{
+		{Status: model.TodoStatusOpen, Count: 2},
+		{Status: model.TodoStatusCompleted, Count: 2},
+		{Status: model.TodoStatusArchived, Count: 1},
+	}, stats.StatusCounts)
+	assert.Equal(s.T(), 1, stats.OverdueCount)
+	assert.NotNil(s.T(), stats.AverageCompletionSeconds)
+	assert.Equal(s.T(), 2, stats.CurrentStreak)
+	assert.Equal(s.T(), 2, stats.LongestStreak)
+
+	// NOTE: 日ごとの完了件数は完了のない日も含めて日付順に返すことの確認
+	assert.Len(s.T(), stats.CompletedPerDay, 7)
+	assert.Equal(s.T(), today.Format(dueDateLayout), stats.CompletedPerDay[6].Date)
+	assert.Equal(s.T(), 1, stats.CompletedPerDay[6].Count)
+	assert.Equal(s.T(), 1, stats.CompletedPerDay[5].Count)
+	assert.Equal(s.T(), 0, stats.CompletedPerDay[4].Count)
+	assert.Equal(s.T(), 1, stats.CompletedPerDay[1].Count)
+}
+
+func (s *TestStatsServiceSuite) TestFetchTodoStats_Empty() {
+	days := 1000
+
+	stats, err := testStatsService.FetchTodoStats(ctx, user.ID, &days)
+
+	assert.Nil(s.T(), err)
+	assert.Nil(s.T(), stats.AverageCompletionSeconds)
+	assert.Equal(s.T(), 0, stats.CurrentStreak)
+	assert.Len(s.T(), stats.CompletedPerDay, maxStatsDays)
+}
+
+func TestStatsService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestStatsServiceSuite))
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 計測中のタイマーは開始からの経過時間を作業時間とする
+const timeEntrySecondsExpr = "COALESCE(time_entries.duration_seconds, TIMESTAMPDIFF(SECOND, time_entries.started_at, ?))"
+
+// NOTE: 集計の単位ごとの、グループ化の式と表示名の式(ユーザの入力値をSQLに含めないよう、許可した式のみ使用する)
+var timeReportGroups = map[model.TimeReportGroupBy]struct {
+	key     string
+	label   string
+	orderBy string
+}{
+	model.TimeReportGroupByTodo:    {"CAST(time_entries.todo_id AS CHAR)", "MAX(todos.title)", "total_seconds DESC, `key` ASC"},
+	model.TimeReportGroupByProject: {"COALESCE(CAST(todos.project_id AS CHAR), '')", "COALESCE(MAX(projects.name), '')", "total_seconds DESC, `key` ASC"},
+	model.TimeReportGroupByDay:     {"DATE_FORMAT(time_entries.started_at, '%Y-%m-%d')", "DATE_FORMAT(MAX(time_entries.started_at), '%Y-%m-%d')", "`key` ASC"},
+}
+
+type TimeEntryService interface {
+	StartTimer(ctx context.Context, todoID int, userID int) (*models.TimeEntry, error)
+	StopTimer(ctx context.Context, userID int) (*models.TimeEntry, error)
+	LogTime(ctx context.Context, todoID int, duration int, note *string, userID int) (*models.TimeEntry, error)
+	FetchRunningTimer(ctx context.Context, userID int) (*models.TimeEntry, error)
+	FetchTimeSpent(ctx context.Context, todoID int) (int, error)
+	FetchTimeReport(ctx context.Context, from string, to string, groupBy model.TimeReportGroupBy, userID int) (*model.TimeReport, error)
+}
+
+type timeEntryService struct {
+	db *sql.DB
+}
+
+func NewTimeEntryService(db *sql.DB) TimeEntryService {
+	return &timeEntryService{db}
+}
+
+// NOTE: タイマーはユーザごとに同時に1つまで計測できる
+func (tes *timeEntryService) StartTimer(ctx context.Context, todoID int, userID int) (*models.TimeEntry, error) {
+	tx, err := tes.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	// NOTE: 同時に開始された場合に複数のタイマーが計測中にならないよう、ユーザ単位で排他制御する
+	if _, err := models.Users(qm.Where("id = ?", userID), qm.For("UPDATE")).One(ctx, tx); err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, tx)
+	if err != nil {
+		return &models.TimeEntry{}, view.NewNotFoundView(err)
+	}
+	running, err := models.TimeEntries(qm.Where("user_id = ? AND ended_at IS NULL", userID)).Exists(ctx, tx)
+	if err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+	if running {
+		return &models.TimeEntry{}, view.NewBadRequestView(fmt.Errorf("計測中のタイマーがあります。"))
+	}
+
+	entry := &models.TimeEntry{UserID: userID, TodoID: todo.ID, StartedAt: time.Now()}
+	if err := entry.Insert(ctx, tx, boil.Infer()); err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+	return entry, nil
+}
+
+func (tes *timeEntryService) StopTimer(ctx context.Context, userID int) (*models.TimeEntry, error) {
+	tx, err := tes.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	entry, err := models.TimeEntries(qm.Where("user_id = ? AND ended_at IS NULL", userID), qm.For("UPDATE")).One(ctx, tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &models.TimeEntry{}, view.NewNotFoundView(fmt.Errorf("計測中のタイマーはありません。"))
+	}
+	if err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+
+	endedAt := time.Now()
+	entry.EndedAt = null.TimeFrom(endedAt)
+	entry.DurationSeconds = null.IntFrom(TimeEntryDuration(entry, endedAt))
+	if _, err := entry.Update(ctx, tx, boil.Infer()); err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+	return entry, nil
+}
+
+// NOTE: タイマーを使わずに作業時間(秒)を記録する(記録した時点で終了した作業として扱う)
+func (tes *timeEntryService) LogTime(ctx context.Context, todoID int, duration int, note *string, userID int) (*models.TimeEntry, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateLogTime(duration, note)
+	if validationErrors != nil {
+		return &models.TimeEntry{}, view.NewBadRequestView(validationErrors)
+	}
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, tes.db)
+	if err != nil {
+		return &models.TimeEntry{}, view.NewNotFoundView(err)
+	}
+
+	endedAt := time.Now()
+	entry := &models.TimeEntry{
+		UserID:          userID,
+		TodoID:          todo.ID,
+		StartedAt:       endedAt.Add(-time.Duration(duration) * time.Second),
+		EndedAt:         null.TimeFrom(endedAt),
+		DurationSeconds: null.IntFrom(duration),
+		Note:            null.StringFromPtr(note),
+	}
+	if err := entry.Insert(ctx, tes.db, boil.Infer()); err != nil {
+		return &models.TimeEntry{}, view.NewInternalServerErrorView(err)
+	}
+	return entry, nil
+}
+
+// NOTE: 計測中のタイマーがない場合はnilを返す
+func (tes *timeEntryService) FetchRunningTimer(ctx context.Context, userID int) (*models.TimeEntry, error) {
+	entry, err := models.TimeEntries(qm.Where("user_id = ? AND ended_at IS NULL", userID)).One(ctx, tes.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+	return entry, nil
+}
+
+// NOTE: Todoの作業時間の合計(秒)を返す(計測中のタイマーの経過時間を含む)
+func (tes *timeEntryService) FetchTimeSpent(ctx context.Context, todoID int) (int, error) {
+	var total int
+	err := queries.Raw(
+		"SELECT COALESCE(SUM("+timeEntrySecondsExpr+"), 0) FROM time_entries WHERE todo_id = ?",
+		time.Now(), todoID,
+	).QueryRowContext(ctx, tes.db).Scan(&total)
+	if err != nil {
+		return 0, view.NewInternalServerErrorView(err)
+	}
+	return total, nil
+}
+
+// NOTE: 期間内(開始日時の日付がfrom ~ to)の作業時間を、指定した単位ごとに集計する
+func (tes *timeEntryService) FetchTimeReport(ctx context.Context, from string, to string, groupBy model.TimeReportGroupBy, userID int) (*model.TimeReport, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateTimeReport(from, to)
+	if validationErrors != nil {
+		return &model.TimeReport{}, view.NewBadRequestView(validationErrors)
+	}
+	group, ok := timeReportGroups[groupBy]
+	if !ok {
+		return &model.TimeReport{}, view.NewBadRequestView(fmt.Errorf("集計の単位が不正です。"))
+	}
+
+	fromTime, _ := time.ParseInLocation(dueDateLayout, from, time.Local)
+	toTime, _ := time.ParseInLocation(dueDateLayout, to, time.Local)
+	rows := []*model.TimeReportRow{}
+	err := queries.Raw(
+		"SELECT "+group.key+" AS `key`, "+group.label+" AS label, SUM("+timeEntrySecondsExpr+") AS total_seconds"+
+			" FROM time_entries"+
+			" INNER JOIN todos ON todos.id = time_entries.todo_id"+
+			" LEFT JOIN projects ON projects.id = todos.project_id"+
+			" WHERE time_entries.user_id = ? AND time_entries.started_at >= ? AND time_entries.started_at < ?"+
+			" GROUP BY `key`"+
+			" ORDER BY "+group.orderBy,
+		time.Now(), userID, fromTime, toTime.AddDate(0, 0, 1),
+	).Bind(ctx, tes.db, &rows)
+	if err != nil {
+		return &model.TimeReport{}, view.NewInternalServerErrorView(err)
+	}
+
+	report := &model.TimeReport{GroupBy: groupBy, Rows: rows}
+	for _, row := range rows {
+		report.TotalSeconds += row.TotalSeconds
+	}
+	return report, nil
+}
+
+// NOTE: 作業時間(秒)を返す(計測中のタイマーはnowまでの経過時間)
+func TimeEntryDuration(entry *models.TimeEntry, now time.Time) int {
+	if entry.DurationSeconds.Valid {
+		return entry.DurationSeconds.Int
+	}
+	return int(now.Sub(entry.StartedAt).Seconds())
+}
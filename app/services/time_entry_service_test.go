@@ -0,0 +1,127 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type TestTimeEntryServiceSuite struct {
+	WithDBSuite
+}
+
+var testTimeEntryService TimeEntryService
+
+func (s *TestTimeEntryServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	testTimeEntryService = NewTimeEntryService(DBCon)
+	testTodoService = newTestTodoService(s.T())
+}
+
+func (s *TestTimeEntryServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestTimeEntryServiceSuite) createTodo(title string) *models.Todo {
+	todo, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: title, Content: ""}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	return todo
+}
+
+func (s *TestTimeEntryServiceSuite) TestStartTimer() {
+	todo := s.createTodo("設計レビュー")
+
+	entry, err := testTimeEntryService.StartTimer(ctx, todo.ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), entry.EndedAt.Valid)
+	running, _ := testTimeEntryService.FetchRunningTimer(ctx, user.ID)
+	assert.Equal(s.T(), entry.ID, running.ID)
+
+	// NOTE: 計測中のタイマーがある場合は開始できないことの確認
+	_, err = testTimeEntryService.StartTimer(ctx, s.createTodo("実装").ID, user.ID)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTimeEntryServiceSuite) TestStopTimer() {
+	todo := s.createTodo("設計レビュー")
+	if _, err := testTimeEntryService.StartTimer(ctx, todo.ID, user.ID); err != nil {
+		s.T().Fatalf("failed to start test timer %v", err)
+	}
+
+	entry, err := testTimeEntryService.StopTimer(ctx, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), entry.EndedAt.Valid)
+	assert.True(s.T(), entry.DurationSeconds.Valid)
+	running, _ := testTimeEntryService.FetchRunningTimer(ctx, user.ID)
+	assert.Nil(s.T(), running)
+
+	// NOTE: 計測中のタイマーがない場合はエラーとなることの確認
+	_, err = testTimeEntryService.StopTimer(ctx, user.ID)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTimeEntryServiceSuite) TestLogTime() {
+	todo := s.createTodo("設計レビュー")
+	note := "打ち合わせ"
+
+	entry, err := testTimeEntryService.LogTime(ctx, todo.ID, 1800, &note, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1800, entry.DurationSeconds.Int)
+	assert.Equal(s.T(), note, entry.Note.String)
+
+	_, _ = testTimeEntryService.LogTime(ctx, todo.ID, 600, nil, user.ID)
+	timeSpent, err := testTimeEntryService.FetchTimeSpent(ctx, todo.ID)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2400, timeSpent)
+}
+
+func (s *TestTimeEntryServiceSuite) TestLogTime_ValidationError() {
+	todo := s.createTodo("設計レビュー")
+
+	_, err := testTimeEntryService.LogTime(ctx, todo.ID, 0, nil, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTimeEntryServiceSuite) TestFetchTimeReport() {
+	review, implementation := s.createTodo("設計レビュー"), s.createTodo("実装")
+	_, _ = testTimeEntryService.LogTime(ctx, review.ID, 1800, nil, user.ID)
+	_, _ = testTimeEntryService.LogTime(ctx, implementation.ID, 3600, nil, user.ID)
+	_, _ = testTimeEntryService.LogTime(ctx, implementation.ID, 600, nil, user.ID)
+	today := time.Now().Format(dueDateLayout)
+
+	report, err := testTimeEntryService.FetchTimeReport(ctx, today, today, model.TimeReportGroupByTodo, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 6000, report.TotalSeconds)
+	assert.Len(s.T(), report.Rows, 2)
+	assert.Equal(s.T(), "実装", report.Rows[0].Label)
+	assert.Equal(s.T(), 4200, report.Rows[0].TotalSeconds)
+
+	// NOTE: 期間外の作業時間は集計されないことの確認
+	report, _ = testTimeEntryService.FetchTimeReport(ctx, "2000-01-01", "2000-01-31", model.TimeReportGroupByDay, user.ID)
+	assert.Len(s.T(), report.Rows, 0)
+}
+
+func TestTimeEntryService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestTimeEntryServiceSuite))
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 変更履歴やWebhookの配信をTodoの変更と同じトランザクションで記録するため、トランザクション内で行ロックを取得して更新する
+func (ts *todoService) ArchiveTodo(ctx context.Context, id int, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	if todo.ArchivedAt.Valid {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("既にアーカイブ済みのTodoです。"))
+	}
+
+	todo.ArchivedAt = null.Time{Time: time.Now(), Valid: true}
+	todo.Version++
+	if _, err := todo.Update(ctx, tx, boil.Whitelist(models.TodoColumns.ArchivedAt, models.TodoColumns.Version, models.TodoColumns.UpdatedAt)); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return todo, nil
+}
+
+func (ts *todoService) UnarchiveTodo(ctx context.Context, id int, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ? AND archived_at IS NOT NULL", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+
+	todo.ArchivedAt = null.Time{}
+	todo.Version++
+	if _, err := todo.Update(ctx, tx, boil.Whitelist(models.TodoColumns.ArchivedAt, models.TodoColumns.Version, models.TodoColumns.UpdatedAt)); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return todo, nil
+}
+
+// NOTE: 完了済みのTodoをまとめてアーカイブし、件数を返す
+// 変更履歴を記録するため、UpdateAllは使わず1件ずつ更新する
+func (ts *todoService) ArchiveCompletedTodos(ctx context.Context, userID int) (int, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todos, err := models.Todos(
+		qm.Where("user_id = ? AND completed_at IS NOT NULL AND archived_at IS NULL", userID),
+		qm.For("UPDATE"),
+	).All(ctx, tx)
+	if err != nil {
+		return 0, view.NewInternalServerErrorView(err)
+	}
+
+	now := time.Now()
+	for _, todo := range todos {
+		todo.ArchivedAt = null.Time{Time: now, Valid: true}
+		todo.Version++
+		if _, err := todo.Update(ctx, tx, boil.Whitelist(models.TodoColumns.ArchivedAt, models.TodoColumns.Version, models.TodoColumns.UpdatedAt)); err != nil {
+			return 0, view.NewInternalServerErrorView(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return len(todos), nil
+}
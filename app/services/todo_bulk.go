@@ -0,0 +1,467 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/rank"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 1回の一括操作で指定できる件数の上限
+const maxBulkTodoCount = 100
+
+// NOTE: 一括操作の対象のTodoと、入力順の処理結果
+type bulkTodoTargets struct {
+	todos   models.TodoSlice
+	results []*model.BulkTodoItemResult
+	byID    map[int]*model.BulkTodoItemResult
+}
+
+func (ts *todoService) BulkCreateTodos(ctx context.Context, inputs []*model.CreateTodoInput, userID int) (*model.BulkTodoPayload, error) {
+	ctx, collected := withTodoChanges(ctx)
+	if err := validateBulkTodoCount(len(inputs)); err != nil {
+		return &model.BulkTodoPayload{}, err
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	// NOTE: バリデーションエラーの入力は個別に失敗とし、それ以外をまとめて登録する
+	results := make([]*model.BulkTodoItemResult, len(inputs))
+	todos := models.TodoSlice{}
+	created := []*model.BulkTodoItemResult{}
+	for i, input := range inputs {
+		results[i] = &model.BulkTodoItemResult{Index: i}
+		if validationErrors := validator.ValidateCreateTodo(*input); validationErrors != nil {
+			failBulkTodoItem(results[i], http.StatusBadRequest, validationErrors)
+			continue
+		}
+
+		todo := newTodoFromInput(*input, userID)
+		todo.ProjectID, err = todoProjectID(ctx, tx, input.ProjectID, userID)
+		var viewError view.ViewError
+		if errors.As(err, &viewError) && viewError.Code == http.StatusNotFound {
+			failBulkTodoItem(results[i], http.StatusNotFound, viewError.Message)
+			continue
+		}
+		if err != nil {
+			return &model.BulkTodoPayload{}, err
+		}
+
+		todos = append(todos, todo)
+		created = append(created, results[i])
+	}
+
+	if err := appendTodoPositions(ctx, tx, userID, todos); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	todos, err = insertTodos(ctx, tx, todos)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+
+	// NOTE: リマインド日時が指定されている場合はリマインダーも登録する
+	reminders := models.TodoReminderSlice{}
+	todoTags := models.TodoTagSlice{}
+	for i, todo := range todos {
+		id := strconv.Itoa(todo.ID)
+		created[i].ID = &id
+		created[i].Todo = todo
+		input := inputs[created[i].Index]
+		if input.RemindAt != nil {
+			reminders = append(reminders, newTodoReminder(todo, *input.RemindAt))
+		}
+		todoTags = append(todoTags, newTodoTags(todo.ID, input.Tags)...)
+	}
+	if _, err := reminders.InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	if _, err := todoTags.InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return newBulkTodoPayload(results), nil
+}
+
+func (ts *todoService) BulkUpdateTodos(ctx context.Context, ids []int, patch model.BulkTodoPatch, userID int) (*model.BulkTodoPayload, error) {
+	ctx, collected := withTodoChanges(ctx)
+	if err := validateBulkTodoCount(len(ids)); err != nil {
+		return &model.BulkTodoPayload{}, err
+	}
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateBulkTodoPatch(patch)
+	if validationErrors != nil {
+		return &model.BulkTodoPayload{}, view.NewBadRequestView(validationErrors)
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	targets, err := lockBulkTodoTargets(ctx, tx, ids, userID)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+
+	columns := models.M{}
+	if title, ok := patch.Title.ValueOK(); ok {
+		columns[models.TodoColumns.Title] = *title
+	}
+	// NOTE: nullが明示された場合は値をクリアする
+	if content, ok := patch.Content.ValueOK(); ok {
+		columns[models.TodoColumns.Content] = null.StringFromPtr(content)
+	}
+	if dueDate, ok := patch.DueDate.ValueOK(); ok {
+		columns[models.TodoColumns.DueDate] = dueDateFromInput(dueDate)
+	}
+	if recurrence, ok := patch.Recurrence.ValueOK(); ok {
+		columns[models.TodoColumns.RecurrenceRule] = recurrenceRuleFromInput(recurrence)
+	}
+
+	// NOTE: 変更のあったTodoのみ更新し、それぞれの変更履歴を記録する
+	updatedAt := time.Now()
+	changed := models.TodoSlice{}
+	for _, todo := range targets.todos {
+		before := *todo
+		applyBulkTodoPatch(todo, patch)
+		changes := diffTodo(&before, todo)
+		if len(changes) == 0 {
+			continue
+		}
+		if err := recordTodoChange(ctx, tx, todo, todoRevisionActionUpdate, changes); err != nil {
+			return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+		todo.Version++
+		todo.UpdatedAt = updatedAt
+		changed = append(changed, todo)
+	}
+	if len(changed) > 0 {
+		columns[models.TodoColumns.UpdatedAt] = updatedAt
+		if _, err := changed.UpdateAll(ctx, tx, columns); err != nil {
+			return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+		// NOTE: 編集中の他の画面からの更新と競合させるため、versionを進める
+		if err := incrementTodoVersions(ctx, tx, changed); err != nil {
+			return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+	}
+	targets.succeed(targets.todos)
+
+	if err := tx.Commit(); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return newBulkTodoPayload(targets.results), nil
+}
+
+func (ts *todoService) BulkDeleteTodos(ctx context.Context, ids []int, userID int) (*model.BulkTodoPayload, error) {
+	ctx, collected := withTodoChanges(ctx)
+	if err := validateBulkTodoCount(len(ids)); err != nil {
+		return &model.BulkTodoPayload{}, err
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	targets, err := lockBulkTodoTargets(ctx, tx, ids, userID)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+
+	// NOTE: 論理削除(ゴミ箱へ移動)
+	if _, err := targets.todos.DeleteAll(ctx, tx, false); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	targets.succeed(targets.todos)
+
+	if err := tx.Commit(); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return newBulkTodoPayload(targets.results), nil
+}
+
+// NOTE: 未完了の先行Todoがある場合は完了できない(同時に完了するTodoは完了済みとして扱う)
+func (ts *todoService) BulkCompleteTodos(ctx context.Context, ids []int, force bool, userID int) (*model.BulkTodoPayload, error) {
+	ctx, collected := withTodoChanges(ctx)
+	if err := validateBulkTodoCount(len(ids)); err != nil {
+		return &model.BulkTodoPayload{}, err
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	targets, err := lockBulkTodoTargets(ctx, tx, ids, userID)
+	if err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+
+	candidates := models.TodoSlice{}
+	for _, todo := range targets.todos {
+		if todo.CompletedAt.Valid {
+			failBulkTodoItem(targets.byID[todo.ID], http.StatusBadRequest, fmt.Errorf("既に完了済みのTodoです。"))
+			continue
+		}
+		candidates = append(candidates, todo)
+	}
+	if !force {
+		candidates, err = excludeBlockedTodos(ctx, tx, candidates, targets)
+		if err != nil {
+			return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+	}
+
+	completedAt := time.Now()
+	completed := models.TodoSlice{}
+	nextOccurrences := models.TodoSlice{}
+	for _, todo := range candidates {
+
+		before := *todo
+		todo.CompletedAt = null.Time{Time: completedAt, Valid: true}
+		todo.UpdatedAt = completedAt
+		if err := recordTodoChange(ctx, tx, todo, todoRevisionActionUpdate, diffTodo(&before, todo)); err != nil {
+			return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+		todo.Version++
+		completed = append(completed, todo)
+
+		// NOTE: 繰り返し設定がある場合は次回分のTodoを生成する
+		nextOccurrence, err := newNextOccurrence(todo, completedAt)
+		if err != nil {
+			return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+		if nextOccurrence != nil {
+			nextOccurrences = append(nextOccurrences, nextOccurrence)
+		}
+	}
+
+	if _, err := completed.UpdateAll(ctx, tx, models.M{
+		models.TodoColumns.CompletedAt: completedAt,
+		models.TodoColumns.UpdatedAt:   completedAt,
+	}); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	if len(completed) > 0 {
+		if err := incrementTodoVersions(ctx, tx, completed); err != nil {
+			return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+	}
+	if err := appendTodoPositions(ctx, tx, userID, nextOccurrences); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	if _, err := insertTodos(ctx, tx, nextOccurrences); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	targets.succeed(completed)
+
+	if err := tx.Commit(); err != nil {
+		return &model.BulkTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return newBulkTodoPayload(targets.results), nil
+}
+
+func applyBulkTodoPatch(todo *models.Todo, patch model.BulkTodoPatch) {
+	if title, ok := patch.Title.ValueOK(); ok {
+		todo.Title = *title
+	}
+	if content, ok := patch.Content.ValueOK(); ok {
+		todo.Content = null.StringFromPtr(content)
+	}
+	if dueDate, ok := patch.DueDate.ValueOK(); ok {
+		todo.DueDate = dueDateFromInput(dueDate)
+	}
+	if recurrence, ok := patch.Recurrence.ValueOK(); ok {
+		todo.RecurrenceRule = recurrenceRuleFromInput(recurrence)
+	}
+}
+
+func validateBulkTodoCount(count int) error {
+	if count == 0 || count > maxBulkTodoCount {
+		return view.NewBadRequestView(fmt.Errorf("一括操作の対象は1 ~ %d件での指定をお願いします。", maxBulkTodoCount))
+	}
+	return nil
+}
+
+// NOTE: 指定されたIDのうちログインユーザのTodoを行ロックして取得する
+// 見つからない(他のユーザのTodoを含む)IDや重複したIDは、その項目のみ失敗として結果に記録する
+func lockBulkTodoTargets(ctx context.Context, exec boil.ContextExecutor, ids []int, userID int) (*bulkTodoTargets, error) {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	todos, err := models.Todos(
+		qm.WhereIn("id IN ?", args...),
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("id ASC"),
+		qm.For("UPDATE"),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[int]*models.Todo, len(todos))
+	for _, todo := range todos {
+		owned[todo.ID] = todo
+	}
+
+	targets := &bulkTodoTargets{
+		todos:   models.TodoSlice{},
+		results: make([]*model.BulkTodoItemResult, len(ids)),
+		byID:    map[int]*model.BulkTodoItemResult{},
+	}
+	for i, id := range ids {
+		strID := strconv.Itoa(id)
+		targets.results[i] = &model.BulkTodoItemResult{Index: i, ID: &strID}
+
+		todo, ok := owned[id]
+		if !ok {
+			failBulkTodoItem(targets.results[i], http.StatusNotFound, fmt.Errorf("Todoが見つかりません。"))
+			continue
+		}
+		if _, duplicated := targets.byID[id]; duplicated {
+			failBulkTodoItem(targets.results[i], http.StatusBadRequest, fmt.Errorf("同じTodoが重複して指定されています。"))
+			continue
+		}
+		targets.todos = append(targets.todos, todo)
+		targets.byID[id] = targets.results[i]
+	}
+	return targets, nil
+}
+
+func (t *bulkTodoTargets) succeed(todos models.TodoSlice) {
+	for _, todo := range todos {
+		t.byID[todo.ID].Todo = todo
+	}
+}
+
+func failBulkTodoItem(result *model.BulkTodoItemResult, code int, err error) {
+	message := err.Error()
+	result.Code = &code
+	result.Error = &message
+}
+
+func newBulkTodoPayload(results []*model.BulkTodoItemResult) *model.BulkTodoPayload {
+	payload := &model.BulkTodoPayload{Results: results}
+	for _, result := range results {
+		if result.Code == nil {
+			payload.SucceededCount++
+		} else {
+			payload.FailedCount++
+		}
+	}
+	return payload
+}
+
+// NOTE: 複数のTodoを指定された順に末尾へ追加する場合の順位を設定する
+func appendTodoPositions(ctx context.Context, exec boil.ContextExecutor, userID int, todos models.TodoSlice) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	position, err := appendTodoPosition(ctx, exec, userID)
+	if err != nil {
+		return err
+	}
+	todos[0].Position = position
+	for _, todo := range todos[1:] {
+		position, err = rank.Between(position, "")
+		if err != nil {
+			return err
+		}
+		if len(position) > maxTodoPositionLength {
+			return fmt.Errorf("todo position is too long")
+		}
+		todo.Position = position
+	}
+	return nil
+}
+
+// NOTE: 1件ずつ登録し、採番されたIDを反映したTodoを返す
+// InsertAllは採番されたIDを返さず、複数行のINSERTで連番に採番される保証もないため使わない(作成時の変更履歴はフックで記録される)
+func insertTodos(ctx context.Context, exec boil.ContextExecutor, todos models.TodoSlice) (models.TodoSlice, error) {
+	for _, todo := range todos {
+		if err := todo.Insert(ctx, exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+	}
+	return todos, nil
+}
+
+// NOTE: SliceのUpdateAllでは行ごとに異なる値を設定できないため、versionの加算のみ個別に実行する
+func incrementTodoVersions(ctx context.Context, exec boil.ContextExecutor, todos models.TodoSlice) error {
+	args := make([]interface{}, len(todos))
+	for i, todo := range todos {
+		args[i] = todo.ID
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(todos)), ",")
+	_, err := queries.Raw("UPDATE todos SET version = version + 1 WHERE id IN ("+placeholders+")", args...).ExecContext(ctx, exec)
+	return err
+}
+
+// NOTE: 未完了の先行Todoがあるものを除外する
+// 除外により先行Todoが完了しなくなった後続のTodoも除外されるよう、変化がなくなるまで繰り返す
+func excludeBlockedTodos(ctx context.Context, exec boil.ContextExecutor, todos models.TodoSlice, targets *bulkTodoTargets) (models.TodoSlice, error) {
+	ids := make([]int, len(todos))
+	for i, todo := range todos {
+		ids[i] = todo.ID
+	}
+	blockerIDs, err := openBlockerIDs(ctx, exec, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		completing := map[int]bool{}
+		for _, todo := range todos {
+			completing[todo.ID] = true
+		}
+
+		remaining := models.TodoSlice{}
+		for _, todo := range todos {
+			blocked := false
+			for _, blockerID := range blockerIDs[todo.ID] {
+				if !completing[blockerID] {
+					blocked = true
+					break
+				}
+			}
+			if blocked {
+				failBulkTodoItem(targets.byID[todo.ID], http.StatusBadRequest, fmt.Errorf("未完了の先行Todoがあるため完了できません。"))
+				continue
+			}
+			remaining = append(remaining, todo)
+		}
+		if len(remaining) == len(todos) {
+			return remaining, nil
+		}
+		todos = remaining
+	}
+}
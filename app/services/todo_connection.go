@@ -0,0 +1,158 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 期日未設定のTodoは昇順の場合に末尾へ並べる
+const noDueDateSortKey = "9999-12-31"
+
+// NOTE: 並び替えの項目ごとの、SQLの式とカーソルに保存する値
+var todoOrderKeys = map[model.TodoOrderField]struct {
+	expr string
+	key  func(todo *models.Todo) string
+}{
+	model.TodoOrderFieldPosition:  {"position", func(todo *models.Todo) string { return todo.Position }},
+	model.TodoOrderFieldCreatedAt: {"created_at", func(todo *models.Todo) string { return todo.CreatedAt.Format(dateTimeLayout) }},
+	model.TodoOrderFieldUpdatedAt: {"updated_at", func(todo *models.Todo) string { return todo.UpdatedAt.Format(dateTimeLayout) }},
+	model.TodoOrderFieldDueDate: {"COALESCE(due_date, '" + noDueDateSortKey + "')", func(todo *models.Todo) string {
+		if !todo.DueDate.Valid {
+			return noDueDateSortKey
+		}
+		return todo.DueDate.Time.Format(dueDateLayout)
+	}},
+	model.TodoOrderFieldPriority: {"priority", func(todo *models.Todo) string { return strconv.Itoa(int(todo.Priority)) }},
+	model.TodoOrderFieldTitle:    {"title", func(todo *models.Todo) string { return todo.Title }},
+}
+
+// NOTE: (並び替えの値, ID)によるキーセットページネーションでTodoを取得する
+// first/afterで後続のページを、last/beforeで前のページを取得する
+func (ts *todoService) FetchTodos(ctx context.Context, userID int, first *int, after *string, last *int, before *string, filter *model.TodoFilter, orderBy *model.TodoOrder, includeArchived bool) (*model.TodoConnection, error) {
+	if first != nil && last != nil {
+		return &model.TodoConnection{}, view.NewBadRequestView(fmt.Errorf("firstとlastは同時に指定できません。"))
+	}
+
+	order := model.TodoOrder{Field: model.TodoOrderFieldPosition, Direction: model.OrderDirectionAsc}
+	if orderBy != nil {
+		order = *orderBy
+	}
+	orderKey, ok := todoOrderKeys[order.Field]
+	if !ok {
+		return &model.TodoConnection{}, view.NewBadRequestView(fmt.Errorf("並び替えの項目が不正です。"))
+	}
+	ascending := order.Direction != model.OrderDirectionDesc
+
+	// NOTE: バリデーションチェック
+	if filter != nil {
+		if validationErrors := validator.ValidateTodoFilter(*filter); validationErrors != nil {
+			return &model.TodoConnection{}, view.NewBadRequestView(validationErrors)
+		}
+	}
+
+	mods := []qm.QueryMod{qm.Where("user_id = ?", userID)}
+	// NOTE: アーカイブ済みのTodoは指定がない限り一覧に含めない(ステータスでアーカイブ済みが指定された場合も含める)
+	if !includeArchived && !filterIncludesArchived(filter) {
+		mods = append(mods, qm.Where("archived_at IS NULL"))
+	}
+	mods = append(mods, todoFilterMods(filter)...)
+
+	totalCount, err := models.Todos(mods...).Count(ctx, ts.db)
+	if err != nil {
+		return &model.TodoConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	if after != nil {
+		cursor, err := decodeKeysetCursor(*after, string(order.Field))
+		if err != nil {
+			return &model.TodoConnection{}, view.NewBadRequestView(err)
+		}
+		mods = append(mods, keysetWhere(orderKey.expr, cursor, ascending))
+	}
+	if before != nil {
+		cursor, err := decodeKeysetCursor(*before, string(order.Field))
+		if err != nil {
+			return &model.TodoConnection{}, view.NewBadRequestView(err)
+		}
+		mods = append(mods, keysetWhere(orderKey.expr, cursor, !ascending))
+	}
+
+	// NOTE: 前のページを取得する場合は逆順に取得してから並べ直す
+	backward := last != nil || (before != nil && first == nil)
+	limit := pageSize(first)
+	direction := "ASC"
+	if backward {
+		limit = pageSize(last)
+		ascending = !ascending
+	}
+	if !ascending {
+		direction = "DESC"
+	}
+
+	// NOTE: 前後のページの有無を判定するため1件多く取得する
+	mods = append(mods,
+		qm.OrderBy(fmt.Sprintf("%s %s, id %s", orderKey.expr, direction, direction)),
+		qm.Limit(limit+1),
+	)
+	todos, err := models.Todos(mods...).All(ctx, ts.db)
+	if err != nil {
+		return &model.TodoConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	hasMore := len(todos) > limit
+	if hasMore {
+		todos = todos[:limit]
+	}
+	if backward {
+		for i, j := 0, len(todos)-1; i < j; i, j = i+1, j-1 {
+			todos[i], todos[j] = todos[j], todos[i]
+		}
+	}
+
+	edges := make([]*model.TodoEdge, 0, len(todos))
+	for _, todo := range todos {
+		cursor := keysetCursor{Field: string(order.Field), Key: orderKey.key(todo), ID: todo.ID}
+		edges = append(edges, &model.TodoEdge{Cursor: encodeKeysetCursor(cursor), Node: todo})
+	}
+	pageInfo := &model.PageInfo{HasNextPage: hasMore, HasPreviousPage: after != nil}
+	if backward {
+		pageInfo = &model.PageInfo{HasNextPage: before != nil, HasPreviousPage: hasMore}
+	}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.TodoConnection{Edges: edges, PageInfo: pageInfo, TotalCount: int(totalCount)}, nil
+}
+
+// NOTE: カーソルより後(greaterがfalseの場合は前)の行に絞り込む条件
+func keysetWhere(expr string, cursor keysetCursor, greater bool) qm.QueryMod {
+	op := ">"
+	if !greater {
+		op = "<"
+	}
+	return qm.Where(
+		fmt.Sprintf("(%s %s ? OR (%s = ? AND id %s ?))", expr, op, expr, op),
+		cursor.Key, cursor.Key, cursor.ID,
+	)
+}
+
+func filterIncludesArchived(filter *model.TodoFilter) bool {
+	if filter == nil {
+		return false
+	}
+	for _, status := range filter.Statuses {
+		if status == model.TodoStatusArchived {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 先行Todoとして完了を妨げる条件(アーカイブ済み・ゴミ箱内のTodoは対象外)
+const openBlockerCondition = "blocker_id IN (SELECT id FROM todos WHERE completed_at IS NULL AND archived_at IS NULL AND deleted_at IS NULL)"
+
+// NOTE: todoIDのTodoがblockerIDのTodoの完了を待つ(blockerIDに先行される)依存関係を登録する
+func (ts *todoService) AddTodoDependency(ctx context.Context, todoID int, blockerID int, userID int) (*models.Todo, error) {
+	if todoID == blockerID {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("自身を先行Todoに指定することはできません。"))
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	// NOTE: 同時に登録された依存関係によって循環が生じないよう、ユーザ単位で排他制御する
+	if _, err := models.Users(qm.Where("id = ?", userID), qm.For("UPDATE")).One(ctx, tx); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	exists, err := models.Todos(qm.Where("id = ? AND user_id = ?", blockerID, userID)).Exists(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if !exists {
+		return &models.Todo{}, view.NewNotFoundView(fmt.Errorf("先行Todoが見つかりません。"))
+	}
+
+	exists, err = models.TodoDependencies(qm.Where("todo_id = ? AND blocker_id = ?", todoID, blockerID)).Exists(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if exists {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("既に登録済みの依存関係です。"))
+	}
+	// NOTE: 先行Todoが(間接的にでも)このTodoの完了を待っている場合は循環となる
+	cyclic, err := isBlockedBy(ctx, tx, blockerID, todoID)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if cyclic {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("依存関係が循環するため登録できません。"))
+	}
+
+	dependency := &models.TodoDependency{TodoID: todoID, BlockerID: blockerID}
+	if err := dependency.Insert(ctx, tx, boil.Infer()); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	return todo, nil
+}
+
+func (ts *todoService) RemoveTodoDependency(ctx context.Context, todoID int, blockerID int, userID int) (*models.Todo, error) {
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, ts.db)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	dependency, err := models.TodoDependencies(qm.Where("todo_id = ? AND blocker_id = ?", todoID, blockerID)).One(ctx, ts.db)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+
+	if _, err := dependency.Delete(ctx, ts.db); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	return todo, nil
+}
+
+// NOTE: 指定したTodoの完了を待たせている先行Todo
+func (ts *todoService) FetchBlockers(ctx context.Context, todoID int) ([]*models.Todo, error) {
+	todos, err := models.Todos(
+		qm.Where("id IN (SELECT blocker_id FROM todo_dependencies WHERE todo_id = ?)", todoID),
+		qm.OrderBy("position ASC, id ASC"),
+	).All(ctx, ts.db)
+	if err != nil {
+		return models.TodoSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return todos, nil
+}
+
+// NOTE: 指定したTodoの完了を待っている後続のTodo
+func (ts *todoService) FetchBlockedTodos(ctx context.Context, todoID int) ([]*models.Todo, error) {
+	todos, err := models.Todos(
+		qm.Where("id IN (SELECT todo_id FROM todo_dependencies WHERE blocker_id = ?)", todoID),
+		qm.OrderBy("position ASC, id ASC"),
+	).All(ctx, ts.db)
+	if err != nil {
+		return models.TodoSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return todos, nil
+}
+
+// NOTE: todoIDのTodoが、依存関係を辿ってblockerIDのTodoの完了を待っているかどうか
+func isBlockedBy(ctx context.Context, exec boil.ContextExecutor, todoID int, blockerID int) (bool, error) {
+	var blocked bool
+	err := queries.Raw(`
+		WITH RECURSIVE blockers (id) AS (
+			SELECT blocker_id FROM todo_dependencies WHERE todo_id = ?
+			UNION
+			SELECT todo_dependencies.blocker_id FROM todo_dependencies INNER JOIN blockers ON todo_dependencies.todo_id = blockers.id
+		)
+		SELECT EXISTS (SELECT 1 FROM blockers WHERE id = ?)`,
+		todoID, blockerID,
+	).QueryRowContext(ctx, exec).Scan(&blocked)
+	return blocked, err
+}
+
+// NOTE: 指定したTodoごとの、未完了の先行TodoのID
+func openBlockerIDs(ctx context.Context, exec boil.ContextExecutor, todoIDs []int) (map[int][]int, error) {
+	blockerIDs := map[int][]int{}
+	if len(todoIDs) == 0 {
+		return blockerIDs, nil
+	}
+
+	args := make([]interface{}, len(todoIDs))
+	for i, id := range todoIDs {
+		args[i] = id
+	}
+	dependencies, err := models.TodoDependencies(
+		qm.WhereIn("todo_id IN ?", args...),
+		qm.Where(openBlockerCondition),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	for _, dependency := range dependencies {
+		blockerIDs[dependency.TodoID] = append(blockerIDs[dependency.TodoID], dependency.BlockerID)
+	}
+	return blockerIDs, nil
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 優先度はDBに数値で保存する(配列のインデックスが保存値、大きいほど優先度が高い)
+var todoPriorities = []model.TodoPriority{
+	model.TodoPriorityNone,
+	model.TodoPriorityLow,
+	model.TodoPriorityMedium,
+	model.TodoPriorityHigh,
+}
+
+// NOTE: ステータスごとの絞り込み条件(ユーザの入力値をSQLに含めないよう、許可した条件のみ使用する)
+var todoStatusConditions = map[model.TodoStatus]string{
+	model.TodoStatusOpen:      "(completed_at IS NULL AND archived_at IS NULL)",
+	model.TodoStatusCompleted: "(completed_at IS NOT NULL AND archived_at IS NULL)",
+	model.TodoStatusArchived:  "archived_at IS NOT NULL",
+}
+
+func todoPriorityValue(priority model.TodoPriority) int8 {
+	for i, p := range todoPriorities {
+		if p == priority {
+			return int8(i)
+		}
+	}
+	return 0
+}
+
+// NOTE: DBに保存された優先度をGraphQLの型へ変換する
+func TodoPriorityFromValue(value int8) model.TodoPriority {
+	if int(value) < 0 || int(value) >= len(todoPriorities) {
+		return model.TodoPriorityNone
+	}
+	return todoPriorities[value]
+}
+
+// NOTE: Todoのステータスを返す(アーカイブ済みの場合は完了状態に関わらずアーカイブ済みとする)
+func TodoStatusOf(todo *models.Todo) model.TodoStatus {
+	if todo.ArchivedAt.Valid {
+		return model.TodoStatusArchived
+	}
+	if todo.CompletedAt.Valid {
+		return model.TodoStatusCompleted
+	}
+	return model.TodoStatusOpen
+}
+
+// NOTE: 絞り込み条件をクエリに変換する(バリデーション済みの前提)
+func todoFilterMods(filter *model.TodoFilter) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+	if filter == nil {
+		return mods
+	}
+
+	if filter.TitleContains != nil && *filter.TitleContains != "" {
+		mods = append(mods, qm.Where("title LIKE ?", containsPattern(*filter.TitleContains)))
+	}
+	if filter.ContentContains != nil && *filter.ContentContains != "" {
+		mods = append(mods, qm.Where("content LIKE ?", containsPattern(*filter.ContentContains)))
+	}
+	if len(filter.Statuses) > 0 {
+		conditions := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			conditions = append(conditions, todoStatusConditions[status])
+		}
+		mods = append(mods, qm.Where("("+strings.Join(conditions, " OR ")+")"))
+	}
+	if len(filter.Priorities) > 0 {
+		priorities := make([]interface{}, 0, len(filter.Priorities))
+		for _, priority := range filter.Priorities {
+			priorities = append(priorities, todoPriorityValue(priority))
+		}
+		mods = append(mods, qm.WhereIn("priority IN ?", priorities...))
+	}
+	if filter.CreatedAt != nil {
+		mods = append(mods, dateTimeRangeMods(models.TodoColumns.CreatedAt, filter.CreatedAt)...)
+	}
+	if filter.UpdatedAt != nil {
+		mods = append(mods, dateTimeRangeMods(models.TodoColumns.UpdatedAt, filter.UpdatedAt)...)
+	}
+	if filter.DueDate != nil {
+		if filter.DueDate.From != nil {
+			mods = append(mods, qm.Where("due_date >= ?", *filter.DueDate.From))
+		}
+		if filter.DueDate.To != nil {
+			mods = append(mods, qm.Where("due_date <= ?", *filter.DueDate.To))
+		}
+	}
+	// NOTE: 指定されたタグのいずれかが付いているTodoに絞り込む
+	if len(filter.Tags) > 0 {
+		tags := make([]interface{}, 0, len(filter.Tags))
+		for _, tag := range normalizeTags(filter.Tags) {
+			tags = append(tags, tag)
+		}
+		mods = append(mods, qm.WhereIn("id IN (SELECT todo_id FROM todo_tags WHERE name IN ?)", tags...))
+	}
+	if filter.ProjectID != nil {
+		projectID, _ := strconv.Atoi(*filter.ProjectID)
+		mods = append(mods, qm.Where("project_id = ?", projectID))
+	}
+	if filter.HasAttachments != nil {
+		condition := "EXISTS (SELECT 1 FROM attachments WHERE attachments.todo_id = todos.id)"
+		if !*filter.HasAttachments {
+			condition = "NOT " + condition
+		}
+		mods = append(mods, qm.Where(condition))
+	}
+	return mods
+}
+
+func dateTimeRangeMods(column string, dateTimeRange *model.DateTimeRange) []qm.QueryMod {
+	mods := []qm.QueryMod{}
+	if dateTimeRange.From != nil {
+		from, _ := time.ParseInLocation(dateTimeLayout, *dateTimeRange.From, time.Local)
+		mods = append(mods, qm.Where(column+" >= ?", from))
+	}
+	if dateTimeRange.To != nil {
+		to, _ := time.ParseInLocation(dateTimeLayout, *dateTimeRange.To, time.Local)
+		mods = append(mods, qm.Where(column+" <= ?", to))
+	}
+	return mods
+}
+
+// NOTE: 部分一致検索用に、LIKEの特殊文字をエスケープしたパターンを返す
+func containsPattern(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + replacer.Replace(value) + "%"
+}
+
+// NOTE: タグの前後の空白を除去し、重複を除外する
+func normalizeTags(tags []string) []string {
+	seen := map[string]bool{}
+	normalized := []string{}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+func newTodoTags(todoID int, tags []string) models.TodoTagSlice {
+	todoTags := models.TodoTagSlice{}
+	for _, tag := range normalizeTags(tags) {
+		todoTags = append(todoTags, &models.TodoTag{TodoID: todoID, Name: tag})
+	}
+	return todoTags
+}
+
+// NOTE: Todoのタグを指定されたタグで置き換える
+func replaceTodoTags(ctx context.Context, exec boil.ContextExecutor, todoID int, tags []string) error {
+	if _, err := models.TodoTags(qm.Where("todo_id = ?", todoID)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+	_, err := newTodoTags(todoID, tags).InsertAll(ctx, exec, boil.Infer())
+	return err
+}
+
+func (ts *todoService) FetchTodoTags(ctx context.Context, todoID int) ([]string, error) {
+	todoTags, err := models.TodoTags(
+		qm.Where("todo_id = ?", todoID),
+		qm.OrderBy("name ASC"),
+	).All(ctx, ts.db)
+	if err != nil {
+		return []string{}, view.NewInternalServerErrorView(err)
+	}
+
+	tags := make([]string, 0, len(todoTags))
+	for _, todoTag := range todoTags {
+		tags = append(tags, todoTag.Name)
+	}
+	return tags, nil
+}
+
+// NOTE: 指定されたプロジェクトがログインユーザのものであることを確認する(未指定の場合はプロジェクトなし)
+func todoProjectID(ctx context.Context, exec boil.ContextExecutor, projectID *string, userID int) (null.Int, error) {
+	if projectID == nil {
+		return null.Int{}, nil
+	}
+
+	intID, _ := strconv.Atoi(*projectID)
+	exists, err := models.Projects(qm.Where("id = ? AND user_id = ?", intID, userID)).Exists(ctx, exec)
+	if err != nil {
+		return null.Int{}, view.NewInternalServerErrorView(err)
+	}
+	if !exists {
+		return null.Int{}, view.NewNotFoundView(fmt.Errorf("プロジェクトが見つかりません。"))
+	}
+	return null.Int{Int: intID, Valid: true}, nil
+}
@@ -0,0 +1,162 @@
+package services
+
+import (
+	"app/lib/rank"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 同じ位置への挿入が繰り返されて順位の文字列が長くなった場合は、ユーザのTodo全体の順位を振り直す
+const maxTodoPositionLength = 200
+
+func (ts *todoService) MoveTodo(ctx context.Context, id int, afterID *int, beforeID *int, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
+	if afterID == nil && beforeID == nil {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("移動先の指定は必須です。"))
+	}
+	if (afterID != nil && *afterID == id) || (beforeID != nil && *beforeID == id) {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("移動先の指定が不正です。"))
+	}
+
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+
+	position, err := movedTodoPosition(ctx, tx, todo, afterID, beforeID)
+	if err != nil {
+		return &models.Todo{}, err
+	}
+	if position == "" {
+		// NOTE: 前後の順位が重複している、または順位が長くなりすぎた場合は振り直してから再計算する
+		if err := rebalanceTodoPositions(ctx, tx, userID); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+		if err := todo.Reload(ctx, tx); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+		position, err = movedTodoPosition(ctx, tx, todo, afterID, beforeID)
+		if err != nil {
+			return &models.Todo{}, err
+		}
+		if position == "" {
+			return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("移動先の指定が不正です。"))
+		}
+	}
+
+	todo.Position = position
+	if _, err := todo.Update(ctx, tx, boil.Whitelist(models.TodoColumns.Position, models.TodoColumns.UpdatedAt)); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return todo, nil
+}
+
+// NOTE: afterIDとbeforeIDの間に入る順位を求める(計算できない場合は空文字を返す)
+// 片方のみ指定された場合は、もう片方を指定されたTodoに隣接するTodoとする
+func movedTodoPosition(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, afterID *int, beforeID *int) (string, error) {
+	var after, before string
+	if afterID != nil {
+		afterTodo, err := models.Todos(qm.Where("id = ? AND user_id = ?", *afterID, todo.UserID)).One(ctx, exec)
+		if err != nil {
+			return "", view.NewNotFoundView(err)
+		}
+		after = afterTodo.Position
+	}
+	if beforeID != nil {
+		beforeTodo, err := models.Todos(qm.Where("id = ? AND user_id = ?", *beforeID, todo.UserID)).One(ctx, exec)
+		if err != nil {
+			return "", view.NewNotFoundView(err)
+		}
+		before = beforeTodo.Position
+	}
+
+	var err error
+	if beforeID == nil {
+		before, err = neighborTodoPosition(ctx, exec, todo, qm.Where("position > ?", after), qm.OrderBy("position ASC, id ASC"))
+	} else if afterID == nil {
+		after, err = neighborTodoPosition(ctx, exec, todo, qm.Where("position < ?", before), qm.OrderBy("position DESC, id DESC"))
+	}
+	if err != nil {
+		return "", view.NewInternalServerErrorView(err)
+	}
+
+	position, err := rank.Between(after, before)
+	if err != nil || len(position) > maxTodoPositionLength {
+		return "", nil
+	}
+	return position, nil
+}
+
+func neighborTodoPosition(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, mods ...qm.QueryMod) (string, error) {
+	mods = append(mods, qm.Where("user_id = ? AND id <> ?", todo.UserID, todo.ID))
+	neighbor, err := models.Todos(mods...).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return neighbor.Position, nil
+}
+
+// NOTE: ユーザのTodoの末尾に追加する場合の順位を返す
+func appendTodoPosition(ctx context.Context, exec boil.ContextExecutor, userID int) (string, error) {
+	last, err := models.Todos(
+		qm.Select(models.TodoColumns.Position),
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("position DESC, id DESC"),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rank.Between("", "")
+	}
+	if err != nil {
+		return "", err
+	}
+
+	position, err := rank.Between(last.Position, "")
+	if err != nil || len(position) > maxTodoPositionLength {
+		if err := rebalanceTodoPositions(ctx, exec, userID); err != nil {
+			return "", err
+		}
+		return appendTodoPosition(ctx, exec, userID)
+	}
+	return position, nil
+}
+
+// NOTE: ユーザのTodo全体に、現在の並び順を保ったまま均等な間隔の順位を振り直す
+func rebalanceTodoPositions(ctx context.Context, exec boil.ContextExecutor, userID int) error {
+	todos, err := models.Todos(
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("position ASC, id ASC"),
+		qm.For("UPDATE"),
+	).All(ctx, exec)
+	if err != nil {
+		return err
+	}
+
+	for i, position := range rank.Spread(len(todos)) {
+		todos[i].Position = position
+		if _, err := todos[i].Update(ctx, exec, boil.Whitelist(models.TodoColumns.Position)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
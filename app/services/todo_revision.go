@@ -0,0 +1,250 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/auth"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+const (
+	todoRevisionActionCreate = "create"
+	todoRevisionActionUpdate = "update"
+	todoRevisionActionDelete = "delete"
+	todoRevisionActionRevert = "revert"
+)
+
+type revisionActionKey struct{}
+
+// NOTE: 変更履歴の対象とする項目(並び順やタイムスタンプは対象外)
+var todoRevisionFields = []struct {
+	name  string
+	value func(todo *models.Todo) *string
+}{
+	{models.TodoColumns.Title, func(todo *models.Todo) *string { return &todo.Title }},
+	{models.TodoColumns.Content, func(todo *models.Todo) *string { return todo.Content.Ptr() }},
+	{models.TodoColumns.DueDate, func(todo *models.Todo) *string { return formatNullTime(todo.DueDate, dueDateLayout) }},
+	{models.TodoColumns.RecurrenceRule, func(todo *models.Todo) *string { return todo.RecurrenceRule.Ptr() }},
+	{models.TodoColumns.Priority, func(todo *models.Todo) *string {
+		priority := string(TodoPriorityFromValue(todo.Priority))
+		return &priority
+	}},
+	{models.TodoColumns.EstimateMinutes, func(todo *models.Todo) *string {
+		if !todo.EstimateMinutes.Valid {
+			return nil
+		}
+		estimateMinutes := strconv.Itoa(todo.EstimateMinutes.Int)
+		return &estimateMinutes
+	}},
+	{models.TodoColumns.ProjectID, func(todo *models.Todo) *string {
+		if !todo.ProjectID.Valid {
+			return nil
+		}
+		projectID := strconv.Itoa(todo.ProjectID.Int)
+		return &projectID
+	}},
+	{models.TodoColumns.CompletedAt, func(todo *models.Todo) *string { return formatNullTime(todo.CompletedAt, dateTimeLayout) }},
+	{models.TodoColumns.ArchivedAt, func(todo *models.Todo) *string { return formatNullTime(todo.ArchivedAt, dateTimeLayout) }},
+	{models.TodoColumns.DeletedAt, func(todo *models.Todo) *string { return formatNullTime(todo.DeletedAt, dateTimeLayout) }},
+}
+
+// NOTE: Todoの作成・更新・削除をsqlboilerのフックで捕捉し、変更履歴等を記録する
+// 更新者はリクエストのログインユーザとする(スケジューラ等による更新の場合は空)
+// UpdateAll/DeleteAllはフックが実行されないため、履歴が必要な処理では1件ずつ更新すること
+func init() {
+	models.AddTodoHook(boil.AfterInsertHook, func(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo) error {
+		return recordTodoChange(ctx, exec, todo, todoRevisionActionCreate, diffTodo(nil, todo))
+	})
+	models.AddTodoHook(boil.BeforeUpdateHook, func(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo) error {
+		before, err := models.Todos(qm.WithDeleted(), qm.Where("id = ?", todo.ID)).One(ctx, exec)
+		if err != nil {
+			return err
+		}
+		return recordTodoChange(ctx, exec, todo, todoRevisionActionUpdate, diffTodo(before, todo))
+	})
+	models.AddTodoHook(boil.AfterDeleteHook, func(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo) error {
+		// NOTE: 完全に削除された場合は履歴もまとめて削除されるため、論理削除の場合のみ記録する
+		if !todo.DeletedAt.Valid {
+			return nil
+		}
+		return recordTodoChange(ctx, exec, todo, todoRevisionActionDelete, []*model.TodoFieldChange{
+			{Field: models.TodoColumns.DeletedAt, NewValue: formatNullTime(todo.DeletedAt, dateTimeLayout)},
+		})
+	})
+}
+
+func (ts *todoService) FetchTodoRevisions(ctx context.Context, todoID int) ([]*models.TodoRevision, error) {
+	revisions, err := models.TodoRevisions(
+		qm.Where("todo_id = ?", todoID),
+		qm.OrderBy("id DESC"),
+	).All(ctx, ts.db)
+	if err != nil {
+		return models.TodoRevisionSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return revisions, nil
+}
+
+// NOTE: 指定した履歴時点の内容(タイトル、内容、期日、繰り返し、優先度、見積もり、プロジェクト)にTodoを戻す
+// 完了・アーカイブ・削除の状態はそれぞれの操作で戻す想定のため対象外とする
+func (ts *todoService) RevertTodo(ctx context.Context, id int, revisionID int, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	revisions, err := models.TodoRevisions(
+		qm.Where("todo_id = ? AND id <= ?", todo.ID, revisionID),
+		qm.OrderBy("id ASC"),
+	).All(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if len(revisions) == 0 || revisions[len(revisions)-1].ID != revisionID {
+		return &models.Todo{}, view.NewNotFoundView(fmt.Errorf("revision not found"))
+	}
+
+	// NOTE: 作成時から指定の履歴までの差分を順に適用して、その時点の内容を復元する
+	state := map[string]*string{}
+	for _, revision := range revisions {
+		changes, err := TodoRevisionChanges(revision)
+		if err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+		for _, change := range changes {
+			state[change.Field] = change.NewValue
+		}
+	}
+
+	if title := state[models.TodoColumns.Title]; title != nil {
+		todo.Title = *title
+	}
+	todo.Content = null.StringFromPtr(state[models.TodoColumns.Content])
+	todo.DueDate = dueDateFromInput(state[models.TodoColumns.DueDate])
+	todo.RecurrenceRule = null.StringFromPtr(state[models.TodoColumns.RecurrenceRule])
+	todo.Priority = 0
+	if priority := state[models.TodoColumns.Priority]; priority != nil {
+		todo.Priority = todoPriorityValue(model.TodoPriority(*priority))
+	}
+	todo.EstimateMinutes = null.Int{}
+	if estimateMinutes := state[models.TodoColumns.EstimateMinutes]; estimateMinutes != nil {
+		intEstimateMinutes, _ := strconv.Atoi(*estimateMinutes)
+		todo.EstimateMinutes = null.IntFrom(intEstimateMinutes)
+	}
+	// NOTE: 履歴時点のプロジェクトが削除されている場合は、別のプロジェクトへ移動させないよう戻さずにエラーとする
+	todo.ProjectID, err = todoProjectID(ctx, tx, state[models.TodoColumns.ProjectID], userID)
+	var viewError view.ViewError
+	if errors.As(err, &viewError) && viewError.Code == http.StatusNotFound {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("戻す時点のプロジェクトが削除されているため、元に戻せません。"))
+	}
+	if err != nil {
+		return &models.Todo{}, err
+	}
+	// NOTE: 編集中の他の画面からの更新と競合させるため、versionを進める
+	todo.Version++
+
+	if _, err := todo.Update(context.WithValue(ctx, revisionActionKey{}, todoRevisionActionRevert), tx, boil.Infer()); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return todo, nil
+}
+
+// TodoRevisionChanges 履歴に保存された差分を取り出す
+func TodoRevisionChanges(revision *models.TodoRevision) ([]*model.TodoFieldChange, error) {
+	changes := []*model.TodoFieldChange{}
+	if err := revision.Changes.Unmarshal(&changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// NOTE: Todoの変更履歴を記録し、Webhookへの配信予約とアクティビティの記録を行う(購読者への配信はコミット後)
+func recordTodoChange(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, action string, changes []*model.TodoFieldChange) error {
+	// NOTE: 並び順のみの変更等、履歴に残らない変更も購読者へは配信する
+	collectTodoChange(ctx, todo, action, changes)
+	if len(changes) == 0 {
+		return nil
+	}
+	if err := insertTodoRevision(ctx, exec, todo.ID, action, changes); err != nil {
+		return err
+	}
+	event := todoWebhookEvent(action, changes)
+	if err := enqueueTodoWebhooks(ctx, exec, todo, event); err != nil {
+		return err
+	}
+	return insertTodoActivity(ctx, exec, todo, event)
+}
+
+func insertTodoRevision(ctx context.Context, exec boil.ContextExecutor, todoID int, action string, changes []*model.TodoFieldChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	if override, ok := ctx.Value(revisionActionKey{}).(string); ok {
+		action = override
+	}
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	revision := &models.TodoRevision{TodoID: todoID, Action: action, Changes: types.JSON(encoded)}
+	if user := auth.GetUser(ctx); user != nil {
+		revision.ActorID = null.Int{Int: user.ID, Valid: true}
+	}
+	return revision.Insert(ctx, exec, boil.Infer())
+}
+
+// NOTE: 変更のあった項目の差分を返す(beforeがnilの場合は作成時として値のある項目を返す)
+func diffTodo(before *models.Todo, after *models.Todo) []*model.TodoFieldChange {
+	changes := []*model.TodoFieldChange{}
+	for _, field := range todoRevisionFields {
+		newValue := field.value(after)
+		var oldValue *string
+		if before != nil {
+			oldValue = field.value(before)
+		}
+		if equalStringPtr(oldValue, newValue) {
+			continue
+		}
+		changes = append(changes, &model.TodoFieldChange{Field: field.name, OldValue: oldValue, NewValue: newValue})
+	}
+	return changes
+}
+
+func formatNullTime(t null.Time, layout string) *string {
+	if !t.Valid {
+		return nil
+	}
+
+	formatted := t.Time.Format(layout)
+	return &formatted
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
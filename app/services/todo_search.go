@@ -0,0 +1,134 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/highlight"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+const (
+	todoSearchMatch       = "MATCH(title, content) AGAINST (? IN BOOLEAN MODE)"
+	todoSearchCursorField = "RELEVANCE"
+	todoSnippetWidth      = 30
+)
+
+// NOTE: 検索語として扱わない、BOOLEAN MODEの演算子
+const booleanModeOperators = `+-<>()~*"@`
+
+type todoSearchRow struct {
+	models.Todo `boil:",bind"`
+	Score       float64 `boil:"score"`
+}
+
+// NOTE: タイトルと内容を全文検索し、関連度の高い順に取得する
+// 全文検索インデックスはngramパーサーを使用しているため、日本語も単語の区切りなしで検索できる
+func (ts *todoService) SearchTodos(ctx context.Context, userID int, query string, first *int, after *string) (*model.TodoSearchConnection, error) {
+	// NOTE: バリデーションチェック
+	if err := validator.ValidateSearchTodos(query); err != nil {
+		return &model.TodoSearchConnection{}, view.NewBadRequestView(err)
+	}
+
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return &model.TodoSearchConnection{Edges: []*model.TodoSearchEdge{}, PageInfo: &model.PageInfo{}}, nil
+	}
+	against := booleanModeQuery(terms)
+
+	conditions := []string{"user_id = ?", "deleted_at IS NULL", todoSearchMatch}
+	args := []interface{}{userID, against}
+
+	var totalCount int
+	countQuery := "SELECT COUNT(*) FROM todos WHERE " + strings.Join(conditions, " AND ")
+	if err := queries.Raw(countQuery, args...).QueryRowContext(ctx, ts.db).Scan(&totalCount); err != nil {
+		return &model.TodoSearchConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	// NOTE: 関連度が同じ場合はIDの降順に並べ、(関連度, ID)によるキーセットページネーションとする
+	if after != nil {
+		cursor, err := decodeKeysetCursor(*after, todoSearchCursorField)
+		if err != nil {
+			return &model.TodoSearchConnection{}, view.NewBadRequestView(err)
+		}
+		score, err := strconv.ParseFloat(cursor.Key, 64)
+		if err != nil {
+			return &model.TodoSearchConnection{}, view.NewBadRequestView(fmt.Errorf("不正なカーソルです。"))
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s < ? OR (%s = ? AND id < ?))", todoSearchMatch, todoSearchMatch))
+		args = append(args, against, score, against, score, cursor.ID)
+	}
+
+	// NOTE: 次のページの有無を判定するため1件多く取得する
+	limit := pageSize(first)
+	rows := []*todoSearchRow{}
+	searchQuery := fmt.Sprintf(
+		"SELECT todos.*, %s AS score FROM todos WHERE %s ORDER BY score DESC, id DESC LIMIT ?",
+		todoSearchMatch, strings.Join(conditions, " AND "),
+	)
+	if err := queries.Raw(searchQuery, append([]interface{}{against}, append(args, limit+1)...)...).Bind(ctx, ts.db, &rows); err != nil {
+		return &model.TodoSearchConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	edges := make([]*model.TodoSearchEdge, 0, len(rows))
+	for _, row := range rows {
+		todo := row.Todo
+		cursor := keysetCursor{Field: todoSearchCursorField, Key: strconv.FormatFloat(row.Score, 'g', -1, 64), ID: todo.ID}
+		edge := &model.TodoSearchEdge{
+			Cursor:         encodeKeysetCursor(cursor),
+			Node:           &todo,
+			Score:          row.Score,
+			TitleHighlight: highlight.Highlight(todo.Title, terms),
+		}
+		if todo.Content.Valid {
+			snippet := highlight.Snippet(todo.Content.String, terms, todoSnippetWidth)
+			edge.ContentSnippet = &snippet
+		}
+		edges = append(edges, edge)
+	}
+	pageInfo := &model.PageInfo{HasNextPage: hasMore, HasPreviousPage: after != nil}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.TodoSearchConnection{Edges: edges, PageInfo: pageInfo, TotalCount: totalCount}, nil
+}
+
+// NOTE: 検索クエリを空白(全角空白を含む)で区切り、演算子を取り除いた検索語を返す
+func searchTerms(query string) []string {
+	terms := []string{}
+	for _, field := range strings.FieldsFunc(query, unicode.IsSpace) {
+		term := strings.Map(func(r rune) rune {
+			if strings.ContainsRune(booleanModeOperators, r) {
+				return -1
+			}
+			return r
+		}, field)
+		if term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// NOTE: すべての検索語を含むTodoに一致するよう、各検索語を必須のフレーズとして指定する
+func booleanModeQuery(terms []string) string {
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		phrases = append(phrases, `+"`+term+`"`)
+	}
+	return strings.Join(phrases, " ")
+}
@@ -2,11 +2,15 @@ package services
 
 import (
 	"app/graph/model"
+	"app/lib/recurrence"
+	"app/lib/storage"
 	models "app/models/generated"
 	"app/view"
 	"context"
 	"database/sql"
+	"fmt"
 	"strconv"
+	"time"
 
 	"app/validator"
 
@@ -18,42 +22,107 @@ import (
 
 type TodoService interface {
 	CreateTodo(ctx context.Context, requestParams model.CreateTodoInput, userID int) (*models.Todo, error)
-	FetchTodoLists(ctx context.Context, userID int) ([]*models.Todo, error)
+	FetchTodoLists(ctx context.Context, userID int, includeArchived bool) ([]*models.Todo, error)
+	FetchTodos(ctx context.Context, userID int, first *int, after *string, last *int, before *string, filter *model.TodoFilter, orderBy *model.TodoOrder, includeArchived bool) (*model.TodoConnection, error)
+	SearchTodos(ctx context.Context, userID int, query string, first *int, after *string) (*model.TodoSearchConnection, error)
+	FetchTodoTags(ctx context.Context, todoID int) ([]string, error)
 	FetchTodo(ctx context.Context, id int, userID int) (*models.Todo, error)
 	UpdateTodo(ctx context.Context, id int, requestParams model.UpdateTodoInput, userID int) (*models.Todo, error)
 	DeleteTodo(ctx context.Context, id int, userID int) (string, error)
+	CompleteTodo(ctx context.Context, id int, force bool, userID int) (*model.CompleteTodoPayload, error)
+	PreviewOccurrences(ctx context.Context, id int, count *int, userID int) ([]string, error)
+	MoveTodo(ctx context.Context, id int, afterID *int, beforeID *int, userID int) (*models.Todo, error)
+	FetchTrashedTodos(ctx context.Context, userID int) ([]*models.Todo, error)
+	RestoreTodo(ctx context.Context, id int, userID int) (*models.Todo, error)
+	EmptyTrash(ctx context.Context, userID int) (int, error)
+	PurgeTrashedTodos(ctx context.Context, deletedBefore time.Time) (int, error)
+	ArchiveTodo(ctx context.Context, id int, userID int) (*models.Todo, error)
+	UnarchiveTodo(ctx context.Context, id int, userID int) (*models.Todo, error)
+	ArchiveCompletedTodos(ctx context.Context, userID int) (int, error)
+	FetchTodoRevisions(ctx context.Context, todoID int) ([]*models.TodoRevision, error)
+	RevertTodo(ctx context.Context, id int, revisionID int, userID int) (*models.Todo, error)
+	BulkCreateTodos(ctx context.Context, inputs []*model.CreateTodoInput, userID int) (*model.BulkTodoPayload, error)
+	BulkUpdateTodos(ctx context.Context, ids []int, patch model.BulkTodoPatch, userID int) (*model.BulkTodoPayload, error)
+	BulkDeleteTodos(ctx context.Context, ids []int, userID int) (*model.BulkTodoPayload, error)
+	BulkCompleteTodos(ctx context.Context, ids []int, force bool, userID int) (*model.BulkTodoPayload, error)
+	AddTodoDependency(ctx context.Context, todoID int, blockerID int, userID int) (*models.Todo, error)
+	RemoveTodoDependency(ctx context.Context, todoID int, blockerID int, userID int) (*models.Todo, error)
+	FetchBlockers(ctx context.Context, todoID int) ([]*models.Todo, error)
+	FetchBlockedTodos(ctx context.Context, todoID int) ([]*models.Todo, error)
+	FetchWorkload(ctx context.Context, userID int, from string, to string) ([]*model.WorkloadDay, error)
+	SubscribeTodoChanges(ctx context.Context, userID int, projectID *int) (<-chan *model.TodoChangeEvent, error)
+	ShareTodo(ctx context.Context, id int, email string, userID int) (*models.Todo, error)
+	UnshareTodo(ctx context.Context, id int, collaboratorID int, userID int) (*models.Todo, error)
+	FetchSharedTodos(ctx context.Context, userID int) ([]*models.Todo, error)
+	FetchCollaborators(ctx context.Context, todoID int) ([]*models.User, error)
+	AssignTodo(ctx context.Context, id int, assigneeID *int, userID int) (*models.Todo, error)
 }
 
 type todoService struct {
-	db *sql.DB
+	db      *sql.DB
+	broker  *TodoChangeBroker
+	storage storage.Storage
 }
 
-func NewTodoService(db *sql.DB) TodoService {
-	return &todoService{db}
+func NewTodoService(db *sql.DB, broker *TodoChangeBroker, blobStorage storage.Storage) TodoService {
+	return &todoService{db, broker, blobStorage}
 }
 
 func (ts *todoService) CreateTodo(ctx context.Context, requestParams model.CreateTodoInput, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
 	// NOTE: バリデーションチェック
 	validationErrors := validator.ValidateCreateTodo(requestParams)
 	if validationErrors != nil {
 		return &models.Todo{}, view.NewBadRequestView(validationErrors)
 	}
 
-	todo := &models.Todo{}
-	todo.Title = requestParams.Title
-	todo.Content = null.String{String: requestParams.Content, Valid: true}
-	todo.UserID = userID
+	todo := newTodoFromInput(requestParams, userID)
 
-	// NOTE: Create処理
-	err := todo.Insert(ctx, ts.db, boil.Infer())
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo.ProjectID, err = todoProjectID(ctx, tx, requestParams.ProjectID, userID)
 	if err != nil {
+		return &models.Todo{}, err
+	}
+	// NOTE: 新規作成したTodoは末尾に並べる
+	todo.Position, err = appendTodoPosition(ctx, tx, userID)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	// NOTE: Create処理
+	if err := todo.Insert(ctx, tx, boil.Infer()); err != nil {
 		return &models.Todo{}, view.NewInternalServerErrorView(err)
 	}
+	// NOTE: リマインド日時が指定されている場合はリマインダーも登録する
+	if requestParams.RemindAt != nil {
+		if err := newTodoReminder(todo, *requestParams.RemindAt).Insert(ctx, tx, boil.Infer()); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+	}
+	if _, err := newTodoTags(todo.ID, requestParams.Tags).InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
 	return todo, nil
 }
 
-func (ts *todoService) FetchTodoLists(ctx context.Context, userID int) ([]*models.Todo, error) {
-	todos, err := models.Todos(qm.Where("user_id = ?", userID)).All(ctx, ts.db)
+func (ts *todoService) FetchTodoLists(ctx context.Context, userID int, includeArchived bool) ([]*models.Todo, error) {
+	mods := []qm.QueryMod{qm.Where("user_id = ?", userID), qm.OrderBy("position ASC, id ASC")}
+	// NOTE: アーカイブ済みのTodoは指定がない限り一覧に含めない
+	if !includeArchived {
+		mods = append(mods, qm.Where("archived_at IS NULL"))
+	}
+
+	todos, err := models.Todos(mods...).All(ctx, ts.db)
 	if err != nil {
 		return models.TodoSlice{}, view.NewNotFoundView(err)
 	}
@@ -61,8 +130,9 @@ func (ts *todoService) FetchTodoLists(ctx context.Context, userID int) ([]*model
 	return todos, nil
 }
 
+// NOTE: 共有されたTodoも取得できる
 func (ts *todoService) FetchTodo(ctx context.Context, id int, userID int) (*models.Todo, error) {
-	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ts.db)
+	todo, err := models.Todos(qm.Where("id = ?", id), visibleTodoMod(userID)).One(ctx, ts.db)
 	if err != nil {
 		return &models.Todo{}, view.NewNotFoundView(err)
 	}
@@ -71,7 +141,14 @@ func (ts *todoService) FetchTodo(ctx context.Context, id int, userID int) (*mode
 }
 
 func (ts *todoService) UpdateTodo(ctx context.Context, id int, requestParams model.UpdateTodoInput, userID int) (*models.Todo, error) {
-	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ts.db)
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
 	if err != nil {
 		return &models.Todo{}, view.NewNotFoundView(err)
 	}
@@ -82,26 +159,274 @@ func (ts *todoService) UpdateTodo(ctx context.Context, id int, requestParams mod
 		return &models.Todo{}, view.NewBadRequestView(validationErrors)
 	}
 
-	todo.Title = requestParams.Title
-	todo.Content = null.String{String: requestParams.Content, Valid: true}
+	before := *todo
+	if title, ok := requestParams.Title.ValueOK(); ok {
+		todo.Title = *title
+	}
+	// NOTE: nullが明示された場合は値をクリアする
+	if content, ok := requestParams.Content.ValueOK(); ok {
+		todo.Content = null.StringFromPtr(content)
+	}
+	if dueDate, ok := requestParams.DueDate.ValueOK(); ok {
+		todo.DueDate = dueDateFromInput(dueDate)
+	}
+	if recurrence, ok := requestParams.Recurrence.ValueOK(); ok {
+		todo.RecurrenceRule = recurrenceRuleFromInput(recurrence)
+	}
+	if priority, ok := requestParams.Priority.ValueOK(); ok {
+		todo.Priority = 0
+		if priority != nil {
+			todo.Priority = todoPriorityValue(*priority)
+		}
+	}
+	if estimateMinutes, ok := requestParams.EstimateMinutes.ValueOK(); ok {
+		todo.EstimateMinutes = null.IntFromPtr(estimateMinutes)
+	}
+	if projectID, ok := requestParams.ProjectID.ValueOK(); ok {
+		todo.ProjectID, err = todoProjectID(ctx, tx, projectID, userID)
+		if err != nil {
+			return &models.Todo{}, err
+		}
+	}
+
+	if todo.Version != requestParams.ExpectedVersion {
+		return &models.Todo{}, newTodoConflictView(&before)
+	}
+	// NOTE: 変更のあった項目のみ更新する
+	changes := diffTodo(&before, todo)
+	if len(changes) > 0 {
+		todo.Version = requestParams.ExpectedVersion + 1
+		todo.UpdatedAt = time.Now()
+
+		columns := models.M{
+			models.TodoColumns.Version:   todo.Version,
+			models.TodoColumns.UpdatedAt: todo.UpdatedAt,
+		}
+		for _, change := range changes {
+			columns[change.Field] = todoColumnValue(todo, change.Field)
+		}
+
+		// NOTE: Update処理(楽観的排他制御のため、取得時のversionから変わっていない場合のみ更新する)
+		rowsAff, err := models.Todos(qm.Where("id = ? AND version = ?", todo.ID, requestParams.ExpectedVersion)).UpdateAll(ctx, tx, columns)
+		if err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+		if rowsAff == 0 {
+			return &models.Todo{}, newTodoConflictView(&before)
+		}
+		// NOTE: UpdateAllではフックが実行されないため、変更履歴は個別に記録する
+		if err := recordTodoChange(ctx, tx, todo, todoRevisionActionUpdate, changes); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+	}
+	// NOTE: タグは指定された場合のみ置き換える(nullが明示された場合は全て外す)
+	if tags, ok := requestParams.Tags.ValueOK(); ok {
+		if err := replaceTodoTags(ctx, tx, todo.ID, tags); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+	}
 
-	// NOTE: Update処理
-	_, updateError := todo.Update(ctx, ts.db, boil.Infer())
-	if updateError != nil {
-		return &models.Todo{}, view.NewInternalServerErrorView(updateError)
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
 	}
+	publishTodoChanges(ts.broker, collected)
 	return todo, nil
 }
 
 func (ts *todoService) DeleteTodo(ctx context.Context, id int, userID int) (string, error) {
-	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ts.db)
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
 	if err != nil {
 		return strconv.Itoa(id), view.NewNotFoundView(err)
 	}
 
-	_, deleteError := todo.Delete(ctx, ts.db)
+	// NOTE: 論理削除(ゴミ箱へ移動)
+	_, deleteError := todo.Delete(ctx, tx, false)
 	if deleteError != nil {
 		return strconv.Itoa(id), view.NewInternalServerErrorView(deleteError)
 	}
+
+	if err := tx.Commit(); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
 	return strconv.Itoa(id), nil
 }
+
+// NOTE: 未完了の先行Todoがある場合は完了できない(forceを指定した場合は完了する)
+func (ts *todoService) CompleteTodo(ctx context.Context, id int, force bool, userID int) (*model.CompleteTodoPayload, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &model.CompleteTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	// NOTE: 同時に完了された場合に次回分が重複して生成されないよう行ロックを取得する
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &model.CompleteTodoPayload{}, view.NewNotFoundView(err)
+	}
+	if todo.CompletedAt.Valid {
+		return &model.CompleteTodoPayload{}, view.NewBadRequestView(fmt.Errorf("既に完了済みのTodoです。"))
+	}
+	if !force {
+		blockerIDs, err := openBlockerIDs(ctx, tx, []int{todo.ID})
+		if err != nil {
+			return &model.CompleteTodoPayload{}, view.NewInternalServerErrorView(err)
+		}
+		if len(blockerIDs[todo.ID]) > 0 {
+			return &model.CompleteTodoPayload{}, view.NewBadRequestView(fmt.Errorf("未完了の先行Todoがあるため完了できません。"))
+		}
+	}
+
+	nextOccurrence, err := completeTodo(ctx, tx, todo, time.Now())
+	if err != nil {
+		return &model.CompleteTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &model.CompleteTodoPayload{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return &model.CompleteTodoPayload{Todo: todo, NextOccurrence: nextOccurrence}, nil
+}
+
+func (ts *todoService) PreviewOccurrences(ctx context.Context, id int, count *int, userID int) ([]string, error) {
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ts.db)
+	if err != nil {
+		return []string{}, view.NewNotFoundView(err)
+	}
+	if !todo.RecurrenceRule.Valid {
+		return []string{}, nil
+	}
+
+	rule, err := recurrence.Parse(todo.RecurrenceRule.String)
+	if err != nil {
+		return []string{}, view.NewInternalServerErrorView(err)
+	}
+
+	occurrences := []string{}
+	for _, occurrence := range rule.Occurrences(recurrenceBase(todo, rule, time.Now()), occurrenceCount(count)) {
+		occurrences = append(occurrences, occurrence.Format(dueDateLayout))
+	}
+	return occurrences, nil
+}
+
+// NOTE: 作成時の入力値からTodoを生成する(バリデーション済みの前提)
+func newTodoFromInput(input model.CreateTodoInput, userID int) *models.Todo {
+	todo := &models.Todo{}
+	todo.Title = input.Title
+	todo.Content = null.String{String: input.Content, Valid: true}
+	todo.DueDate = dueDateFromInput(input.DueDate)
+	todo.RecurrenceRule = recurrenceRuleFromInput(input.Recurrence)
+	if input.Priority != nil {
+		todo.Priority = todoPriorityValue(*input.Priority)
+	}
+	todo.EstimateMinutes = null.IntFromPtr(input.EstimateMinutes)
+	todo.UserID = userID
+	return todo
+}
+
+// NOTE: Todoを完了し、繰り返し設定がある場合は次回分のTodoを生成して返す
+func completeTodo(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, completedAt time.Time) (*models.Todo, error) {
+	todo.CompletedAt = null.Time{Time: completedAt, Valid: true}
+	// NOTE: 編集中の他の画面からの更新と競合させるため、versionを進める
+	todo.Version++
+	if _, err := todo.Update(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	nextOccurrence, err := newNextOccurrence(todo, completedAt)
+	if err != nil || nextOccurrence == nil {
+		return nil, err
+	}
+	nextOccurrence.Position, err = appendTodoPosition(ctx, exec, todo.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := nextOccurrence.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+	return nextOccurrence, nil
+}
+
+// NOTE: 完了したTodoの次回分を生成する(繰り返し設定がない場合はnil)
+func newNextOccurrence(todo *models.Todo, completedAt time.Time) (*models.Todo, error) {
+	if !todo.RecurrenceRule.Valid {
+		return nil, nil
+	}
+	rule, err := recurrence.Parse(todo.RecurrenceRule.String)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: 日付の指定がない毎月の繰り返しは、次回以降も同じ日付になるよう起点の日付をルールに保存する
+	base := recurrenceBase(todo, rule, completedAt)
+	rule = rule.AnchoredTo(base)
+
+	nextOccurrence := &models.Todo{
+		UserID:         todo.UserID,
+		Title:          todo.Title,
+		Content:        todo.Content,
+		DueDate:        null.Time{Time: rule.Next(base), Valid: true},
+		RecurrenceRule: null.StringFrom(rule.String()),
+		// NOTE: 繰り返しの起点となったTodoを親として辿れるようにする
+		RecurrenceParentID: null.Int{Int: todo.ID, Valid: true},
+	}
+	if todo.RecurrenceParentID.Valid {
+		nextOccurrence.RecurrenceParentID = todo.RecurrenceParentID
+	}
+	return nextOccurrence, nil
+}
+
+// NOTE: 次回発生日の計算起点を決定する
+// 完了起点の繰り返し、または期日未設定の場合は完了日を起点とする
+func recurrenceBase(todo *models.Todo, rule recurrence.Rule, completedAt time.Time) time.Time {
+	if rule.Frequency == recurrence.AfterCompletion || !todo.DueDate.Valid {
+		return completedAt
+	}
+	return todo.DueDate.Time
+}
+
+// NOTE: 部分更新で変更された項目の値を返す
+func todoColumnValue(todo *models.Todo, column string) interface{} {
+	switch column {
+	case models.TodoColumns.Title:
+		return todo.Title
+	case models.TodoColumns.Content:
+		return todo.Content
+	case models.TodoColumns.DueDate:
+		return todo.DueDate
+	case models.TodoColumns.RecurrenceRule:
+		return todo.RecurrenceRule
+	case models.TodoColumns.Priority:
+		return todo.Priority
+	case models.TodoColumns.EstimateMinutes:
+		return todo.EstimateMinutes
+	case models.TodoColumns.ProjectID:
+		return todo.ProjectID
+	}
+	return nil
+}
+
+// NOTE: 他の更新と競合した場合に、クライアントが差分を確認できるようサーバ側の最新の状態を返す
+func newTodoConflictView(todo *models.Todo) view.ViewError {
+	recurrence, _ := RecurrenceFromRule(todo.RecurrenceRule)
+	current := map[string]interface{}{
+		"id":         strconv.Itoa(todo.ID),
+		"title":      todo.Title,
+		"content":    todo.Content.String,
+		"dueDate":    formatNullTime(todo.DueDate, dueDateLayout),
+		"recurrence": recurrence,
+		"version":    todo.Version,
+		"updatedAt":  todo.UpdatedAt.Format(dateTimeLayout),
+	}
+	return view.NewConflictView(fmt.Errorf("他の画面で更新されています。最新の内容を確認してください。"), current)
+}
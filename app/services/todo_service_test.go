@@ -2,11 +2,18 @@ package services
 
 import (
 	"app/graph/model"
+	"app/lib/storage"
 	models "app/models/generated"
 	"app/test/factories"
+	"app/view"
+	"context"
+	"net/http"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/volatiletech/null/v8"
@@ -32,7 +39,7 @@ func (s *TestTodoServiceSuite) SetupTest() {
 		s.T().Fatalf("failed to create test user %v", err)
 	}
 
-	testTodoService = NewTodoService(DBCon)
+	testTodoService = newTestTodoService(s.T())
 }
 
 func (s *TestTodoServiceSuite) TearDownTest() {
@@ -84,7 +91,7 @@ func (s *TestTodoServiceSuite) TestFetchTodoLists() {
 		s.T().Fatalf("failed to create TestFetchTodoLists Data: %v", err)
 	}
 
-	todos, err := testTodoService.FetchTodoLists(ctx, user.ID)
+	todos, err := testTodoService.FetchTodoLists(ctx, user.ID, false)
 
 	assert.Nil(s.T(), err)
 	assert.Len(s.T(), todos, 2)
@@ -109,7 +116,7 @@ func (s *TestTodoServiceSuite) TestUpdateTodo() {
 		s.T().Fatalf("failed to create test todos %v", err)
 	}
 
-	requestParams := model.UpdateTodoInput{Title: "test updated title 1", Content: "test updated content 1"}
+	requestParams := model.UpdateTodoInput{Title: omittableString("test updated title 1"), Content: omittableString("test updated content 1"), ExpectedVersion: testTodo.Version}
 	todo, err := testTodoService.UpdateTodo(ctx, testTodo.ID, requestParams, user.ID)
 
 	assert.Nil(s.T(), err)
@@ -123,13 +130,91 @@ func (s *TestTodoServiceSuite) TestUpdateTodo() {
 	assert.Equal(s.T(), null.String{String: "test updated content 1", Valid: true}, testTodo.Content)
 }
 
+func (s *TestTodoServiceSuite) TestUpdateTodo_Partial() {
+	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, DueDate: null.TimeFrom(time.Date(2024, 11, 1, 0, 0, 0, 0, time.Local)), UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: タイトルのみ指定し、期日はnullを明示してクリアする
+	requestParams := model.UpdateTodoInput{Title: omittableString("test updated title 1"), DueDate: graphql.OmittableOf[*string](nil), ExpectedVersion: testTodo.Version}
+	todo, err := testTodoService.UpdateTodo(ctx, testTodo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "test updated title 1", todo.Title)
+	if err := testTodo.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload test todos %v", err)
+	}
+	assert.Equal(s.T(), "test updated title 1", testTodo.Title)
+	// NOTE: 指定されていない内容は変更されないことの確認
+	assert.Equal(s.T(), null.String{String: "test content 1", Valid: true}, testTodo.Content)
+	assert.False(s.T(), testTodo.DueDate.Valid)
+	assert.Equal(s.T(), 2, testTodo.Version)
+}
+
+func (s *TestTodoServiceSuite) TestUpdateTodo_ClearContent() {
+	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	requestParams := model.UpdateTodoInput{Content: graphql.OmittableOf[*string](nil), ExpectedVersion: testTodo.Version}
+	_, err := testTodoService.UpdateTodo(ctx, testTodo.ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	if err := testTodo.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload test todos %v", err)
+	}
+	assert.Equal(s.T(), "test title 1", testTodo.Title)
+	assert.False(s.T(), testTodo.Content.Valid)
+}
+
+func (s *TestTodoServiceSuite) TestUpdateTodo_NullTitle() {
+	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: タイトルはnullでのクリアを許可しない
+	requestParams := model.UpdateTodoInput{Title: graphql.OmittableOf[*string](nil), ExpectedVersion: testTodo.Version}
+	_, err := testTodoService.UpdateTodo(ctx, testTodo.ID, requestParams, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestUpdateTodo_Conflict() {
+	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	// NOTE: 別の画面で先に更新された状態にする
+	requestParams := model.UpdateTodoInput{Title: omittableString("updated in other tab"), Content: omittableString("test content 1"), ExpectedVersion: testTodo.Version}
+	if _, err := testTodoService.UpdateTodo(ctx, testTodo.ID, requestParams, user.ID); err != nil {
+		s.T().Fatalf("failed to update test todos %v", err)
+	}
+
+	requestParams = model.UpdateTodoInput{Title: omittableString("test updated title 1"), Content: omittableString("test updated content 1"), ExpectedVersion: testTodo.Version}
+	_, err := testTodoService.UpdateTodo(ctx, testTodo.ID, requestParams, user.ID)
+
+	var viewError view.ViewError
+	assert.ErrorAs(s.T(), err, &viewError)
+	assert.Equal(s.T(), int64(http.StatusConflict), viewError.Code)
+	assert.Equal(s.T(), "updated in other tab", viewError.Current.(map[string]interface{})["title"])
+	// NOTE: 後からの更新で上書きされていないことの確認
+	if err := testTodo.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload test todos %v", err)
+	}
+	assert.Equal(s.T(), "updated in other tab", testTodo.Title)
+	assert.Equal(s.T(), 2, testTodo.Version)
+}
+
 func (s *TestTodoServiceSuite) TestUpdateTodo_ValidationError() {
 	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
 	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
 		s.T().Fatalf("failed to create test todos %v", err)
 	}
 
-	requestParams := model.UpdateTodoInput{Title: "", Content: "test updated content 1"}
+	requestParams := model.UpdateTodoInput{Title: omittableString(""), Content: omittableString("test updated content 1"), ExpectedVersion: testTodo.Version}
 	todo, err := testTodoService.UpdateTodo(ctx, testTodo.ID, requestParams, user.ID)
 
 	assert.NotNil(s.T(), err)
@@ -157,6 +242,1065 @@ func (s *TestTodoServiceSuite) TestDeleteTodo() {
 	assert.NotNil(s.T(), reloadErr)
 }
 
+func (s *TestTodoServiceSuite) TestCreateTodo_WithRecurrence() {
+	dueDate := "2024-11-04"
+	requestParams := model.CreateTodoInput{
+		Title:      "test title 1",
+		Content:    "test content 1",
+		DueDate:    &dueDate,
+		Recurrence: &model.RecurrenceInput{Frequency: model.RecurrenceFrequencyWeekly, Weekdays: []model.Weekday{model.WeekdayThursday, model.WeekdayMonday}},
+	}
+
+	todo, err := testTodoService.CreateTodo(ctx, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), null.String{String: "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,TH", Valid: true}, todo.RecurrenceRule)
+	assert.Equal(s.T(), "2024-11-04", todo.DueDate.Time.Format("2006-01-02"))
+}
+
+func (s *TestTodoServiceSuite) TestCreateTodo_InvalidRecurrence() {
+	monthDay := 15
+	requestParams := model.CreateTodoInput{
+		Title:      "test title 1",
+		Content:    "test content 1",
+		Recurrence: &model.RecurrenceInput{Frequency: model.RecurrenceFrequencyDaily, MonthDay: &monthDay},
+	}
+
+	_, err := testTodoService.CreateTodo(ctx, requestParams, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo() {
+	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	payload, err := testTodoService.CompleteTodo(ctx, testTodo.ID, false, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), payload.Todo.CompletedAt.Valid)
+	assert.Nil(s.T(), payload.NextOccurrence)
+	// NOTE: 繰り返し設定がないため次回分が生成されていないことの確認
+	count, _ := models.Todos(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo_GeneratesNextOccurrence() {
+	testTodo := models.Todo{
+		Title:          "test title 1",
+		Content:        null.String{String: "test content 1", Valid: true},
+		UserID:         user.ID,
+		DueDate:        null.Time{Time: time.Date(2025, 1, 31, 0, 0, 0, 0, time.Local), Valid: true},
+		RecurrenceRule: null.String{String: "FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=31", Valid: true},
+	}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	payload, err := testTodoService.CompleteTodo(ctx, testTodo.ID, false, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.NotNil(s.T(), payload.NextOccurrence)
+	// NOTE: 次回分が期日を進めて生成されていることの確認
+	nextOccurrence, err := models.FindTodo(ctx, DBCon, payload.NextOccurrence.ID)
+	if err != nil {
+		s.T().Fatalf("failed to find next occurrence %v", err)
+	}
+	assert.Equal(s.T(), "2025-02-28", nextOccurrence.DueDate.Time.Format("2006-01-02"))
+	assert.Equal(s.T(), testTodo.RecurrenceRule, nextOccurrence.RecurrenceRule)
+	assert.Equal(s.T(), null.Int{Int: testTodo.ID, Valid: true}, nextOccurrence.RecurrenceParentID)
+	assert.False(s.T(), nextOccurrence.CompletedAt.Valid)
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo_MonthlyKeepsDueDay() {
+	testTodo := models.Todo{
+		Title:          "test title 1",
+		UserID:         user.ID,
+		DueDate:        null.Time{Time: time.Date(2025, 1, 31, 0, 0, 0, 0, time.Local), Valid: true},
+		RecurrenceRule: null.String{String: "FREQ=MONTHLY;INTERVAL=1", Valid: true},
+	}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	payload, err := testTodoService.CompleteTodo(ctx, testTodo.ID, false, user.ID)
+	assert.Nil(s.T(), err)
+	next, err := testTodoService.CompleteTodo(ctx, payload.NextOccurrence.ID, false, user.ID)
+
+	// NOTE: 月末に丸めた次回(2/28)からではなく、元の期日の日付(31日)から次々回を計算することの確認
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "2025-02-28", payload.NextOccurrence.DueDate.Time.Format("2006-01-02"))
+	assert.Equal(s.T(), "2025-03-31", next.NextOccurrence.DueDate.Time.Format("2006-01-02"))
+	assert.Equal(s.T(), "FREQ=MONTHLY;INTERVAL=1;BYMONTHDAY=31", next.NextOccurrence.RecurrenceRule.String)
+}
+
+func (s *TestTodoServiceSuite) TestStateChanges_IncrementVersion() {
+	todo := s.createTodos("a")[0]
+
+	for _, change := range []func() error{
+		func() error { _, err := testTodoService.CompleteTodo(ctx, todo.ID, false, user.ID); return err },
+		func() error { _, err := testTodoService.ArchiveTodo(ctx, todo.ID, user.ID); return err },
+		func() error { _, err := testTodoService.UnarchiveTodo(ctx, todo.ID, user.ID); return err },
+		func() error {
+			if _, err := testTodoService.DeleteTodo(ctx, todo.ID, user.ID); err != nil {
+				return err
+			}
+			_, err := testTodoService.RestoreTodo(ctx, todo.ID, user.ID)
+			return err
+		},
+	} {
+		if err := change(); err != nil {
+			s.T().Fatalf("failed to change test todos %v", err)
+		}
+		// NOTE: 変更前のversionを持つ画面からの更新は競合となることの確認
+		_, err := testTodoService.UpdateTodo(ctx, todo.ID, model.UpdateTodoInput{Title: omittableString("b"), ExpectedVersion: todo.Version}, user.ID)
+		assert.NotNil(s.T(), err)
+		if err := todo.Reload(ctx, DBCon); err != nil {
+			s.T().Fatalf("failed to reload test todos %v", err)
+		}
+	}
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo_AfterCompletion() {
+	testTodo := models.Todo{
+		Title:          "test title 1",
+		Content:        null.String{String: "test content 1", Valid: true},
+		UserID:         user.ID,
+		DueDate:        null.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), Valid: true},
+		RecurrenceRule: null.String{String: "FREQ=DAILY;INTERVAL=3;FROM=COMPLETION", Valid: true},
+	}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	payload, err := testTodoService.CompleteTodo(ctx, testTodo.ID, false, user.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 期日ではなく完了日を起点に次回の期日が決まることの確認
+	expected := time.Now().AddDate(0, 0, 3).Format("2006-01-02")
+	assert.Equal(s.T(), expected, payload.NextOccurrence.DueDate.Time.Format("2006-01-02"))
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo_AlreadyCompleted() {
+	testTodo := models.Todo{
+		Title:          "test title 1",
+		Content:        null.String{String: "test content 1", Valid: true},
+		UserID:         user.ID,
+		CompletedAt:    null.Time{Time: time.Now(), Valid: true},
+		RecurrenceRule: null.String{String: "FREQ=DAILY;INTERVAL=1", Valid: true},
+	}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	_, err := testTodoService.CompleteTodo(ctx, testTodo.ID, false, user.ID)
+
+	assert.NotNil(s.T(), err)
+	// NOTE: 次回分が重複して生成されていないことの確認
+	count, _ := models.Todos(qm.Where("user_id = ?", user.ID)).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTodoServiceSuite) TestPreviewOccurrences() {
+	testTodo := models.Todo{
+		Title:          "test title 1",
+		Content:        null.String{String: "test content 1", Valid: true},
+		UserID:         user.ID,
+		DueDate:        null.Time{Time: time.Date(2024, 11, 4, 0, 0, 0, 0, time.Local), Valid: true},
+		RecurrenceRule: null.String{String: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR", Valid: true},
+	}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	count := 3
+	occurrences, err := testTodoService.PreviewOccurrences(ctx, testTodo.ID, &count, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"2024-11-08", "2024-11-18", "2024-11-22"}, occurrences)
+}
+
+func newTestTodoService(t *testing.T) TodoService {
+	return NewTodoService(DBCon, NewTodoChangeBroker(), storage.NewLocalStorage(t.TempDir()))
+}
+
+// NOTE: TodoService以外から配信されたTodoの変更を受け取る
+func receivePublishedTodoChange(t *testing.T, changes <-chan *TodoChange) *TodoChange {
+	select {
+	case change := <-changes:
+		return change
+	case <-time.After(time.Second):
+		t.Fatal("no todo change published")
+		return nil
+	}
+}
+
+func omittableString(value string) graphql.Omittable[*string] {
+	return graphql.OmittableOf(&value)
+}
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func (s *TestTodoServiceSuite) createTodos(titles ...string) []*models.Todo {
+	todos := []*models.Todo{}
+	for _, title := range titles {
+		todo, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: title, Content: ""}, user.ID)
+		if err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+		todos = append(todos, todo)
+	}
+	return todos
+}
+
+func (s *TestTodoServiceSuite) fetchTodoTitles() []string {
+	todos, err := testTodoService.FetchTodoLists(ctx, user.ID, false)
+	if err != nil {
+		s.T().Fatalf("failed to fetch test todos %v", err)
+	}
+	titles := []string{}
+	for _, todo := range todos {
+		titles = append(titles, todo.Title)
+	}
+	return titles
+}
+
+func (s *TestTodoServiceSuite) TestCreateTodo_AppendsToEnd() {
+	todos := s.createTodos("first", "second")
+
+	assert.Less(s.T(), todos[0].Position, todos[1].Position)
+	assert.Equal(s.T(), []string{"first", "second"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo() {
+	todos := s.createTodos("a", "b", "c")
+
+	_, err := testTodoService.MoveTodo(ctx, todos[2].ID, &todos[0].ID, &todos[1].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"a", "c", "b"}, s.fetchTodoTitles())
+	// NOTE: 移動したTodo以外の順位は変わらないことの確認
+	for _, todo := range todos[:2] {
+		position := todo.Position
+		if err := todo.Reload(ctx, DBCon); err != nil {
+			s.T().Fatalf("failed to reload test todos %v", err)
+		}
+		assert.Equal(s.T(), position, todo.Position)
+	}
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_OnlyAfterID() {
+	todos := s.createTodos("a", "b", "c")
+
+	_, err := testTodoService.MoveTodo(ctx, todos[0].ID, &todos[1].ID, nil, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"b", "a", "c"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_OnlyBeforeID() {
+	todos := s.createTodos("a", "b", "c")
+
+	_, err := testTodoService.MoveTodo(ctx, todos[2].ID, nil, &todos[0].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"c", "a", "b"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_DuplicatedPositions() {
+	// NOTE: 順位が重複している場合は振り直してから移動することの確認
+	var todosSlice models.TodoSlice
+	for _, title := range []string{"a", "b", "c"} {
+		todosSlice = append(todosSlice, &models.Todo{Title: title, UserID: user.ID, Position: "i"})
+	}
+	if _, err := todosSlice.InsertAll(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	todos, _ := models.Todos(qm.OrderBy("id ASC")).All(ctx, DBCon)
+
+	_, err := testTodoService.MoveTodo(ctx, todos[2].ID, &todos[0].ID, &todos[1].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"a", "c", "b"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestMoveTodo_InvalidTarget() {
+	todos := s.createTodos("a", "b")
+
+	_, err := testTodoService.MoveTodo(ctx, todos[0].ID, nil, nil, user.ID)
+	assert.NotNil(s.T(), err)
+
+	_, err = testTodoService.MoveTodo(ctx, todos[0].ID, &todos[0].ID, nil, user.ID)
+	assert.NotNil(s.T(), err)
+
+	// NOTE: 前後の指定が逆の場合
+	_, err = testTodoService.MoveTodo(ctx, todos[0].ID, &todos[1].ID, &todos[1].ID, user.ID)
+	assert.NotNil(s.T(), err)
+	assert.Equal(s.T(), []string{"a", "b"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestDeleteTodo_MovesToTrash() {
+	todos := s.createTodos("a", "b")
+
+	_, err := testTodoService.DeleteTodo(ctx, todos[0].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 一覧からは除外され、ゴミ箱に残っていることの確認
+	assert.Equal(s.T(), []string{"b"}, s.fetchTodoTitles())
+	trashed, err := testTodoService.FetchTrashedTodos(ctx, user.ID)
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), trashed, 1)
+	assert.Equal(s.T(), todos[0].ID, trashed[0].ID)
+	assert.True(s.T(), trashed[0].DeletedAt.Valid)
+}
+
+func (s *TestTodoServiceSuite) TestRestoreTodo() {
+	todos := s.createTodos("a", "b")
+	if _, err := testTodoService.DeleteTodo(ctx, todos[0].ID, user.ID); err != nil {
+		s.T().Fatalf("failed to delete test todos %v", err)
+	}
+
+	todo, err := testTodoService.RestoreTodo(ctx, todos[0].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), todo.DeletedAt.Valid)
+	// NOTE: 削除前の位置に戻っていることの確認
+	assert.Equal(s.T(), []string{"a", "b"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestRestoreTodo_NotTrashed() {
+	todos := s.createTodos("a")
+
+	_, err := testTodoService.RestoreTodo(ctx, todos[0].ID, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestEmptyTrash() {
+	todos := s.createTodos("a", "b", "c")
+	for _, todo := range todos[:2] {
+		if _, err := testTodoService.DeleteTodo(ctx, todo.ID, user.ID); err != nil {
+			s.T().Fatalf("failed to delete test todos %v", err)
+		}
+	}
+
+	purged, err := testTodoService.EmptyTrash(ctx, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, purged)
+	// NOTE: ゴミ箱内のTodoが完全に削除されていることの確認
+	count, _ := models.Todos(qm.WithDeleted()).Count(ctx, DBCon)
+	assert.Equal(s.T(), int64(1), count)
+}
+
+func (s *TestTodoServiceSuite) TestEmptyTrash_DeletesAttachmentFiles() {
+	blobStorage := storage.NewLocalStorage(s.T().TempDir())
+	todoService := NewTodoService(DBCon, NewTodoChangeBroker(), blobStorage)
+	todo := s.createTodos("a")[0]
+	if err := blobStorage.Put(ctx, "todos/a.txt", strings.NewReader("a")); err != nil {
+		s.T().Fatalf("failed to put test file %v", err)
+	}
+	attachment := &models.Attachment{TodoID: todo.ID, UserID: user.ID, FileName: "a.txt", ContentType: "text/plain", Size: 1, StorageKey: "todos/a.txt"}
+	if err := attachment.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test attachments %v", err)
+	}
+	if _, err := todoService.DeleteTodo(ctx, todo.ID, user.ID); err != nil {
+		s.T().Fatalf("failed to delete test todos %v", err)
+	}
+
+	_, err := todoService.EmptyTrash(ctx, user.ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 添付ファイルの行だけでなくストレージ上のファイルも削除されていることの確認
+	_, err = blobStorage.Open(ctx, "todos/a.txt")
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestPurgeTrashedTodos() {
+	todos := s.createTodos("a", "b")
+	// NOTE: 保持期間を過ぎたTodoと、期間内のTodoを用意する
+	todos[0].DeletedAt = null.Time{Time: time.Now().AddDate(0, 0, -31), Valid: true}
+	todos[1].DeletedAt = null.Time{Time: time.Now().AddDate(0, 0, -1), Valid: true}
+	for _, todo := range todos {
+		if _, err := todo.Update(ctx, DBCon, boil.Infer()); err != nil {
+			s.T().Fatalf("failed to update test todos %v", err)
+		}
+	}
+
+	purged, err := testTodoService.PurgeTrashedTodos(ctx, time.Now().AddDate(0, 0, -30))
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 1, purged)
+	trashed, _ := testTodoService.FetchTrashedTodos(ctx, user.ID)
+	assert.Len(s.T(), trashed, 1)
+	assert.Equal(s.T(), todos[1].ID, trashed[0].ID)
+}
+
+func (s *TestTodoServiceSuite) TestArchiveTodo() {
+	todos := s.createTodos("a", "b")
+
+	todo, err := testTodoService.ArchiveTodo(ctx, todos[0].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), todo.ArchivedAt.Valid)
+	// NOTE: アーカイブ済みのTodoは指定がない限り一覧に含まれないことの確認
+	assert.Equal(s.T(), []string{"b"}, s.fetchTodoTitles())
+	all, _ := testTodoService.FetchTodoLists(ctx, user.ID, true)
+	assert.Len(s.T(), all, 2)
+
+	_, err = testTodoService.ArchiveTodo(ctx, todos[0].ID, user.ID)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestUnarchiveTodo() {
+	todos := s.createTodos("a", "b")
+	if _, err := testTodoService.ArchiveTodo(ctx, todos[0].ID, user.ID); err != nil {
+		s.T().Fatalf("failed to archive test todos %v", err)
+	}
+
+	todo, err := testTodoService.UnarchiveTodo(ctx, todos[0].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), todo.ArchivedAt.Valid)
+	assert.Equal(s.T(), []string{"a", "b"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestArchiveCompletedTodos() {
+	todos := s.createTodos("a", "b", "c")
+	for _, todo := range todos[:2] {
+		if _, err := testTodoService.CompleteTodo(ctx, todo.ID, false, user.ID); err != nil {
+			s.T().Fatalf("failed to complete test todos %v", err)
+		}
+	}
+
+	archived, err := testTodoService.ArchiveCompletedTodos(ctx, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, archived)
+	assert.Equal(s.T(), []string{"c"}, s.fetchTodoTitles())
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodoRevisions() {
+	todos := s.createTodos("a")
+	requestParams := model.UpdateTodoInput{Title: omittableString("b"), Content: omittableString("updated"), ExpectedVersion: todos[0].Version}
+	if _, err := testTodoService.UpdateTodo(ctx, todos[0].ID, requestParams, user.ID); err != nil {
+		s.T().Fatalf("failed to update test todos %v", err)
+	}
+	if _, err := testTodoService.DeleteTodo(ctx, todos[0].ID, user.ID); err != nil {
+		s.T().Fatalf("failed to delete test todos %v", err)
+	}
+
+	revisions, err := testTodoService.FetchTodoRevisions(ctx, todos[0].ID)
+
+	assert.Nil(s.T(), err)
+	// NOTE: 新しい順に作成・更新・削除の履歴が記録されていることの確認
+	assert.Len(s.T(), revisions, 3)
+	assert.Equal(s.T(), []string{"delete", "update", "create"}, []string{revisions[0].Action, revisions[1].Action, revisions[2].Action})
+
+	changes, _ := TodoRevisionChanges(revisions[1])
+	fields := map[string]*model.TodoFieldChange{}
+	for _, change := range changes {
+		fields[change.Field] = change
+	}
+	assert.Equal(s.T(), "a", *fields["title"].OldValue)
+	assert.Equal(s.T(), "b", *fields["title"].NewValue)
+	assert.Equal(s.T(), "updated", *fields["content"].NewValue)
+	// NOTE: 並び順など対象外の項目は記録されないことの確認
+	assert.NotContains(s.T(), fields, "position")
+}
+
+func (s *TestTodoServiceSuite) TestRevertTodo() {
+	todos := s.createTodos("a")
+	for i, title := range []string{"b", "c"} {
+		requestParams := model.UpdateTodoInput{Title: omittableString(title), Content: omittableString(title), ExpectedVersion: todos[0].Version + i}
+		if _, err := testTodoService.UpdateTodo(ctx, todos[0].ID, requestParams, user.ID); err != nil {
+			s.T().Fatalf("failed to update test todos %v", err)
+		}
+	}
+	revisions, _ := testTodoService.FetchTodoRevisions(ctx, todos[0].ID)
+
+	// NOTE: 1回目の更新時点に戻す
+	todo, err := testTodoService.RevertTodo(ctx, todos[0].ID, revisions[1].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "b", todo.Title)
+	assert.Equal(s.T(), null.String{String: "b", Valid: true}, todo.Content)
+	revisions, _ = testTodoService.FetchTodoRevisions(ctx, todos[0].ID)
+	assert.Equal(s.T(), "revert", revisions[0].Action)
+
+	// NOTE: 他のTodoの履歴は指定できないことの確認
+	other := s.createTodos("other")
+	otherRevisions, _ := testTodoService.FetchTodoRevisions(ctx, other[0].ID)
+	_, err = testTodoService.RevertTodo(ctx, todos[0].ID, otherRevisions[0].ID, user.ID)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestRevertTodo_AllFields() {
+	projectService := NewProjectService(DBCon)
+	from, _ := projectService.CreateProject(ctx, model.CreateProjectInput{Name: "from"}, user.ID)
+	to, _ := projectService.CreateProject(ctx, model.CreateProjectInput{Name: "to"}, user.ID)
+	fromID, toID := strconv.Itoa(from.ID), strconv.Itoa(to.ID)
+	high, low := model.TodoPriorityHigh, model.TodoPriorityLow
+	estimateMinutes := 30
+	todo, _ := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: "a", Priority: &high, EstimateMinutes: &estimateMinutes, ProjectID: &fromID}, user.ID)
+	requestParams := model.UpdateTodoInput{
+		Priority:        graphql.OmittableOf(&low),
+		EstimateMinutes: graphql.OmittableOf[*int](nil),
+		ProjectID:       graphql.OmittableOf(&toID),
+		ExpectedVersion: todo.Version,
+	}
+	if _, err := testTodoService.UpdateTodo(ctx, todo.ID, requestParams, user.ID); err != nil {
+		s.T().Fatalf("failed to update test todos %v", err)
+	}
+	revisions, _ := testTodoService.FetchTodoRevisions(ctx, todo.ID)
+
+	// NOTE: 作成時点の優先度・見積もり・プロジェクトに戻ることの確認
+	reverted, err := testTodoService.RevertTodo(ctx, todo.ID, revisions[1].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.TodoPriorityHigh, TodoPriorityFromValue(reverted.Priority))
+	assert.Equal(s.T(), null.IntFrom(30), reverted.EstimateMinutes)
+	assert.Equal(s.T(), null.IntFrom(from.ID), reverted.ProjectID)
+
+	// NOTE: 戻す時点のプロジェクトが削除されている場合は戻せないことの確認
+	if _, err := projectService.DeleteProject(ctx, from.ID, user.ID); err != nil {
+		s.T().Fatalf("failed to delete test projects %v", err)
+	}
+	_, err = testTodoService.RevertTodo(ctx, todo.ID, revisions[1].ID, user.ID)
+	assert.NotNil(s.T(), err)
+	_ = reverted.Reload(ctx, DBCon)
+	assert.False(s.T(), reverted.ProjectID.Valid)
+}
+
+func (s *TestTodoServiceSuite) TestBulkCreateTodos() {
+	inputs := []*model.CreateTodoInput{
+		{Title: "a", Content: "a"},
+		{Title: "", Content: "invalid"},
+		{Title: "b", Content: "b"},
+	}
+
+	payload, err := testTodoService.BulkCreateTodos(ctx, inputs, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, payload.SucceededCount)
+	assert.Equal(s.T(), 1, payload.FailedCount)
+	assert.Equal(s.T(), 400, *payload.Results[1].Code)
+	assert.Equal(s.T(), strconv.Itoa(payload.Results[2].Todo.ID), *payload.Results[2].ID)
+	// NOTE: 入力順に末尾へ追加されていることの確認
+	assert.Equal(s.T(), []string{"a", "b"}, s.fetchTodoTitles())
+	revisions, _ := testTodoService.FetchTodoRevisions(ctx, payload.Results[0].Todo.ID)
+	assert.Len(s.T(), revisions, 1)
+}
+
+func (s *TestTodoServiceSuite) TestBulkUpdateTodos() {
+	todos := s.createTodos("a", "b")
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	otherTodo := models.Todo{Title: "other", UserID: otherUser.ID}
+	if err := otherTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	patch := model.BulkTodoPatch{DueDate: omittableString("2024-12-01")}
+	payload, err := testTodoService.BulkUpdateTodos(ctx, []int{todos[0].ID, otherTodo.ID, todos[1].ID}, patch, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, payload.SucceededCount)
+	// NOTE: 他のユーザのTodoは失敗として結果に含まれ、更新されないことの確認
+	assert.Equal(s.T(), 404, *payload.Results[1].Code)
+	if err := otherTodo.Reload(ctx, DBCon); err != nil {
+		s.T().Fatalf("failed to reload test todos %v", err)
+	}
+	assert.False(s.T(), otherTodo.DueDate.Valid)
+	for _, todo := range todos {
+		if err := todo.Reload(ctx, DBCon); err != nil {
+			s.T().Fatalf("failed to reload test todos %v", err)
+		}
+		assert.Equal(s.T(), "2024-12-01", todo.DueDate.Time.Format("2006-01-02"))
+		assert.Equal(s.T(), 2, todo.Version)
+	}
+}
+
+func (s *TestTodoServiceSuite) TestBulkDeleteTodos() {
+	todos := s.createTodos("a", "b", "c")
+
+	payload, err := testTodoService.BulkDeleteTodos(ctx, []int{todos[0].ID, todos[2].ID, todos[0].ID}, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, payload.SucceededCount)
+	// NOTE: 重複したIDは失敗として扱われることの確認
+	assert.Equal(s.T(), 400, *payload.Results[2].Code)
+	assert.Equal(s.T(), []string{"b"}, s.fetchTodoTitles())
+	trashed, _ := testTodoService.FetchTrashedTodos(ctx, user.ID)
+	assert.Len(s.T(), trashed, 2)
+}
+
+func (s *TestTodoServiceSuite) TestBulkCompleteTodos() {
+	todos := s.createTodos("a", "b")
+	recurring, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{
+		Title:      "recurring",
+		DueDate:    func() *string { d := "2024-11-01"; return &d }(),
+		Recurrence: &model.RecurrenceInput{Frequency: model.RecurrenceFrequencyDaily},
+	}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	if _, err := testTodoService.CompleteTodo(ctx, todos[1].ID, false, user.ID); err != nil {
+		s.T().Fatalf("failed to complete test todos %v", err)
+	}
+
+	payload, err := testTodoService.BulkCompleteTodos(ctx, []int{todos[0].ID, todos[1].ID, recurring.ID}, false, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, payload.SucceededCount)
+	// NOTE: 完了済みのTodoは失敗として扱われることの確認
+	assert.Equal(s.T(), 400, *payload.Results[1].Code)
+	assert.True(s.T(), payload.Results[0].Todo.CompletedAt.Valid)
+	// NOTE: 繰り返しのTodoは次回分が生成されることの確認
+	next, err := models.Todos(qm.Where("recurrence_parent_id = ?", recurring.ID)).One(ctx, DBCon)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "2024-11-02", next.DueDate.Time.Format("2006-01-02"))
+}
+
+func (s *TestTodoServiceSuite) TestBulkTodos_TooMany() {
+	ids := make([]int, maxBulkTodoCount+1)
+
+	_, err := testTodoService.BulkDeleteTodos(ctx, ids, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodos() {
+	s.createTodos("a", "b", "c", "d", "e")
+	first := 2
+
+	page1, err := testTodoService.FetchTodos(ctx, user.ID, &first, nil, nil, nil, nil, nil, false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 5, page1.TotalCount)
+	assert.Equal(s.T(), []string{"a", "b"}, todoEdgeTitles(page1.Edges))
+	assert.True(s.T(), page1.PageInfo.HasNextPage)
+	assert.False(s.T(), page1.PageInfo.HasPreviousPage)
+
+	page2, err := testTodoService.FetchTodos(ctx, user.ID, &first, page1.PageInfo.EndCursor, nil, nil, nil, nil, false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"c", "d"}, todoEdgeTitles(page2.Edges))
+	assert.True(s.T(), page2.PageInfo.HasPreviousPage)
+
+	// NOTE: 前のページに戻れることの確認
+	last := 2
+	back, err := testTodoService.FetchTodos(ctx, user.ID, nil, nil, &last, page2.PageInfo.StartCursor, nil, nil, false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"a", "b"}, todoEdgeTitles(back.Edges))
+	assert.False(s.T(), back.PageInfo.HasPreviousPage)
+	assert.True(s.T(), back.PageInfo.HasNextPage)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodos_OrderBy() {
+	s.createTodos("b", "a", "c", "a")
+	first := 3
+	orderBy := &model.TodoOrder{Field: model.TodoOrderFieldTitle, Direction: model.OrderDirectionDesc}
+
+	page1, err := testTodoService.FetchTodos(ctx, user.ID, &first, nil, nil, nil, nil, orderBy, false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"c", "b", "a"}, todoEdgeTitles(page1.Edges))
+
+	// NOTE: 同じ値のTodoがページをまたいでも重複・欠落しないことの確認
+	page2, err := testTodoService.FetchTodos(ctx, user.ID, &first, page1.PageInfo.EndCursor, nil, nil, nil, orderBy, false)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), []string{"a"}, todoEdgeTitles(page2.Edges))
+	assert.NotEqual(s.T(), page1.Edges[2].Node.ID, page2.Edges[0].Node.ID)
+	assert.False(s.T(), page2.PageInfo.HasNextPage)
+
+	// NOTE: 並び替えの項目が異なるカーソルは不正とする
+	_, err = testTodoService.FetchTodos(ctx, user.ID, &first, page1.PageInfo.EndCursor, nil, nil, nil, nil, false)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodos_Filter() {
+	project := models.Project{Name: "project 1", UserID: user.ID}
+	if err := project.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test projects %v", err)
+	}
+	projectID := strconv.Itoa(project.ID)
+	high := model.TodoPriorityHigh
+	inputs := []model.CreateTodoInput{
+		{Title: "release 100%", Content: "a", Priority: &high, ProjectID: &projectID, Tags: []string{"work"}},
+		{Title: "release note", Content: "b", Tags: []string{"work", "docs"}},
+		{Title: "shopping", Content: "c", Tags: []string{"private"}},
+	}
+	for _, input := range inputs {
+		if _, err := testTodoService.CreateTodo(ctx, input, user.ID); err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+	}
+
+	cases := []struct {
+		name     string
+		filter   model.TodoFilter
+		expected []string
+	}{
+		{"title", model.TodoFilter{TitleContains: func() *string { v := "release"; return &v }()}, []string{"release 100%", "release note"}},
+		// NOTE: LIKEの特殊文字はエスケープされることの確認
+		{"escaped", model.TodoFilter{TitleContains: func() *string { v := "100%"; return &v }()}, []string{"release 100%"}},
+		{"priority", model.TodoFilter{Priorities: []model.TodoPriority{model.TodoPriorityHigh}}, []string{"release 100%"}},
+		{"tags", model.TodoFilter{Tags: []string{"docs", "private"}}, []string{"release note", "shopping"}},
+		{"project", model.TodoFilter{ProjectID: &projectID}, []string{"release 100%"}},
+		{"status", model.TodoFilter{Statuses: []model.TodoStatus{model.TodoStatusCompleted}}, []string{}},
+	}
+	for _, c := range cases {
+		connection, err := testTodoService.FetchTodos(ctx, user.ID, nil, nil, nil, nil, &c.filter, nil, false)
+		assert.Nil(s.T(), err, c.name)
+		assert.Equal(s.T(), c.expected, todoEdgeTitles(connection.Edges), c.name)
+		assert.Equal(s.T(), len(c.expected), connection.TotalCount, c.name)
+	}
+}
+
+func (s *TestTodoServiceSuite) TestFetchTodos_InvalidFilter() {
+	from, to := "2024-12-01", "2024-11-01"
+	filter := &model.TodoFilter{DueDate: &model.DateRange{From: &from, To: &to}}
+
+	_, err := testTodoService.FetchTodos(ctx, user.ID, nil, nil, nil, nil, filter, nil, false)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestUpdateTodo_TagsAndPriority() {
+	todos := s.createTodos("a")
+	high := model.TodoPriorityHigh
+	requestParams := model.UpdateTodoInput{
+		Priority:        graphql.OmittableOf(&high),
+		Tags:            graphql.OmittableOf([]string{"work", " work ", "docs"}),
+		ExpectedVersion: todos[0].Version,
+	}
+
+	todo, err := testTodoService.UpdateTodo(ctx, todos[0].ID, requestParams, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), model.TodoPriorityHigh, TodoPriorityFromValue(todo.Priority))
+	tags, _ := testTodoService.FetchTodoTags(ctx, todos[0].ID)
+	assert.Equal(s.T(), []string{"docs", "work"}, tags)
+}
+
+// NOTE: 全文検索インデックスはコミット時に更新されるため、トランザクション内のテストでは検索結果を確認できない
+// ここではバリデーションと、検索語が残らないクエリの扱いのみ確認する
+func (s *TestTodoServiceSuite) TestSearchTodos_ValidationError() {
+	for _, query := range []string{"", "  ", "a", strings.Repeat("あ", 101)} {
+		_, err := testTodoService.SearchTodos(ctx, user.ID, query, nil, nil)
+		assert.NotNil(s.T(), err, query)
+	}
+}
+
+func (s *TestTodoServiceSuite) TestSearchTodos_OperatorsOnly() {
+	connection, err := testTodoService.SearchTodos(ctx, user.ID, "+- ()", nil, nil)
+
+	assert.Nil(s.T(), err)
+	assert.Empty(s.T(), connection.Edges)
+	assert.Equal(s.T(), 0, connection.TotalCount)
+}
+
+func (s *TestTodoServiceSuite) TestAddTodoDependency() {
+	todos := s.createTodos("a", "b")
+
+	_, err := testTodoService.AddTodoDependency(ctx, todos[0].ID, todos[1].ID, user.ID)
+
+	assert.Nil(s.T(), err)
+	blockers, _ := testTodoService.FetchBlockers(ctx, todos[0].ID)
+	assert.Len(s.T(), blockers, 1)
+	assert.Equal(s.T(), todos[1].ID, blockers[0].ID)
+	blocked, _ := testTodoService.FetchBlockedTodos(ctx, todos[1].ID)
+	assert.Len(s.T(), blocked, 1)
+	assert.Equal(s.T(), todos[0].ID, blocked[0].ID)
+}
+
+func (s *TestTodoServiceSuite) TestAddTodoDependency_Cycle() {
+	todos := s.createTodos("a", "b", "c")
+	for _, pair := range [][2]int{{0, 1}, {1, 2}} {
+		if _, err := testTodoService.AddTodoDependency(ctx, todos[pair[0]].ID, todos[pair[1]].ID, user.ID); err != nil {
+			s.T().Fatalf("failed to add test dependencies %v", err)
+		}
+	}
+
+	// NOTE: 自身への依存、重複、間接的な循環はいずれも登録できないことの確認
+	for _, pair := range [][2]int{{0, 0}, {0, 1}, {2, 0}} {
+		_, err := testTodoService.AddTodoDependency(ctx, todos[pair[0]].ID, todos[pair[1]].ID, user.ID)
+		assert.NotNil(s.T(), err, pair)
+	}
+}
+
+func (s *TestTodoServiceSuite) TestCompleteTodo_Blocked() {
+	todos := s.createTodos("a", "b")
+	if _, err := testTodoService.AddTodoDependency(ctx, todos[0].ID, todos[1].ID, user.ID); err != nil {
+		s.T().Fatalf("failed to add test dependencies %v", err)
+	}
+
+	_, err := testTodoService.CompleteTodo(ctx, todos[0].ID, false, user.ID)
+	assert.NotNil(s.T(), err)
+
+	// NOTE: forceを指定した場合は完了できることの確認
+	payload, err := testTodoService.CompleteTodo(ctx, todos[0].ID, true, user.ID)
+	assert.Nil(s.T(), err)
+	assert.True(s.T(), payload.Todo.CompletedAt.Valid)
+}
+
+func (s *TestTodoServiceSuite) TestBulkCompleteTodos_Blocked() {
+	todos := s.createTodos("a", "b", "c", "d")
+	// NOTE: a <- b(同時に完了), c <- d(未完了のまま)
+	for _, pair := range [][2]int{{0, 1}, {2, 3}} {
+		if _, err := testTodoService.AddTodoDependency(ctx, todos[pair[0]].ID, todos[pair[1]].ID, user.ID); err != nil {
+			s.T().Fatalf("failed to add test dependencies %v", err)
+		}
+	}
+
+	payload, err := testTodoService.BulkCompleteTodos(ctx, []int{todos[0].ID, todos[1].ID, todos[2].ID}, false, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, payload.SucceededCount)
+	assert.Equal(s.T(), 1, payload.FailedCount)
+	assert.Equal(s.T(), http.StatusBadRequest, *payload.Results[2].Code)
+}
+
+func (s *TestTodoServiceSuite) TestFetchWorkload() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := collaborator.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	for _, input := range []struct {
+		title           string
+		dueDate         string
+		estimateMinutes *int
+		assignee        *models.User
+	}{
+		{"a", "2024-12-09", intPtr(60), nil},
+		{"b", "2024-12-09", intPtr(90), collaborator},
+		{"c", "2024-12-09", intPtr(45), collaborator},
+		{"d", "2024-12-10", intPtr(30), nil},
+		{"e", "2024-12-10", nil, nil},
+		{"f", "2024-12-20", intPtr(120), nil},
+	} {
+		dueDate := input.dueDate
+		todo, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: input.title, Content: "", DueDate: &dueDate, EstimateMinutes: input.estimateMinutes}, user.ID)
+		if err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+		if input.assignee != nil {
+			share := &models.TodoShare{TodoID: todo.ID, UserID: input.assignee.ID}
+			if err := share.Insert(ctx, DBCon, boil.Infer()); err != nil {
+				s.T().Fatalf("failed to create test shares %v", err)
+			}
+			if _, err := testTodoService.AssignTodo(ctx, todo.ID, &input.assignee.ID, user.ID); err != nil {
+				s.T().Fatalf("failed to assign test todos %v", err)
+			}
+		}
+	}
+
+	workload, err := testTodoService.FetchWorkload(ctx, user.ID, "2024-12-09", "2024-12-15")
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), workload, 3)
+	// NOTE: 同じ期日でも担当者ごとに集計され、担当者が未設定のものはassigneeがnilとなることの確認
+	assert.Equal(s.T(), "2024-12-09", workload[0].Date)
+	assert.Nil(s.T(), workload[0].Assignee)
+	assert.Equal(s.T(), 60, workload[0].EstimateMinutes)
+	assert.Equal(s.T(), 1, workload[0].TodoCount)
+	assert.Equal(s.T(), "2024-12-09", workload[1].Date)
+	assert.Equal(s.T(), collaborator.ID, workload[1].Assignee.ID)
+	assert.Equal(s.T(), 135, workload[1].EstimateMinutes)
+	assert.Equal(s.T(), 2, workload[1].TodoCount)
+	assert.Equal(s.T(), "2024-12-10", workload[2].Date)
+	assert.Equal(s.T(), 30, workload[2].EstimateMinutes)
+	assert.Equal(s.T(), 1, workload[2].TodoCount)
+}
+
+func (s *TestTodoServiceSuite) TestAssignTodo() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := collaborator.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo, _ := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID)
+
+	// NOTE: 共有していないユーザは担当者にできないことの確認
+	_, err := testTodoService.AssignTodo(ctx, todo.ID, &collaborator.ID, user.ID)
+	assert.NotNil(s.T(), err)
+
+	if _, err := testTodoService.ShareTodo(ctx, todo.ID, "test_2@example.com", user.ID); err != nil {
+		s.T().Fatalf("failed to share test todos %v", err)
+	}
+	assigned, err := testTodoService.AssignTodo(ctx, todo.ID, &collaborator.ID, user.ID)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), collaborator.ID, assigned.AssigneeID.Int)
+	assert.Equal(s.T(), todo.Version+1, assigned.Version)
+	// NOTE: 担当者に通知され、自分自身を担当者にした場合は通知されないことの確認
+	_, _ = testTodoService.AssignTodo(ctx, todo.ID, &user.ID, user.ID)
+	exists, _ := models.Notifications(qm.Where("user_id = ? AND event_type = ?", collaborator.ID, notificationTypeAssigned)).Exists(ctx, DBCon)
+	assert.True(s.T(), exists)
+	exists, _ = models.Notifications(qm.Where("user_id = ?", user.ID)).Exists(ctx, DBCon)
+	assert.False(s.T(), exists)
+	_, _ = testTodoService.AssignTodo(ctx, todo.ID, &collaborator.ID, user.ID)
+
+	// NOTE: 共有を解除すると担当者も解除されることの確認
+	unshared, err := testTodoService.UnshareTodo(ctx, todo.ID, collaborator.ID, user.ID)
+	assert.Nil(s.T(), err)
+	assert.False(s.T(), unshared.AssigneeID.Valid)
+}
+
+func (s *TestTodoServiceSuite) TestFetchWorkload_ValidationError() {
+	_, err := testTodoService.FetchWorkload(ctx, user.ID, "2024-12-15", "2024-12-09")
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestCreateTodo_InvalidEstimateMinutes() {
+	_, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: "", EstimateMinutes: intPtr(0)}, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestSubscribeTodoChanges() {
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := testTodoService.SubscribeTodoChanges(subscriptionCtx, user.ID, nil)
+	assert.Nil(s.T(), err)
+
+	todo, _ := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID)
+	// NOTE: 更新に失敗した(ロールバックされた)変更は配信されないことの確認
+	_, err = testTodoService.UpdateTodo(ctx, todo.ID, model.UpdateTodoInput{Title: omittableString("x"), ExpectedVersion: todo.Version + 1}, user.ID)
+	assert.NotNil(s.T(), err)
+	_, _ = testTodoService.UpdateTodo(ctx, todo.ID, model.UpdateTodoInput{Title: omittableString("b"), ExpectedVersion: todo.Version}, user.ID)
+	_, _ = testTodoService.DeleteTodo(ctx, todo.ID, user.ID)
+
+	for _, expected := range []struct {
+		action model.TodoChangeAction
+		title  string
+	}{
+		{model.TodoChangeActionCreated, "a"},
+		{model.TodoChangeActionUpdated, "b"},
+		{model.TodoChangeActionDeleted, "b"},
+	} {
+		event := s.receiveTodoChange(events)
+		assert.Equal(s.T(), expected.action, event.Action)
+		assert.Equal(s.T(), todo.ID, event.Todo.ID)
+		assert.Equal(s.T(), expected.title, event.Todo.Title)
+	}
+
+	cancel()
+	_, ok := <-events
+	assert.False(s.T(), ok)
+}
+
+func (s *TestTodoServiceSuite) TestSubscribeTodoChanges_Project() {
+	projectService := NewProjectService(DBCon)
+	from, _ := projectService.CreateProject(ctx, model.CreateProjectInput{Name: "from"}, user.ID)
+	to, _ := projectService.CreateProject(ctx, model.CreateProjectInput{Name: "to"}, user.ID)
+	fromID, toID := strconv.Itoa(from.ID), strconv.Itoa(to.ID)
+	todo, _ := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: "", ProjectID: &fromID}, user.ID)
+
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events, err := testTodoService.SubscribeTodoChanges(subscriptionCtx, user.ID, &from.ID)
+	assert.Nil(s.T(), err)
+
+	// NOTE: 他のプロジェクトのTodoの変更は配信されず、移動元のプロジェクトには移動が配信されることの確認
+	_, _ = testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "b", Content: "", ProjectID: &toID}, user.ID)
+	_, _ = testTodoService.UpdateTodo(ctx, todo.ID, model.UpdateTodoInput{ProjectID: graphql.OmittableOf(&toID), ExpectedVersion: todo.Version}, user.ID)
+
+	event := s.receiveTodoChange(events)
+	assert.Equal(s.T(), model.TodoChangeActionUpdated, event.Action)
+	assert.Equal(s.T(), todo.ID, event.Todo.ID)
+	assert.Equal(s.T(), to.ID, event.Todo.ProjectID.Int)
+}
+
+func (s *TestTodoServiceSuite) TestSubscribeTodoChanges_NotFound() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	project, _ := NewProjectService(DBCon).CreateProject(ctx, model.CreateProjectInput{Name: "project 1"}, user.ID)
+
+	_, err := testTodoService.SubscribeTodoChanges(ctx, otherUser.ID, &project.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestShareTodo() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := collaborator.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo, _ := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID)
+
+	_, err := testTodoService.ShareTodo(ctx, todo.ID, "test_2@example.com", user.ID)
+
+	assert.Nil(s.T(), err)
+	collaborators, _ := testTodoService.FetchCollaborators(ctx, todo.ID)
+	assert.Len(s.T(), collaborators, 1)
+	assert.Equal(s.T(), collaborator.ID, collaborators[0].ID)
+	// NOTE: 共有されたユーザに通知されることの確認
+	notification, err := models.Notifications(qm.Where("user_id = ? AND todo_id = ?", collaborator.ID, todo.ID)).One(ctx, DBCon)
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), notificationTypeShared, notification.EventType)
+	assert.Equal(s.T(), user.ID, notification.ActorID.Int)
+	// NOTE: 共同作業者は共有されたTodoを閲覧できることの確認
+	sharedTodos, _ := testTodoService.FetchSharedTodos(ctx, collaborator.ID)
+	assert.Len(s.T(), sharedTodos, 1)
+	_, err = testTodoService.FetchTodo(ctx, todo.ID, collaborator.ID)
+	assert.Nil(s.T(), err)
+
+	// NOTE: 共有を解除すると閲覧できなくなることの確認
+	_, err = testTodoService.UnshareTodo(ctx, todo.ID, collaborator.ID, user.ID)
+	assert.Nil(s.T(), err)
+	_, err = testTodoService.FetchTodo(ctx, todo.ID, collaborator.ID)
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoServiceSuite) TestShareTodo_Error() {
+	collaborator := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := collaborator.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	todo, _ := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID)
+	if _, err := testTodoService.ShareTodo(ctx, todo.ID, "test_2@example.com", user.ID); err != nil {
+		s.T().Fatalf("failed to share test todos %v", err)
+	}
+
+	for _, input := range []struct {
+		email  string
+		userID int
+	}{
+		{"test_2@example.com", user.ID},
+		{"test@example.com", user.ID},
+		{"unknown@example.com", user.ID},
+		// NOTE: 共同作業者は共有を追加できない
+		{"test@example.com", collaborator.ID},
+	} {
+		_, err := testTodoService.ShareTodo(ctx, todo.ID, input.email, input.userID)
+		assert.NotNil(s.T(), err, input.email)
+	}
+}
+
+func (s *TestTodoServiceSuite) receiveTodoChange(events <-chan *model.TodoChangeEvent) *model.TodoChangeEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		s.T().Fatal("no todo change received")
+		return nil
+	}
+}
+
+func todoEdgeTitles(edges []*model.TodoEdge) []string {
+	titles := make([]string, 0, len(edges))
+	for _, edge := range edges {
+		titles = append(titles, edge.Node.Title)
+	}
+	return titles
+}
+
 func TestTodoService(t *testing.T) {
 	// テストスイートを実行
 	suite.Run(t, new(TestTodoServiceSuite))
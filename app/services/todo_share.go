@@ -0,0 +1,177 @@
+package services
+
+import (
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: Todoを共有したユーザ(共同作業者)は、Todoの閲覧とコメントのみ行える(編集や削除は所有者のみ)
+func (ts *todoService) ShareTodo(ctx context.Context, id int, email string, userID int) (*models.Todo, error) {
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	collaborator, err := models.Users(qm.Where("email = ?", email)).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(fmt.Errorf("共有先のユーザが見つかりません。"))
+	}
+	if collaborator.ID == userID {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("自分自身とは共有できません。"))
+	}
+	exists, err := models.TodoShares(qm.Where("todo_id = ? AND user_id = ?", todo.ID, collaborator.ID)).Exists(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if exists {
+		return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("既に共有済みのユーザです。"))
+	}
+
+	share := &models.TodoShare{TodoID: todo.ID, UserID: collaborator.ID}
+	if err := share.Insert(ctx, tx, boil.Infer()); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if err := notifyTodoEvent(ctx, tx, todo, notificationTypeShared, collaborator.ID, userID); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if err := insertTodoShareActivity(ctx, tx, todo, activityVerbShared); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	return todo, nil
+}
+
+// NOTE: 共有を解除したユーザが担当者の場合は、担当者も解除する
+func (ts *todoService) UnshareTodo(ctx context.Context, id int, collaboratorID int, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	share, err := models.TodoShares(qm.Where("todo_id = ? AND user_id = ?", todo.ID, collaboratorID)).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	if _, err := share.Delete(ctx, tx); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	if todo.AssigneeID.Valid && todo.AssigneeID.Int == collaboratorID {
+		todo.AssigneeID = null.Int{}
+		todo.Version++
+		if _, err := todo.Update(ctx, tx, boil.Whitelist(models.TodoColumns.AssigneeID, models.TodoColumns.Version, models.TodoColumns.UpdatedAt)); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return todo, nil
+}
+
+// NOTE: 担当者はTodoを閲覧できるユーザ(所有者と共同作業者)から選ぶ(nilの場合は担当者を解除する)
+func (ts *todoService) AssignTodo(ctx context.Context, id int, assigneeID *int, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", id, userID), qm.For("UPDATE")).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	if assigneeID != nil {
+		exists, err := models.Users(qm.Where("id = ?", *assigneeID), todoViewerMod(todo.ID)).Exists(ctx, tx)
+		if err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+		if !exists {
+			return &models.Todo{}, view.NewBadRequestView(fmt.Errorf("Todoを共有していないユーザは担当者にできません。"))
+		}
+	}
+
+	previous := todo.AssigneeID
+	todo.AssigneeID = null.IntFromPtr(assigneeID)
+	todo.Version++
+	if _, err := todo.Update(ctx, tx, boil.Whitelist(models.TodoColumns.AssigneeID, models.TodoColumns.Version, models.TodoColumns.UpdatedAt)); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	// NOTE: 新たに担当者となったユーザにのみ通知する(自分自身を担当者にした場合は通知しない)
+	if todo.AssigneeID.Valid && todo.AssigneeID != previous {
+		if todo.AssigneeID.Int != userID {
+			if err := notifyTodoEvent(ctx, tx, todo, notificationTypeAssigned, todo.AssigneeID.Int, userID); err != nil {
+				return &models.Todo{}, view.NewInternalServerErrorView(err)
+			}
+		}
+		if err := insertTodoShareActivity(ctx, tx, todo, activityVerbAssigned); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return todo, nil
+}
+
+// NOTE: ログインユーザに共有されたTodoを、共有された順に返す
+func (ts *todoService) FetchSharedTodos(ctx context.Context, userID int) ([]*models.Todo, error) {
+	todos, err := models.Todos(
+		qm.InnerJoin("todo_shares ON todo_shares.todo_id = todos.id"),
+		qm.Where("todo_shares.user_id = ?", userID),
+		qm.OrderBy("todo_shares.id ASC"),
+	).All(ctx, ts.db)
+	if err != nil {
+		return models.TodoSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return todos, nil
+}
+
+func (ts *todoService) FetchCollaborators(ctx context.Context, todoID int) ([]*models.User, error) {
+	users, err := models.Users(
+		qm.InnerJoin("todo_shares ON todo_shares.user_id = users.id"),
+		qm.Where("todo_shares.todo_id = ?", todoID),
+		qm.OrderBy("todo_shares.id ASC"),
+	).All(ctx, ts.db)
+	if err != nil {
+		return models.UserSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return users, nil
+}
+
+// NOTE: ログインユーザが閲覧できるTodo(所有しているTodoと共有されたTodo)に絞り込む
+func visibleTodoMod(userID int) qm.QueryMod {
+	return qm.Where("(todos.user_id = ? OR todos.id IN (SELECT todo_id FROM todo_shares WHERE user_id = ?))", userID, userID)
+}
+
+// NOTE: Todoを閲覧できるユーザ(所有者と共同作業者)に絞り込む
+func todoViewerMod(todoID int) qm.QueryMod {
+	return qm.Where(
+		"(users.id = (SELECT user_id FROM todos WHERE id = ?) OR users.id IN (SELECT user_id FROM todo_shares WHERE todo_id = ?))",
+		todoID, todoID,
+	)
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/pubsub"
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// NOTE: 購読者へ配信するTodoの変更
+// 別のプロジェクトへ移動したTodoを移動元の購読者にも配信するため、変更前のプロジェクトを保持する
+type TodoChange struct {
+	Event             *model.TodoChangeEvent
+	PreviousProjectID null.Int
+}
+
+type TodoChangeBroker = pubsub.Broker[*TodoChange]
+
+func NewTodoChangeBroker() *TodoChangeBroker {
+	return pubsub.NewBroker[*TodoChange]()
+}
+
+type todoChangesKey struct{}
+
+// NOTE: 1回の処理で記録されたTodoの変更(同じTodoが複数回変更された場合は最後の内容にまとめる)
+type todoChanges struct {
+	mu      sync.Mutex
+	changes []*TodoChange
+	indexes map[int]int
+}
+
+// NOTE: Todoの変更を記録するcontextを返す
+// ロールバックされた変更を配信しないよう、記録した変更はコミット後にpublishTodoChangesで配信する
+func withTodoChanges(ctx context.Context) (context.Context, *todoChanges) {
+	changes := &todoChanges{indexes: map[int]int{}}
+	return context.WithValue(ctx, todoChangesKey{}, changes), changes
+}
+
+// NOTE: contextにTodoの変更の記録先がない場合は配信しない
+func collectTodoChange(ctx context.Context, todo *models.Todo, action string, changes []*model.TodoFieldChange) {
+	collector, ok := ctx.Value(todoChangesKey{}).(*todoChanges)
+	if !ok {
+		return
+	}
+
+	// NOTE: 呼び出し元で後から書き換えられないよう、変更時点の内容を複製して保持する
+	snapshot := *todo
+	snapshot.R = nil
+	change := &TodoChange{
+		Event:             &model.TodoChangeEvent{Action: todoChangeAction(action), Todo: &snapshot},
+		PreviousProjectID: previousProjectID(changes),
+	}
+
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	index, ok := collector.indexes[todo.ID]
+	if !ok {
+		collector.indexes[todo.ID] = len(collector.changes)
+		collector.changes = append(collector.changes, change)
+		return
+	}
+	// NOTE: 作成直後の更新は作成として、移動元のプロジェクトは最初の変更時点のものとして配信する
+	prev := collector.changes[index]
+	if prev.Event.Action == model.TodoChangeActionCreated && change.Event.Action == model.TodoChangeActionUpdated {
+		change.Event.Action = model.TodoChangeActionCreated
+	}
+	if prev.PreviousProjectID.Valid {
+		change.PreviousProjectID = prev.PreviousProjectID
+	}
+	collector.changes[index] = change
+}
+
+func publishTodoChanges(broker *TodoChangeBroker, changes *todoChanges) {
+	changes.mu.Lock()
+	defer changes.mu.Unlock()
+
+	for _, change := range changes.changes {
+		broker.Publish(change)
+	}
+}
+
+// NOTE: ログインユーザのTodoの変更を購読する(プロジェクトを指定した場合はそのプロジェクトのTodoのみ)
+// 返すチャネルはctxがキャンセルされると閉じる
+func (ts *todoService) SubscribeTodoChanges(ctx context.Context, userID int, projectID *int) (<-chan *model.TodoChangeEvent, error) {
+	if projectID != nil {
+		if _, err := models.Projects(qm.Where("id = ? AND user_id = ?", *projectID, userID)).One(ctx, ts.db); err != nil {
+			return nil, view.NewNotFoundView(err)
+		}
+	}
+
+	changes := ts.broker.Subscribe(ctx)
+	events := make(chan *model.TodoChangeEvent)
+	go func() {
+		defer close(events)
+		for change := range changes {
+			if !change.visibleTo(userID, projectID) {
+				continue
+			}
+			select {
+			case events <- change.Event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func (c *TodoChange) visibleTo(userID int, projectID *int) bool {
+	if c.Event.Todo.UserID != userID {
+		return false
+	}
+	if projectID == nil {
+		return true
+	}
+	return c.Event.Todo.ProjectID == null.IntFrom(*projectID) || c.PreviousProjectID == null.IntFrom(*projectID)
+}
+
+func todoChangeAction(action string) model.TodoChangeAction {
+	switch action {
+	case todoRevisionActionCreate:
+		return model.TodoChangeActionCreated
+	case todoRevisionActionDelete:
+		return model.TodoChangeActionDeleted
+	}
+	return model.TodoChangeActionUpdated
+}
+
+// NOTE: プロジェクトが変更された場合のみ変更前のプロジェクトを返す
+func previousProjectID(changes []*model.TodoFieldChange) null.Int {
+	for _, change := range changes {
+		if change.Field != models.TodoColumns.ProjectID || change.OldValue == nil {
+			continue
+		}
+		projectID, err := strconv.Atoi(*change.OldValue)
+		if err != nil {
+			return null.Int{}
+		}
+		return null.IntFrom(projectID)
+	}
+	return null.Int{}
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type TodoTemplateService interface {
+	CreateTodoTemplate(ctx context.Context, requestParams model.CreateTodoTemplateInput, userID int) (*models.TodoTemplate, error)
+	SaveTodoAsTemplate(ctx context.Context, todoID int, name string, userID int) (*models.TodoTemplate, error)
+	DeleteTodoTemplate(ctx context.Context, id int, userID int) (string, error)
+	FetchTodoTemplates(ctx context.Context, userID int) ([]*models.TodoTemplate, error)
+	CreateTodoFromTemplate(ctx context.Context, templateID int, overrides *model.TodoTemplateOverrides, userID int) (*models.Todo, error)
+}
+
+type todoTemplateService struct {
+	db     *sql.DB
+	broker *TodoChangeBroker
+}
+
+func NewTodoTemplateService(db *sql.DB, broker *TodoChangeBroker) TodoTemplateService {
+	return &todoTemplateService{db, broker}
+}
+
+func (tts *todoTemplateService) CreateTodoTemplate(ctx context.Context, requestParams model.CreateTodoTemplateInput, userID int) (*models.TodoTemplate, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateTodoTemplate(requestParams)
+	if validationErrors != nil {
+		return &models.TodoTemplate{}, view.NewBadRequestView(validationErrors)
+	}
+
+	tx, err := tts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.TodoTemplate{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	template, err := insertTodoTemplate(ctx, tx, requestParams, userID)
+	if err != nil {
+		return &models.TodoTemplate{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.TodoTemplate{}, view.NewInternalServerErrorView(err)
+	}
+	return template, nil
+}
+
+// NOTE: Todoの内容と先行Todo(サブタスク)をテンプレートとして保存する
+// 期日は保存した日からの日数として保存する
+func (tts *todoTemplateService) SaveTodoAsTemplate(ctx context.Context, todoID int, name string, userID int) (*models.TodoTemplate, error) {
+	todo, err := models.Todos(qm.Where("id = ? AND user_id = ?", todoID, userID)).One(ctx, tts.db)
+	if err != nil {
+		return &models.TodoTemplate{}, view.NewNotFoundView(err)
+	}
+	todoTags, err := models.TodoTags(qm.Where("todo_id = ?", todo.ID), qm.OrderBy("name ASC")).All(ctx, tts.db)
+	if err != nil {
+		return &models.TodoTemplate{}, view.NewInternalServerErrorView(err)
+	}
+	subtasks, err := models.Todos(
+		qm.Where("id IN (SELECT blocker_id FROM todo_dependencies WHERE todo_id = ?)", todo.ID),
+		qm.OrderBy("position ASC, id ASC"),
+	).All(ctx, tts.db)
+	if err != nil {
+		return &models.TodoTemplate{}, view.NewInternalServerErrorView(err)
+	}
+
+	today := startOfDay(time.Now())
+	priority := TodoPriorityFromValue(todo.Priority)
+	requestParams := model.CreateTodoTemplateInput{
+		Name:          name,
+		Title:         todo.Title,
+		Content:       todo.Content.Ptr(),
+		Priority:      &priority,
+		DueOffsetDays: dueOffsetDays(todo.DueDate, today),
+		Tags:          []string{},
+		Subtasks:      []*model.TodoTemplateSubtaskInput{},
+	}
+	for _, todoTag := range todoTags {
+		requestParams.Tags = append(requestParams.Tags, todoTag.Name)
+	}
+	for _, subtask := range subtasks {
+		requestParams.Subtasks = append(requestParams.Subtasks, &model.TodoTemplateSubtaskInput{
+			Title:         subtask.Title,
+			Content:       subtask.Content.Ptr(),
+			DueOffsetDays: dueOffsetDays(subtask.DueDate, today),
+		})
+	}
+
+	return tts.CreateTodoTemplate(ctx, requestParams, userID)
+}
+
+func (tts *todoTemplateService) DeleteTodoTemplate(ctx context.Context, id int, userID int) (string, error) {
+	template, err := models.TodoTemplates(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, tts.db)
+	if err != nil {
+		return strconv.Itoa(id), view.NewNotFoundView(err)
+	}
+
+	if _, err := template.Delete(ctx, tts.db); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (tts *todoTemplateService) FetchTodoTemplates(ctx context.Context, userID int) ([]*models.TodoTemplate, error) {
+	templates, err := models.TodoTemplates(
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("name ASC, id ASC"),
+		qm.Load(models.TodoTemplateRels.TodoTemplateSubtasks, qm.OrderBy("sort_order ASC")),
+	).All(ctx, tts.db)
+	if err != nil {
+		return models.TodoTemplateSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return templates, nil
+}
+
+// NOTE: テンプレートからTodoを作成する
+// サブタスクは作成したTodoの先行Todoとして作成し、期日は開始日(未指定の場合は当日)からの日数で設定する
+func (tts *todoTemplateService) CreateTodoFromTemplate(ctx context.Context, templateID int, overrides *model.TodoTemplateOverrides, userID int) (*models.Todo, error) {
+	if overrides == nil {
+		overrides = &model.TodoTemplateOverrides{}
+	}
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateTodoTemplateOverrides(*overrides)
+	if validationErrors != nil {
+		return &models.Todo{}, view.NewBadRequestView(validationErrors)
+	}
+
+	template, err := models.TodoTemplates(
+		qm.Where("id = ? AND user_id = ?", templateID, userID),
+		qm.Load(models.TodoTemplateRels.TodoTemplateSubtasks, qm.OrderBy("sort_order ASC")),
+	).One(ctx, tts.db)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+	tags, err := TodoTemplateTags(template)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	startDate := startOfDay(time.Now())
+	if overrides.StartDate != nil {
+		startDate = dueDateFromInput(overrides.StartDate).Time
+	}
+
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := tts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	projectID, err := todoProjectID(ctx, tx, overrides.ProjectID, userID)
+	if err != nil {
+		return &models.Todo{}, err
+	}
+
+	todo := &models.Todo{
+		UserID:    userID,
+		ProjectID: projectID,
+		Title:     template.Title,
+		Content:   null.StringFrom(template.Content.String),
+		Priority:  template.Priority,
+		DueDate:   dueDateFromOffset(startDate, template.DueOffsetDays),
+	}
+	if overrides.Title != nil {
+		todo.Title = *overrides.Title
+	}
+	if overrides.Content != nil {
+		todo.Content = null.StringFrom(*overrides.Content)
+	}
+	if overrides.Priority != nil {
+		todo.Priority = todoPriorityValue(*overrides.Priority)
+	}
+	todos := models.TodoSlice{todo}
+	for _, subtask := range template.R.TodoTemplateSubtasks {
+		todos = append(todos, &models.Todo{
+			UserID:    userID,
+			ProjectID: projectID,
+			Title:     subtask.Title,
+			Content:   null.StringFrom(subtask.Content.String),
+			DueDate:   dueDateFromOffset(startDate, subtask.DueOffsetDays),
+		})
+	}
+
+	// NOTE: 新規作成したTodoは末尾に並べる
+	if err := appendTodoPositions(ctx, tx, userID, todos); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	for _, t := range todos {
+		if err := t.Insert(ctx, tx, boil.Infer()); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+	}
+	if _, err := newTodoTags(todo.ID, tags).InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	for _, subtask := range todos[1:] {
+		dependency := &models.TodoDependency{TodoID: todo.ID, BlockerID: subtask.ID}
+		if err := dependency.Insert(ctx, tx, boil.Infer()); err != nil {
+			return &models.Todo{}, view.NewInternalServerErrorView(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(tts.broker, collected)
+	return todo, nil
+}
+
+// TodoTemplateTags テンプレートに保存されたタグを取り出す
+func TodoTemplateTags(template *models.TodoTemplate) ([]string, error) {
+	tags := []string{}
+	if err := template.Tags.Unmarshal(&tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func insertTodoTemplate(ctx context.Context, exec boil.ContextExecutor, input model.CreateTodoTemplateInput, userID int) (*models.TodoTemplate, error) {
+	exists, err := models.TodoTemplates(qm.Where("user_id = ? AND name = ?", userID, input.Name)).Exists(ctx, exec)
+	if err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+	if exists {
+		return nil, view.NewBadRequestView(fmt.Errorf("同じ名前のテンプレートが既に存在します。"))
+	}
+
+	tags, err := json.Marshal(normalizeTags(input.Tags))
+	if err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+	template := &models.TodoTemplate{
+		UserID:        userID,
+		Name:          input.Name,
+		Title:         input.Title,
+		Content:       null.StringFromPtr(input.Content),
+		DueOffsetDays: null.IntFromPtr(input.DueOffsetDays),
+		Tags:          types.JSON(tags),
+	}
+	if input.Priority != nil {
+		template.Priority = todoPriorityValue(*input.Priority)
+	}
+	if err := template.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+
+	subtasks := models.TodoTemplateSubtaskSlice{}
+	for i, input := range input.Subtasks {
+		subtask := &models.TodoTemplateSubtask{
+			Title:         input.Title,
+			Content:       null.StringFromPtr(input.Content),
+			DueOffsetDays: null.IntFromPtr(input.DueOffsetDays),
+			SortOrder:     i,
+		}
+		subtasks = append(subtasks, subtask)
+	}
+	if err := template.AddTodoTemplateSubtasks(ctx, exec, true, subtasks...); err != nil {
+		return nil, view.NewInternalServerErrorView(err)
+	}
+	return template, nil
+}
+
+// NOTE: 基準日からの日数で期日を求める(日数が未指定の場合は期日なし)
+func dueDateFromOffset(startDate time.Time, offsetDays null.Int) null.Time {
+	if !offsetDays.Valid {
+		return null.Time{}
+	}
+	return null.TimeFrom(startDate.AddDate(0, 0, offsetDays.Int))
+}
+
+// NOTE: 期日が基準日から何日後かを返す(期日を過ぎている場合は0日後とする)
+func dueOffsetDays(dueDate null.Time, today time.Time) *int {
+	if !dueDate.Valid {
+		return nil
+	}
+
+	days := max(int(math.Round(startOfDay(dueDate.Time).Sub(today).Hours()/24)), 0)
+	return &days
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
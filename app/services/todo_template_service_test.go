@@ -0,0 +1,150 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/test/factories"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TestTodoTemplateServiceSuite struct {
+	WithDBSuite
+	broker *TodoChangeBroker
+}
+
+var testTodoTemplateService TodoTemplateService
+
+func (s *TestTodoTemplateServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	s.broker = NewTodoChangeBroker()
+	testTodoTemplateService = NewTodoTemplateService(DBCon, s.broker)
+	testTodoService = newTestTodoService(s.T())
+}
+
+func (s *TestTodoTemplateServiceSuite) TearDownTest() {
+	s.CloseDB()
+}
+
+func (s *TestTodoTemplateServiceSuite) createReleaseTemplate() *models.TodoTemplate {
+	dueOffsetDays, subtaskOffsetDays := 7, 3
+	template, err := testTodoTemplateService.CreateTodoTemplate(ctx, model.CreateTodoTemplateInput{
+		Name:          "リリース",
+		Title:         "リリース作業",
+		DueOffsetDays: &dueOffsetDays,
+		Tags:          []string{"release"},
+		Subtasks: []*model.TodoTemplateSubtaskInput{
+			{Title: "リリースノート作成", DueOffsetDays: &subtaskOffsetDays},
+			{Title: "動作確認"},
+		},
+	}, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test templates %v", err)
+	}
+	return template
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoTemplate() {
+	s.createReleaseTemplate()
+
+	templates, err := testTodoTemplateService.FetchTodoTemplates(ctx, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), templates, 1)
+	assert.Len(s.T(), templates[0].R.TodoTemplateSubtasks, 2)
+	assert.Equal(s.T(), "リリースノート作成", templates[0].R.TodoTemplateSubtasks[0].Title)
+	tags, _ := TodoTemplateTags(templates[0])
+	assert.Equal(s.T(), []string{"release"}, tags)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoTemplate_ValidationError() {
+	dueOffsetDays := -1
+	_, err := testTodoTemplateService.CreateTodoTemplate(ctx, model.CreateTodoTemplateInput{
+		Name:          "",
+		Title:         "title",
+		DueOffsetDays: &dueOffsetDays,
+		Subtasks:      []*model.TodoTemplateSubtaskInput{{Title: ""}},
+	}, user.ID)
+
+	assert.NotNil(s.T(), err)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoFromTemplate() {
+	template := s.createReleaseTemplate()
+	title, startDate := "v1.2.0 リリース作業", "2024-12-02"
+
+	todo, err := testTodoTemplateService.CreateTodoFromTemplate(ctx, template.ID, &model.TodoTemplateOverrides{Title: &title, StartDate: &startDate}, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), title, todo.Title)
+	assert.Equal(s.T(), "2024-12-09", todo.DueDate.Time.Format(dueDateLayout))
+	tags, _ := testTodoService.FetchTodoTags(ctx, todo.ID)
+	assert.Equal(s.T(), []string{"release"}, tags)
+
+	// NOTE: サブタスクは作成したTodoの先行Todoとして作成されることの確認
+	subtasks, _ := testTodoService.FetchBlockers(ctx, todo.ID)
+	assert.Len(s.T(), subtasks, 2)
+	assert.Equal(s.T(), "リリースノート作成", subtasks[0].Title)
+	assert.Equal(s.T(), "2024-12-05", subtasks[0].DueDate.Time.Format(dueDateLayout))
+	assert.False(s.T(), subtasks[1].DueDate.Valid)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestCreateTodoFromTemplate_PublishesTodoChanges() {
+	template := s.createReleaseTemplate()
+	subscriptionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	changes := s.broker.Subscribe(subscriptionCtx)
+
+	todo, err := testTodoTemplateService.CreateTodoFromTemplate(ctx, template.ID, nil, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	// NOTE: 作成したTodoとサブタスクがすべて作成として配信されることの確認
+	ids := []int{}
+	for range 3 {
+		change := receivePublishedTodoChange(s.T(), changes)
+		assert.Equal(s.T(), model.TodoChangeActionCreated, change.Event.Action)
+		ids = append(ids, change.Event.Todo.ID)
+	}
+	assert.Contains(s.T(), ids, todo.ID)
+}
+
+func (s *TestTodoTemplateServiceSuite) TestSaveTodoAsTemplate() {
+	todos := []*models.Todo{}
+	for _, title := range []string{"オンボーディング", "アカウント発行"} {
+		todo, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: title, Content: "", Tags: []string{"onboarding"}}, user.ID)
+		if err != nil {
+			s.T().Fatalf("failed to create test todos %v", err)
+		}
+		todos = append(todos, todo)
+	}
+	if _, err := testTodoService.AddTodoDependency(ctx, todos[0].ID, todos[1].ID, user.ID); err != nil {
+		s.T().Fatalf("failed to add test dependencies %v", err)
+	}
+
+	template, err := testTodoTemplateService.SaveTodoAsTemplate(ctx, todos[0].ID, "オンボーディング", user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), "オンボーディング", template.Title)
+	subtasks, _ := models.TodoTemplateSubtasks(qm.Where("todo_template_id = ?", template.ID)).All(ctx, DBCon)
+	assert.Len(s.T(), subtasks, 1)
+	assert.Equal(s.T(), "アカウント発行", subtasks[0].Title)
+}
+
+func TestTodoTemplateService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestTodoTemplateServiceSuite))
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	models "app/models/generated"
+	"app/view"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (ts *todoService) FetchTrashedTodos(ctx context.Context, userID int) ([]*models.Todo, error) {
+	todos, err := models.Todos(
+		qm.WithDeleted(),
+		qm.Where("user_id = ? AND deleted_at IS NOT NULL", userID),
+		qm.OrderBy("deleted_at DESC, id DESC"),
+	).All(ctx, ts.db)
+	if err != nil {
+		return models.TodoSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return todos, nil
+}
+
+func (ts *todoService) RestoreTodo(ctx context.Context, id int, userID int) (*models.Todo, error) {
+	ctx, collected := withTodoChanges(ctx)
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	defer tx.Rollback()
+
+	todo, err := models.Todos(
+		qm.WithDeleted(),
+		qm.Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		return &models.Todo{}, view.NewNotFoundView(err)
+	}
+
+	// NOTE: 削除前の順位のまま復元する
+	todo.DeletedAt = null.Time{}
+	todo.Version++
+	if _, err := todo.Update(ctx, tx, boil.Whitelist(models.TodoColumns.DeletedAt, models.TodoColumns.Version, models.TodoColumns.UpdatedAt)); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return &models.Todo{}, view.NewInternalServerErrorView(err)
+	}
+	publishTodoChanges(ts.broker, collected)
+	return todo, nil
+}
+
+// NOTE: ゴミ箱内のTodoを完全に削除し、削除件数を返す(コメント等の関連データは外部キーにより削除される)
+func (ts *todoService) EmptyTrash(ctx context.Context, userID int) (int, error) {
+	purged, err := ts.purgeTodos(ctx, qm.Where("user_id = ? AND deleted_at IS NOT NULL", userID))
+	if err != nil {
+		return purged, view.NewInternalServerErrorView(err)
+	}
+	return purged, nil
+}
+
+// NOTE: 保持期間を過ぎたゴミ箱内のTodoを全ユーザ分完全に削除する(定期実行用)
+func (ts *todoService) PurgeTrashedTodos(ctx context.Context, deletedBefore time.Time) (int, error) {
+	return ts.purgeTodos(ctx, qm.Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore))
+}
+
+// NOTE: 条件に該当するTodoを完全に削除する
+// 添付ファイルは外部キーにより行のみ削除されるため、ストレージ上のファイルはコミット後に削除する
+func (ts *todoService) purgeTodos(ctx context.Context, condition qm.QueryMod) (int, error) {
+	tx, err := ts.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	todos, err := models.Todos(qm.WithDeleted(), condition, qm.Select(models.TodoColumns.ID), qm.For("UPDATE")).All(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if len(todos) == 0 {
+		return 0, nil
+	}
+	ids := make([]interface{}, 0, len(todos))
+	for _, todo := range todos {
+		ids = append(ids, todo.ID)
+	}
+
+	attachments, err := models.Attachments(qm.WhereIn("todo_id IN ?", ids...)).All(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	rowsAff, err := models.Todos(qm.WithDeleted(), qm.WhereIn("id IN ?", ids...)).DeleteAll(ctx, tx, true)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	// NOTE: 一部のファイルの削除に失敗しても残りのファイルは削除する
+	var errs []error
+	for _, attachment := range attachments {
+		if err := ts.storage.Delete(ctx, attachment.StorageKey); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return int(rowsAff), errors.Join(errs...)
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"app/graph/model"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type workloadRow struct {
+	Date            string   `boil:"date"`
+	AssigneeID      null.Int `boil:"assignee_id"`
+	EstimateMinutes int      `boil:"estimate_minutes"`
+	TodoCount       int      `boil:"todo_count"`
+}
+
+// NOTE: 期日が期間内(from ~ to)の未完了のTodoの見積もりを、期日・担当者ごとに集計する
+// 対象はログインユーザが所有するTodo(共同作業者に割り当てたものを含む)とし、見積もりが未設定のTodoは集計の対象外とする
+func (ts *todoService) FetchWorkload(ctx context.Context, userID int, from string, to string) ([]*model.WorkloadDay, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateWorkload(from, to)
+	if validationErrors != nil {
+		return []*model.WorkloadDay{}, view.NewBadRequestView(validationErrors)
+	}
+
+	// NOTE: Todoを全件取得せず、集計はDB側で行う(担当者が未設定のものが先に並ぶ)
+	rows := []*workloadRow{}
+	err := queries.Raw(`
+		SELECT DATE_FORMAT(due_date, '%Y-%m-%d') AS date, assignee_id, SUM(estimate_minutes) AS estimate_minutes, COUNT(*) AS todo_count
+		FROM todos
+		WHERE user_id = ? AND due_date BETWEEN ? AND ?
+			AND estimate_minutes IS NOT NULL
+			AND completed_at IS NULL AND archived_at IS NULL AND deleted_at IS NULL
+		GROUP BY due_date, assignee_id
+		ORDER BY due_date ASC, assignee_id ASC`,
+		userID, from, to,
+	).Bind(ctx, ts.db, &rows)
+	if err != nil {
+		return []*model.WorkloadDay{}, view.NewInternalServerErrorView(err)
+	}
+
+	assigneeIDs := []interface{}{}
+	for _, row := range rows {
+		if row.AssigneeID.Valid {
+			assigneeIDs = append(assigneeIDs, row.AssigneeID.Int)
+		}
+	}
+	assignees := map[int]*models.User{}
+	if len(assigneeIDs) > 0 {
+		users, err := models.Users(qm.WhereIn("id IN ?", assigneeIDs...)).All(ctx, ts.db)
+		if err != nil {
+			return []*model.WorkloadDay{}, view.NewInternalServerErrorView(err)
+		}
+		for _, u := range users {
+			assignees[u.ID] = u
+		}
+	}
+
+	workload := make([]*model.WorkloadDay, 0, len(rows))
+	for _, row := range rows {
+		day := &model.WorkloadDay{
+			Date:            row.Date,
+			EstimateMinutes: row.EstimateMinutes,
+			TodoCount:       row.TodoCount,
+		}
+		if row.AssigneeID.Valid {
+			day.Assignee = assignees[row.AssigneeID.Int]
+		}
+		workload = append(workload, day)
+	}
+	return workload, nil
+}
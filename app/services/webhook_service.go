@@ -0,0 +1,356 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/webhook"
+	models "app/models/generated"
+	"app/validator"
+	"app/view"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+const (
+	webhookEventTodoCreated   = "todo.created"
+	webhookEventTodoUpdated   = "todo.updated"
+	webhookEventTodoCompleted = "todo.completed"
+	webhookEventTodoDeleted   = "todo.deleted"
+
+	webhookDeliveryStatusPending   = "pending"
+	webhookDeliveryStatusSucceeded = "succeeded"
+	webhookDeliveryStatusFailed    = "failed"
+
+	webhookDeliveryBatchSize   = 20
+	webhookDeliveryMaxAttempts = 8
+	// NOTE: 1回の実行でバッチ全件の送信がタイムアウトしても、他のサーバが再送しない長さにする
+	webhookDeliveryLease = 5 * time.Minute
+)
+
+// NOTE: イベントはDBと送信するペイロードに "todo.created" 形式の文字列で保存する
+var webhookEventTypes = map[model.WebhookEvent]string{
+	model.WebhookEventTodoCreated:   webhookEventTodoCreated,
+	model.WebhookEventTodoUpdated:   webhookEventTodoUpdated,
+	model.WebhookEventTodoCompleted: webhookEventTodoCompleted,
+	model.WebhookEventTodoDeleted:   webhookEventTodoDeleted,
+}
+
+var webhookDeliveryStatuses = map[string]model.WebhookDeliveryStatus{
+	webhookDeliveryStatusPending:   model.WebhookDeliveryStatusPending,
+	webhookDeliveryStatusSucceeded: model.WebhookDeliveryStatusSucceeded,
+	webhookDeliveryStatusFailed:    model.WebhookDeliveryStatusFailed,
+}
+
+type webhookTodo struct {
+	ID          int                `json:"id"`
+	Title       string             `json:"title"`
+	Content     *string            `json:"content"`
+	DueDate     *string            `json:"dueDate"`
+	Priority    model.TodoPriority `json:"priority"`
+	ProjectID   *int               `json:"projectId"`
+	CompletedAt *string            `json:"completedAt"`
+	ArchivedAt  *string            `json:"archivedAt"`
+	CreatedAt   string             `json:"createdAt"`
+	UpdatedAt   string             `json:"updatedAt"`
+}
+
+type webhookPayload struct {
+	Event      string      `json:"event"`
+	OccurredAt string      `json:"occurredAt"`
+	Todo       webhookTodo `json:"todo"`
+}
+
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, url string, events []model.WebhookEvent, secret string, userID int) (*models.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int, userID int) (string, error)
+	FetchWebhooks(ctx context.Context, userID int) ([]*models.Webhook, error)
+	FetchWebhookDeliveries(ctx context.Context, webhookID int, userID int, first *int, after *string) (*model.WebhookDeliveryConnection, error)
+	DeliverPendingWebhooks(ctx context.Context, now time.Time) (int, error)
+}
+
+type webhookService struct {
+	db     *sql.DB
+	sender webhook.Sender
+}
+
+func NewWebhookService(db *sql.DB, sender webhook.Sender) WebhookService {
+	return &webhookService{db, sender}
+}
+
+func (ws *webhookService) CreateWebhook(ctx context.Context, url string, events []model.WebhookEvent, secret string, userID int) (*models.Webhook, error) {
+	// NOTE: バリデーションチェック
+	validationErrors := validator.ValidateCreateWebhook(url, events, secret)
+	if validationErrors != nil {
+		return &models.Webhook{}, view.NewBadRequestView(validationErrors)
+	}
+
+	eventTypes := make([]string, 0, len(events))
+	for _, event := range events {
+		eventTypes = append(eventTypes, webhookEventTypes[event])
+	}
+	encoded, err := json.Marshal(eventTypes)
+	if err != nil {
+		return &models.Webhook{}, view.NewInternalServerErrorView(err)
+	}
+
+	hook := &models.Webhook{UserID: userID, URL: url, Events: types.JSON(encoded), Secret: secret}
+	if err := hook.Insert(ctx, ws.db, boil.Infer()); err != nil {
+		return &models.Webhook{}, view.NewInternalServerErrorView(err)
+	}
+	return hook, nil
+}
+
+// NOTE: 未送信の配信も含めて、配信履歴はまとめて削除される
+func (ws *webhookService) DeleteWebhook(ctx context.Context, id int, userID int) (string, error) {
+	hook, err := models.Webhooks(qm.Where("id = ? AND user_id = ?", id, userID)).One(ctx, ws.db)
+	if err != nil {
+		return strconv.Itoa(id), view.NewNotFoundView(err)
+	}
+
+	if _, err := hook.Delete(ctx, ws.db); err != nil {
+		return strconv.Itoa(id), view.NewInternalServerErrorView(err)
+	}
+	return strconv.Itoa(id), nil
+}
+
+func (ws *webhookService) FetchWebhooks(ctx context.Context, userID int) ([]*models.Webhook, error) {
+	hooks, err := models.Webhooks(
+		qm.Where("user_id = ?", userID),
+		qm.OrderBy("id ASC"),
+	).All(ctx, ws.db)
+	if err != nil {
+		return models.WebhookSlice{}, view.NewInternalServerErrorView(err)
+	}
+	return hooks, nil
+}
+
+// NOTE: 配信履歴を新しいものから順に返す
+func (ws *webhookService) FetchWebhookDeliveries(ctx context.Context, webhookID int, userID int, first *int, after *string) (*model.WebhookDeliveryConnection, error) {
+	exists, err := models.Webhooks(qm.Where("id = ? AND user_id = ?", webhookID, userID)).Exists(ctx, ws.db)
+	if err != nil {
+		return &model.WebhookDeliveryConnection{}, view.NewInternalServerErrorView(err)
+	}
+	if !exists {
+		return &model.WebhookDeliveryConnection{}, view.NewNotFoundView(fmt.Errorf("Webhookが見つかりません。"))
+	}
+
+	limit := pageSize(first)
+	mods := []qm.QueryMod{qm.Where("webhook_id = ?", webhookID)}
+
+	totalCount, err := models.WebhookDeliveries(mods...).Count(ctx, ws.db)
+	if err != nil {
+		return &model.WebhookDeliveryConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	if after != nil {
+		afterID, err := decodeCursor(*after)
+		if err != nil {
+			return &model.WebhookDeliveryConnection{}, view.NewBadRequestView(err)
+		}
+		mods = append(mods, qm.Where("id < ?", afterID))
+	}
+	// NOTE: 次ページの有無を判定するため1件多く取得する
+	mods = append(mods, qm.OrderBy("id DESC"), qm.Limit(limit+1))
+	deliveries, err := models.WebhookDeliveries(mods...).All(ctx, ws.db)
+	if err != nil {
+		return &model.WebhookDeliveryConnection{}, view.NewInternalServerErrorView(err)
+	}
+
+	hasNextPage := len(deliveries) > limit
+	if hasNextPage {
+		deliveries = deliveries[:limit]
+	}
+
+	edges := make([]*model.WebhookDeliveryEdge, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		edges = append(edges, &model.WebhookDeliveryEdge{Cursor: encodeCursor(delivery.ID), Node: delivery})
+	}
+	pageInfo := &model.PageInfo{HasNextPage: hasNextPage, HasPreviousPage: after != nil}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.WebhookDeliveryConnection{Edges: edges, PageInfo: pageInfo, TotalCount: int(totalCount)}, nil
+}
+
+// NOTE: 送信予定時刻を過ぎた未送信の配信を送信し、送信に成功した件数を返す
+// 失敗した場合は指数関数的に間隔を空けて再送し、上限回数に達したものは失敗として記録する
+// 送信中に行ロックを保持しないよう、対象行を確保してからトランザクションの外で送信し、結果は1件ずつ記録する
+func (ws *webhookService) DeliverPendingWebhooks(ctx context.Context, now time.Time) (int, error) {
+	deliveries, err := claimWebhookDeliveries(ctx, ws.db, now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, delivery := range deliveries {
+		hook := delivery.R.Webhook
+		status, err := ws.sender.Send(ctx, webhook.Request{
+			URL:        hook.URL,
+			Secret:     hook.Secret,
+			Event:      delivery.Event,
+			DeliveryID: delivery.ID,
+			Body:       delivery.Payload,
+		})
+
+		delivery.Attempts++
+		delivery.ResponseStatus = null.NewInt(status, status > 0)
+		if err == nil {
+			delivery.Status = webhookDeliveryStatusSucceeded
+			delivery.DeliveredAt = null.TimeFrom(now)
+			delivery.LastError = null.String{}
+			sent++
+		} else {
+			delivery.LastError = null.StringFrom(err.Error())
+			if delivery.Attempts >= webhookDeliveryMaxAttempts {
+				delivery.Status = webhookDeliveryStatusFailed
+			} else {
+				delivery.NextAttemptAt = now.Add(webhook.Backoff(delivery.Attempts))
+			}
+		}
+		if _, err := delivery.Update(ctx, ws.db, boil.Whitelist(
+			models.WebhookDeliveryColumns.Status,
+			models.WebhookDeliveryColumns.Attempts,
+			models.WebhookDeliveryColumns.NextAttemptAt,
+			models.WebhookDeliveryColumns.ResponseStatus,
+			models.WebhookDeliveryColumns.LastError,
+			models.WebhookDeliveryColumns.DeliveredAt,
+			models.WebhookDeliveryColumns.UpdatedAt,
+		)); err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// NOTE: 送信予定時刻を過ぎた未送信の配信を取得し、送信予定時刻を猶予期間だけ先へ進めて確保する
+// 複数のサーバで同時に実行されても二重送信されないよう、対象行をSKIP LOCKEDで排他的に取得する
+// 送信結果を記録する前にプロセスが停止した場合も、猶予期間が過ぎれば再送される
+func claimWebhookDeliveries(ctx context.Context, db *sql.DB, now time.Time) (models.WebhookDeliverySlice, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	deliveries, err := models.WebhookDeliveries(
+		qm.Where("status = ? AND next_attempt_at <= ?", webhookDeliveryStatusPending, now),
+		qm.OrderBy("next_attempt_at ASC, id ASC"),
+		qm.Limit(webhookDeliveryBatchSize),
+		qm.For("UPDATE OF webhook_deliveries SKIP LOCKED"),
+		qm.Load(models.WebhookDeliveryRels.Webhook),
+	).All(ctx, tx)
+	if err != nil || len(deliveries) == 0 {
+		return nil, err
+	}
+
+	if _, err := deliveries.UpdateAll(ctx, tx, models.M{
+		models.WebhookDeliveryColumns.NextAttemptAt: now.Add(webhookDeliveryLease),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// NOTE: Todoの所有者が登録した、イベントを購読しているWebhookへの配信を予約する
+// Todoの変更と同じトランザクションで登録するため、ロールバックされた変更は配信されない
+func enqueueTodoWebhooks(ctx context.Context, exec boil.ContextExecutor, todo *models.Todo, event string) error {
+	hooks, err := models.Webhooks(
+		qm.Where("user_id = ? AND JSON_CONTAINS(events, JSON_QUOTE(?))", todo.UserID, event),
+	).All(ctx, exec)
+	if err != nil || len(hooks) == 0 {
+		return err
+	}
+
+	now := time.Now()
+	encoded, err := json.Marshal(webhookPayload{Event: event, OccurredAt: now.Format(dateTimeLayout), Todo: newWebhookTodo(todo)})
+	if err != nil {
+		return err
+	}
+
+	deliveries := models.WebhookDeliverySlice{}
+	for _, hook := range hooks {
+		deliveries = append(deliveries, &models.WebhookDelivery{
+			WebhookID:     hook.ID,
+			Event:         event,
+			Payload:       types.JSON(encoded),
+			Status:        webhookDeliveryStatusPending,
+			NextAttemptAt: now,
+		})
+	}
+	_, err = deliveries.InsertAll(ctx, exec, boil.Infer())
+	return err
+}
+
+// NOTE: 変更履歴の操作と差分から、Webhookのイベントを決定する(未完了から完了になった場合は完了とする)
+func todoWebhookEvent(action string, changes []*model.TodoFieldChange) string {
+	switch action {
+	case todoRevisionActionCreate:
+		return webhookEventTodoCreated
+	case todoRevisionActionDelete:
+		return webhookEventTodoDeleted
+	}
+	for _, change := range changes {
+		if change.Field == models.TodoColumns.CompletedAt && change.OldValue == nil && change.NewValue != nil {
+			return webhookEventTodoCompleted
+		}
+	}
+	return webhookEventTodoUpdated
+}
+
+func newWebhookTodo(todo *models.Todo) webhookTodo {
+	return webhookTodo{
+		ID:          todo.ID,
+		Title:       todo.Title,
+		Content:     todo.Content.Ptr(),
+		DueDate:     formatNullTime(todo.DueDate, dueDateLayout),
+		Priority:    TodoPriorityFromValue(todo.Priority),
+		ProjectID:   todo.ProjectID.Ptr(),
+		CompletedAt: formatNullTime(todo.CompletedAt, dateTimeLayout),
+		ArchivedAt:  formatNullTime(todo.ArchivedAt, dateTimeLayout),
+		CreatedAt:   todo.CreatedAt.Format(dateTimeLayout),
+		UpdatedAt:   todo.UpdatedAt.Format(dateTimeLayout),
+	}
+}
+
+// NOTE: 購読しているイベントをGraphQLの型へ変換する
+func WebhookEvents(hook *models.Webhook) ([]model.WebhookEvent, error) {
+	eventTypes := []string{}
+	if err := hook.Events.Unmarshal(&eventTypes); err != nil {
+		return nil, err
+	}
+
+	events := make([]model.WebhookEvent, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		events = append(events, WebhookEventFromType(eventType))
+	}
+	return events, nil
+}
+
+// NOTE: DBに保存されたイベントをGraphQLの型へ変換する
+func WebhookEventFromType(eventType string) model.WebhookEvent {
+	for event, t := range webhookEventTypes {
+		if t == eventType {
+			return event
+		}
+	}
+	return ""
+}
+
+// NOTE: DBに保存された配信状況をGraphQLの型へ変換する
+func WebhookDeliveryStatusOf(delivery *models.WebhookDelivery) model.WebhookDeliveryStatus {
+	return webhookDeliveryStatuses[delivery.Status]
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"app/graph/model"
+	"app/lib/webhook"
+	models "app/models/generated"
+	"app/test/factories"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const testWebhookSecret = "test-webhook-secret"
+
+type TestWebhookServiceSuite struct {
+	WithDBSuite
+	receiver *httptest.Server
+	status   int
+	mu       sync.Mutex
+	received []webhookPayload
+}
+
+var testWebhookService WebhookService
+
+func (s *TestWebhookServiceSuite) SetupTest() {
+	s.SetDBCon()
+
+	// NOTE: テスト用ユーザの作成
+	user = factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test@example.com"}).(*models.User)
+	if err := user.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+
+	// NOTE: 署名を検証し、受信したペイロードを記録するテスト用の受信サーバ
+	s.status = http.StatusNoContent
+	s.received = nil
+	s.receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(webhook.TimestampHeader), 10, 64)
+		if !webhook.Verify(testWebhookSecret, timestamp, body, r.Header.Get(webhook.SignatureHeader)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		payload := webhookPayload{}
+		_ = json.Unmarshal(body, &payload)
+		s.received = append(s.received, payload)
+		w.WriteHeader(s.status)
+	}))
+
+	// NOTE: テスト用の受信サーバはループバックアドレスで待ち受けるため、接続先を制限しない送信を使用する
+	testWebhookService = NewWebhookService(DBCon, webhook.NewSenderWithClient(s.receiver.Client()))
+	testTodoService = newTestTodoService(s.T())
+}
+
+func (s *TestWebhookServiceSuite) TearDownTest() {
+	s.receiver.Close()
+	s.CloseDB()
+}
+
+func (s *TestWebhookServiceSuite) createWebhook(events ...model.WebhookEvent) *models.Webhook {
+	hook, err := testWebhookService.CreateWebhook(ctx, s.receiver.URL, events, testWebhookSecret, user.ID)
+	if err != nil {
+		s.T().Fatalf("failed to create test webhooks %v", err)
+	}
+	return hook
+}
+
+func (s *TestWebhookServiceSuite) TestCreateWebhook() {
+	hook := s.createWebhook(model.WebhookEventTodoCreated, model.WebhookEventTodoCompleted)
+
+	hooks, err := testWebhookService.FetchWebhooks(ctx, user.ID)
+
+	assert.Nil(s.T(), err)
+	assert.Len(s.T(), hooks, 1)
+	events, _ := WebhookEvents(hooks[0])
+	assert.Equal(s.T(), []model.WebhookEvent{model.WebhookEventTodoCreated, model.WebhookEventTodoCompleted}, events)
+	assert.Equal(s.T(), hook.ID, hooks[0].ID)
+}
+
+func (s *TestWebhookServiceSuite) TestCreateWebhook_ValidationError() {
+	for _, input := range []struct {
+		url    string
+		events []model.WebhookEvent
+		secret string
+	}{
+		{"ftp://example.com", []model.WebhookEvent{model.WebhookEventTodoCreated}, testWebhookSecret},
+		{"https://example.com", []model.WebhookEvent{}, testWebhookSecret},
+		{"https://example.com", []model.WebhookEvent{model.WebhookEventTodoCreated, model.WebhookEventTodoCreated}, testWebhookSecret},
+		{"https://example.com", []model.WebhookEvent{model.WebhookEventTodoCreated}, "short"},
+	} {
+		_, err := testWebhookService.CreateWebhook(ctx, input.url, input.events, input.secret, user.ID)
+		assert.NotNil(s.T(), err, input.url)
+	}
+}
+
+func (s *TestWebhookServiceSuite) TestDeliverPendingWebhooks() {
+	hook := s.createWebhook(model.WebhookEventTodoCreated, model.WebhookEventTodoCompleted)
+	todo, _ := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID)
+	// NOTE: 購読していないイベント(更新)は配信されないことの確認
+	if _, err := testTodoService.UpdateTodo(ctx, todo.ID, model.UpdateTodoInput{Title: omittableString("b"), ExpectedVersion: todo.Version}, user.ID); err != nil {
+		s.T().Fatalf("failed to update test todos %v", err)
+	}
+	if _, err := testTodoService.CompleteTodo(ctx, todo.ID, false, user.ID); err != nil {
+		s.T().Fatalf("failed to complete test todos %v", err)
+	}
+
+	sent, err := testWebhookService.DeliverPendingWebhooks(ctx, time.Now())
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 2, sent)
+	assert.Len(s.T(), s.received, 2)
+	assert.Equal(s.T(), webhookEventTodoCreated, s.received[0].Event)
+	assert.Equal(s.T(), webhookEventTodoCompleted, s.received[1].Event)
+	assert.Equal(s.T(), "b", s.received[1].Todo.Title)
+	assert.NotNil(s.T(), s.received[1].Todo.CompletedAt)
+
+	connection, _ := testWebhookService.FetchWebhookDeliveries(ctx, hook.ID, user.ID, nil, nil)
+	assert.Equal(s.T(), 2, connection.TotalCount)
+	for _, edge := range connection.Edges {
+		assert.Equal(s.T(), model.WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusOf(edge.Node))
+		assert.Equal(s.T(), http.StatusNoContent, edge.Node.ResponseStatus.Int)
+	}
+}
+
+func (s *TestWebhookServiceSuite) TestDeliverPendingWebhooks_Retry() {
+	s.createWebhook(model.WebhookEventTodoCreated)
+	if _, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	s.status = http.StatusServiceUnavailable
+	now := time.Now()
+
+	sent, err := testWebhookService.DeliverPendingWebhooks(ctx, now)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, sent)
+	delivery, _ := models.WebhookDeliveries(qm.OrderBy("id DESC")).One(ctx, DBCon)
+	assert.Equal(s.T(), webhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(s.T(), 1, delivery.Attempts)
+	assert.Equal(s.T(), http.StatusServiceUnavailable, delivery.ResponseStatus.Int)
+
+	// NOTE: 再送までの待ち時間が経過するまでは送信しないことの確認
+	_, _ = testWebhookService.DeliverPendingWebhooks(ctx, now.Add(webhook.Backoff(1)-time.Second))
+	assert.Len(s.T(), s.received, 1)
+
+	// NOTE: 上限回数まで失敗した場合は失敗として記録されることの確認
+	for attempt := 1; attempt < webhookDeliveryMaxAttempts; attempt++ {
+		now = now.Add(webhook.Backoff(attempt))
+		_, _ = testWebhookService.DeliverPendingWebhooks(ctx, now)
+	}
+	_ = delivery.Reload(ctx, DBCon)
+	assert.Equal(s.T(), webhookDeliveryStatusFailed, delivery.Status)
+	assert.Equal(s.T(), webhookDeliveryMaxAttempts, delivery.Attempts)
+	assert.Len(s.T(), s.received, webhookDeliveryMaxAttempts)
+}
+
+func (s *TestWebhookServiceSuite) TestDeliverPendingWebhooks_Claimed() {
+	s.createWebhook(model.WebhookEventTodoCreated)
+	if _, err := testTodoService.CreateTodo(ctx, model.CreateTodoInput{Title: "a", Content: ""}, user.ID); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+	now := time.Now()
+	claimed, err := claimWebhookDeliveries(ctx, DBCon, now)
+	if err != nil {
+		s.T().Fatalf("failed to claim test deliveries %v", err)
+	}
+
+	// NOTE: 他のサーバが確保した配信は、猶予期間が過ぎるまで送信しないことの確認
+	sent, err := testWebhookService.DeliverPendingWebhooks(ctx, now)
+
+	assert.Nil(s.T(), err)
+	assert.Equal(s.T(), 0, sent)
+	assert.Len(s.T(), claimed, 1)
+	assert.Len(s.T(), s.received, 0)
+
+	sent, _ = testWebhookService.DeliverPendingWebhooks(ctx, now.Add(webhookDeliveryLease))
+	assert.Equal(s.T(), 1, sent)
+	assert.Len(s.T(), s.received, 1)
+}
+
+func (s *TestWebhookServiceSuite) TestFetchWebhookDeliveries_NotFound() {
+	otherUser := factories.UserFactory.MustCreateWithOption(map[string]interface{}{"Email": "test_2@example.com"}).(*models.User)
+	if err := otherUser.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test user %v", err)
+	}
+	hook := s.createWebhook(model.WebhookEventTodoCreated)
+
+	_, err := testWebhookService.FetchWebhookDeliveries(ctx, hook.ID, otherUser.ID, nil, nil)
+
+	assert.NotNil(s.T(), err)
+}
+
+func TestWebhookService(t *testing.T) {
+	// テストスイートを実行
+	suite.Run(t, new(TestWebhookServiceSuite))
+}
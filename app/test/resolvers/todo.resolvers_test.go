@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -368,6 +369,7 @@ func (s *TestTodoResolverSuite) TestUpdateTodo_Unauthorized() {
             updateTodo(id: ` + id + `, input: {
                 title: "test updated title 1",
                 content: "test updated content 1",
+                expectedVersion: 1,
             }) {
                 id,
                 title,
@@ -405,6 +407,7 @@ func (s *TestTodoResolverSuite) TestUpdateTodo() {
             updateTodo(id: ` + id + `, input: {
                 title: "test updated title 1",
                 content: "test updated content 1",
+                expectedVersion: 1,
             }) {
                 id,
                 title,
@@ -454,6 +457,7 @@ func (s *TestTodoResolverSuite) TestUpdateTodo_NotFound() {
             updateTodo(id: ` + id + `, input: {
                 title: "test updated title 1",
                 content: "test updated content 1",
+                expectedVersion: 1,
             }) {
                 id,
                 title,
@@ -476,6 +480,49 @@ func (s *TestTodoResolverSuite) TestUpdateTodo_NotFound() {
 	assert.Equal(s.T(), float64(404), responseBody["errors"][0]["extensions"]["code"])
 }
 
+func (s *TestTodoResolverSuite) TestUpdateTodo_Conflict() {
+	s.SetAuthUser()
+	s.SignIn()
+
+	// NOTE: 他の画面で既に更新され、versionが進んでいる状態
+	testTodo := models.Todo{Title: "test title 1", Content: null.String{String: "test content 1", Valid: true}, UserID: user.ID, Version: 2}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	id := strconv.Itoa(testTodo.ID)
+	query := map[string]interface{}{
+		"query": `mutation {
+            updateTodo(id: ` + id + `, input: {
+                title: "test updated title 1",
+                content: "test updated content 1",
+                expectedVersion: 1,
+            }) {
+                id,
+                title,
+                content,
+                version
+            }
+        }`,
+	}
+
+	signUpRequestBody, _ := json.Marshal(query)
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(signUpRequestBody)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Cookie", "token="+token)
+	testTodoGraphQLServerHandler.ServeHTTP(res, req)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string]([1]map[string]map[string]interface{}))
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.Equal(s.T(), float64(409), responseBody["errors"][0]["extensions"]["code"])
+	// NOTE: サーバ側の最新の状態が返却されることの確認
+	current := responseBody["errors"][0]["extensions"]["current"].(map[string]interface{})
+	assert.Equal(s.T(), "test title 1", current["title"])
+	assert.Equal(s.T(), float64(2), current["version"])
+}
+
 func (s *TestTodoResolverSuite) TestUpdateTodo_ValidationError() {
 	s.SetAuthUser()
 	s.SignIn()
@@ -492,6 +539,7 @@ func (s *TestTodoResolverSuite) TestUpdateTodo_ValidationError() {
             updateTodo(id: ` + id + `, input: {
                 title: "",
                 content: "test updated content 1",
+                expectedVersion: 1,
             }) {
                 id,
                 title,
@@ -606,6 +654,55 @@ func (s *TestTodoResolverSuite) TestDeleteTodo_NotFound() {
 	assert.Equal(s.T(), float64(404), responseBody["errors"][0]["extensions"]["code"])
 }
 
+func (s *TestTodoResolverSuite) TestCompleteTodo() {
+	s.SetAuthUser()
+	s.SignIn()
+
+	testTodo := models.Todo{
+		Title:          "test title 1",
+		Content:        null.String{String: "test content 1", Valid: true},
+		UserID:         user.ID,
+		DueDate:        null.Time{Time: time.Date(2024, 11, 4, 0, 0, 0, 0, time.Local), Valid: true},
+		RecurrenceRule: null.String{String: "FREQ=DAILY;INTERVAL=2", Valid: true},
+	}
+	if err := testTodo.Insert(ctx, DBCon, boil.Infer()); err != nil {
+		s.T().Fatalf("failed to create test todos %v", err)
+	}
+
+	res := httptest.NewRecorder()
+	id := strconv.Itoa(testTodo.ID)
+	query := map[string]interface{}{
+		"query": `mutation {
+            completeTodo(id: ` + id + `) {
+                todo {
+                    id,
+                    completedAt
+                },
+                nextOccurrence {
+                    id,
+                    dueDate,
+                    recurrence {
+                        frequency,
+                        interval
+                    }
+                }
+            }
+        }`,
+	}
+
+	completeTodoRequestBody, _ := json.Marshal(query)
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(completeTodoRequestBody)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Cookie", "token="+token)
+	testTodoGraphQLServerHandler.ServeHTTP(res, req)
+
+	assert.Equal(s.T(), 200, res.Code)
+	responseBody := make(map[string](map[string]map[string]map[string]interface{}))
+	_ = json.Unmarshal(res.Body.Bytes(), &responseBody)
+	assert.NotNil(s.T(), responseBody["data"]["completeTodo"]["todo"]["completedAt"])
+	assert.Equal(s.T(), "2024-11-06", responseBody["data"]["completeTodo"]["nextOccurrence"]["dueDate"])
+}
+
 func TestTodoResolver(t *testing.T) {
 	// テストスイートを実施
 	suite.Run(t, new(TestTodoResolverSuite))
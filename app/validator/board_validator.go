@@ -0,0 +1,27 @@
+package validator
+
+import (
+	"app/graph/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateBoardColumn(input model.BoardColumnInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("列名は必須入力です。"),
+			validation.RuneLength(1, 50).Error("列名は1 ~ 50文字での入力をお願いします。"),
+		),
+		// NOTE: アーカイブ済みのTodoはボードに表示しないため、未完了と完了のみ指定できる
+		validation.Field(
+			&input.Status,
+			validation.In(model.TodoStatusOpen, model.TodoStatusCompleted).Error("列のステータスは未完了または完了での指定をお願いします。"),
+		),
+		validation.Field(
+			&input.WipLimit,
+			validation.Min(1).Error("WIP制限は1 ~ 100での指定をお願いします。"),
+			validation.Max(100).Error("WIP制限は1 ~ 100での指定をお願いします。"),
+		),
+	)
+}
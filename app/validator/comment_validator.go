@@ -0,0 +1,27 @@
+package validator
+
+import (
+	"app/graph/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateAddComment(input model.AddCommentInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Content,
+			validation.Required.Error("コメントは必須入力です。"),
+			validation.RuneLength(1, 1000).Error("コメントは1 ~ 1000文字での入力をお願いします。"),
+		),
+	)
+}
+
+func ValidateEditComment(input model.EditCommentInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Content,
+			validation.Required.Error("コメントは必須入力です。"),
+			validation.RuneLength(1, 1000).Error("コメントは1 ~ 1000文字での入力をお願いします。"),
+		),
+	)
+}
@@ -0,0 +1,27 @@
+package validator
+
+import (
+	"app/graph/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateProject(input model.CreateProjectInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("プロジェクト名は必須入力です。"),
+			validation.RuneLength(1, 50).Error("プロジェクト名は1 ~ 50文字での入力をお願いします。"),
+		),
+	)
+}
+
+func ValidateUpdateProject(input model.UpdateProjectInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("プロジェクト名は必須入力です。"),
+			validation.RuneLength(1, 50).Error("プロジェクト名は1 ~ 50文字での入力をお願いします。"),
+		),
+	)
+}
@@ -0,0 +1,38 @@
+package validator
+
+import (
+	"app/graph/model"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateAddReminder(input model.AddReminderInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.RemindAt,
+			validation.Required.Error("リマインド日時は必須入力です。"),
+			validation.By(validateRemindAt),
+		),
+	)
+}
+
+func validateRemindAt(value interface{}) error {
+	indirect, _ := validation.Indirect(value)
+	remindAt, _ := indirect.(string)
+	if remindAt == "" {
+		return nil
+	}
+
+	// NOTE: 過去日時のリマインダーは即時に送信されてしまうため登録不可とする
+	return validation.Validate(remindAt,
+		validation.Date("2006-01-02 15:04:05").Error("リマインド日時はYYYY-MM-DD hh:mm:ss形式での入力をお願いします。"),
+		validation.By(func(value interface{}) error {
+			t, err := time.ParseInLocation("2006-01-02 15:04:05", remindAt, time.Local)
+			if err == nil && !t.After(time.Now()) {
+				return validation.NewError("validation_remind_at_past", "リマインド日時は未来の日時での入力をお願いします。")
+			}
+			return nil
+		}),
+	)
+}
@@ -0,0 +1,60 @@
+package validator
+
+import (
+	"app/graph/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateSavedView(input model.CreateSavedViewInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("ビュー名は必須入力です。"),
+			validation.RuneLength(1, 50).Error("ビュー名は1 ~ 50文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.Filter,
+			validation.By(func(value interface{}) error {
+				filter, _ := value.(*model.TodoFilter)
+				if filter == nil {
+					return nil
+				}
+				return ValidateTodoFilter(*filter)
+			}),
+		),
+		validation.Field(
+			&input.OrderBy,
+			validation.By(func(value interface{}) error {
+				order, _ := value.(*model.TodoOrder)
+				if order == nil {
+					return nil
+				}
+				return ValidateTodoOrder(*order)
+			}),
+		),
+	)
+}
+
+// NOTE: 保存済みの並び順を読み込む際にも使用するため、列挙型の値も確認する
+func ValidateTodoOrder(input model.TodoOrder) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Field,
+			validation.By(validateEnum(input.Field.IsValid, "並び替えの項目が不正です。")),
+		),
+		validation.Field(
+			&input.Direction,
+			validation.By(validateEnum(input.Direction.IsValid, "並び替えの方向が不正です。")),
+		),
+	)
+}
+
+func validateEnum(isValid func() bool, message string) validation.RuleFunc {
+	return func(value interface{}) error {
+		if !isValid() {
+			return validation.NewError("validation_invalid_enum", message)
+		}
+		return nil
+	}
+}
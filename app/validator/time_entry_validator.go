@@ -0,0 +1,31 @@
+package validator
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateLogTime(duration int, note *string) error {
+	return validation.Errors{
+		"duration": validation.Validate(duration,
+			validation.Min(1).Error("作業時間は1秒 ~ 24時間での入力をお願いします。"),
+			validation.Max(24*60*60).Error("作業時間は1秒 ~ 24時間での入力をお願いします。"),
+		),
+		"note": validation.Validate(note,
+			validation.RuneLength(0, 255).Error("メモは255文字以内での入力をお願いします。"),
+		),
+	}.Filter()
+}
+
+func ValidateTimeReport(from string, to string) error {
+	return validation.Errors{
+		"from": validation.Validate(from,
+			validation.Required.Error("集計の開始日は必須入力です。"),
+			validation.Date("2006-01-02").Error("集計の開始日はYYYY-MM-DD形式での入力をお願いします。"),
+		),
+		"to": validation.Validate(&to,
+			validation.Required.Error("集計の終了日は必須入力です。"),
+			validation.Date("2006-01-02").Error("集計の終了日はYYYY-MM-DD形式での入力をお願いします。"),
+			validation.By(validateRangeOrder("2006-01-02", &from)),
+		),
+	}.Filter()
+}
@@ -0,0 +1,118 @@
+package validator
+
+import (
+	"app/graph/model"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateTodoFilter(input model.TodoFilter) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.TitleContains,
+			validation.RuneLength(0, 100).Error("タイトルの検索条件は100文字以内での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.ContentContains,
+			validation.RuneLength(0, 100).Error("内容の検索条件は100文字以内での入力をお願いします。"),
+		),
+		// NOTE: 保存済みの絞り込み条件を読み込む際にも使用するため、列挙型の値も確認する
+		validation.Field(
+			&input.Statuses,
+			validation.Each(validation.By(func(value interface{}) error {
+				status, _ := value.(model.TodoStatus)
+				return validateEnum(status.IsValid, "ステータスが不正です。")(value)
+			})),
+		),
+		validation.Field(
+			&input.Priorities,
+			validation.Each(validation.By(func(value interface{}) error {
+				priority, _ := value.(model.TodoPriority)
+				return validateEnum(priority.IsValid, "優先度が不正です。")(value)
+			})),
+		),
+		validation.Field(
+			&input.CreatedAt,
+			validation.By(validateDateTimeRange),
+		),
+		validation.Field(
+			&input.UpdatedAt,
+			validation.By(validateDateTimeRange),
+		),
+		validation.Field(
+			&input.DueDate,
+			validation.By(validateDateRange),
+		),
+		validation.Field(
+			&input.Tags,
+			validation.Length(0, 20).Error("タグの検索条件は20個まで指定できます。"),
+			validation.Each(validation.RuneLength(0, 30).Error("タグは30文字以内での入力をお願いします。")),
+		),
+	)
+}
+
+func validateDateTimeRange(value interface{}) error {
+	input, _ := value.(*model.DateTimeRange)
+	if input == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(input,
+		validation.Field(
+			&input.From,
+			validation.Date("2006-01-02 15:04:05").Error("日時はYYYY-MM-DD hh:mm:ss形式での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.To,
+			validation.Date("2006-01-02 15:04:05").Error("日時はYYYY-MM-DD hh:mm:ss形式での入力をお願いします。"),
+			validation.By(validateRangeOrder("2006-01-02 15:04:05", input.From)),
+		),
+	)
+}
+
+func validateDateRange(value interface{}) error {
+	input, _ := value.(*model.DateRange)
+	if input == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(input,
+		validation.Field(
+			&input.From,
+			validation.Date("2006-01-02").Error("日付はYYYY-MM-DD形式での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.To,
+			validation.Date("2006-01-02").Error("日付はYYYY-MM-DD形式での入力をお願いします。"),
+			validation.By(validateRangeOrder("2006-01-02", input.From)),
+		),
+	)
+}
+
+// NOTE: 範囲の終了が開始より前になっていないことを確認する
+func validateRangeOrder(layout string, from *string) validation.RuleFunc {
+	return func(value interface{}) error {
+		to, _ := value.(*string)
+		if from == nil || to == nil {
+			return nil
+		}
+
+		fromTime, fromErr := time.Parse(layout, *from)
+		toTime, toErr := time.Parse(layout, *to)
+		if fromErr == nil && toErr == nil && toTime.Before(fromTime) {
+			return validation.NewError("validation_range_order", "範囲の終了は開始以降での指定をお願いします。")
+		}
+		return nil
+	}
+}
+
+// NOTE: 全文検索はngram(2文字単位)のため、1文字では検索できない
+func ValidateSearchTodos(query string) error {
+	return validation.Validate(
+		strings.TrimSpace(query),
+		validation.Required.Error("検索キーワードは必須入力です。"),
+		validation.RuneLength(2, 100).Error("検索キーワードは2 ~ 100文字での入力をお願いします。"),
+	)
+}
@@ -0,0 +1,76 @@
+package validator
+
+import (
+	"app/graph/model"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateTodoTemplate(input model.CreateTodoTemplateInput) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Name,
+			validation.Required.Error("テンプレート名は必須入力です。"),
+			validation.RuneLength(1, 50).Error("テンプレート名は1 ~ 50文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.Title,
+			validation.Required.Error("タイトルは必須入力です。"),
+			validation.RuneLength(1, 50).Error("タイトルは1 ~ 50文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.DueOffsetDays,
+			validation.By(validateDueOffsetDays),
+		),
+		validation.Field(
+			&input.Tags,
+			validation.By(validateTags),
+		),
+		validation.Field(
+			&input.Subtasks,
+			validation.Length(0, 50).Error("サブタスクは50個まで指定できます。"),
+			validation.Each(validation.By(validateTodoTemplateSubtask)),
+		),
+	)
+}
+
+func validateTodoTemplateSubtask(value interface{}) error {
+	input, _ := value.(*model.TodoTemplateSubtaskInput)
+	if input == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(input,
+		validation.Field(
+			&input.Title,
+			validation.Required.Error("サブタスクのタイトルは必須入力です。"),
+			validation.RuneLength(1, 50).Error("サブタスクのタイトルは1 ~ 50文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.DueOffsetDays,
+			validation.By(validateDueOffsetDays),
+		),
+	)
+}
+
+func ValidateTodoTemplateOverrides(input model.TodoTemplateOverrides) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Title,
+			validation.NilOrNotEmpty.Error("タイトルは必須入力です。"),
+			validation.RuneLength(1, 50).Error("タイトルは1 ~ 50文字での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.StartDate,
+			validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式での入力をお願いします。"),
+		),
+	)
+}
+
+// NOTE: 期日は作成日(開始日)から何日後かで指定する
+func validateDueOffsetDays(value interface{}) error {
+	return validation.Validate(value,
+		validation.Min(0).Error("期日は0 ~ 365日後での指定をお願いします。"),
+		validation.Max(365).Error("期日は0 ~ 365日後での指定をお願いします。"),
+	)
+}
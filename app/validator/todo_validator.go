@@ -3,9 +3,14 @@ package validator
 import (
 	"app/graph/model"
 
+	"github.com/99designs/gqlgen/graphql"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
+// NOTE: 見積もり(分)の上限は7日とする
+const estimateMinutesMax = 7 * 24 * 60
+
 func ValidateCreateTodo(input model.CreateTodoInput) error {
 	return validation.ValidateStruct(&input,
 		validation.Field(
@@ -13,15 +18,138 @@ func ValidateCreateTodo(input model.CreateTodoInput) error {
 			validation.Required.Error("タイトルは必須入力です。"),
 			validation.RuneLength(1, 50).Error("タイトルは1 ~ 50文字での入力をお願いします。"),
 		),
+		validation.Field(
+			&input.DueDate,
+			validation.Date("2006-01-02").Error("期日はYYYY-MM-DD形式での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.Recurrence,
+			validation.By(validateRecurrence),
+		),
+		validation.Field(
+			&input.RemindAt,
+			validation.By(validateRemindAt),
+		),
+		validation.Field(
+			&input.EstimateMinutes,
+			validation.Min(1).Error("見積もりは1 ~ 10080分(7日)での入力をお願いします。"),
+			validation.Max(estimateMinutesMax).Error("見積もりは1 ~ 10080分(7日)での入力をお願いします。"),
+		),
+		validation.Field(
+			&input.Tags,
+			validation.By(validateTags),
+		),
 	)
 }
 
+// NOTE: 部分更新のため、指定された項目のみ検証する
 func ValidateUpdateTodo(input model.UpdateTodoInput) error {
 	return validation.ValidateStruct(&input,
 		validation.Field(
 			&input.Title,
-			validation.Required.Error("タイトルは必須入力です。"),
-			validation.RuneLength(1, 50).Error("タイトルは1 ~ 50文字での入力をお願いします。"),
+			omittable[*string](
+				validation.Required.Error("タイトルは必須入力です。"),
+				validation.RuneLength(1, 50).Error("タイトルは1 ~ 50文字での入力をお願いします。"),
+			),
+		),
+		validation.Field(
+			&input.DueDate,
+			omittable[*string](
+				validation.Date("2006-01-02").Error("期日はYYYY-MM-DD形式での入力をお願いします。"),
+			),
+		),
+		validation.Field(
+			&input.Recurrence,
+			omittable[*model.RecurrenceInput](
+				validation.By(validateRecurrence),
+			),
+		),
+		validation.Field(
+			&input.EstimateMinutes,
+			omittable[*int](
+				validation.Min(1).Error("見積もりは1 ~ 10080分(7日)での入力をお願いします。"),
+				validation.Max(estimateMinutesMax).Error("見積もりは1 ~ 10080分(7日)での入力をお願いします。"),
+			),
+		),
+		validation.Field(
+			&input.Tags,
+			omittable[[]string](
+				validation.By(validateTags),
+			),
 		),
 	)
 }
+
+func ValidateBulkTodoPatch(input model.BulkTodoPatch) error {
+	return validation.ValidateStruct(&input,
+		validation.Field(
+			&input.Title,
+			omittable[*string](
+				validation.Required.Error("タイトルは必須入力です。"),
+				validation.RuneLength(1, 50).Error("タイトルは1 ~ 50文字での入力をお願いします。"),
+			),
+		),
+		validation.Field(
+			&input.DueDate,
+			omittable[*string](
+				validation.Date("2006-01-02").Error("期日はYYYY-MM-DD形式での入力をお願いします。"),
+			),
+		),
+		validation.Field(
+			&input.Recurrence,
+			omittable[*model.RecurrenceInput](
+				validation.By(validateRecurrence),
+			),
+		),
+	)
+}
+
+func validateRecurrence(value interface{}) error {
+	input, _ := value.(*model.RecurrenceInput)
+	if input == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(input,
+		validation.Field(
+			&input.Interval,
+			validation.NilOrNotEmpty.Error("繰り返し間隔は1 ~ 365での指定をお願いします。"),
+			validation.Min(1).Error("繰り返し間隔は1 ~ 365での指定をお願いします。"),
+			validation.Max(365).Error("繰り返し間隔は1 ~ 365での指定をお願いします。"),
+		),
+		validation.Field(
+			&input.Weekdays,
+			validation.When(input.Frequency != model.RecurrenceFrequencyWeekly, validation.Empty.Error("曜日の指定は毎週の繰り返しでのみ可能です。")),
+		),
+		validation.Field(
+			&input.MonthDay,
+			validation.When(input.Frequency != model.RecurrenceFrequencyMonthly, validation.Nil.Error("日付の指定は毎月の繰り返しでのみ可能です。")),
+			validation.NilOrNotEmpty.Error("日付は1 ~ 31での指定をお願いします。"),
+			validation.Min(1).Error("日付は1 ~ 31での指定をお願いします。"),
+			validation.Max(31).Error("日付は1 ~ 31での指定をお願いします。"),
+		),
+	)
+}
+
+func validateTags(value interface{}) error {
+	tags, _ := value.([]string)
+	return validation.Validate(tags,
+		validation.Length(0, 10).Error("タグは10個まで指定できます。"),
+		validation.Each(
+			validation.Required.Error("タグは必須入力です。"),
+			validation.RuneLength(1, 30).Error("タグは1 ~ 30文字での入力をお願いします。"),
+		),
+	)
+}
+
+// NOTE: Omittableな入力値は、指定された場合のみ値を検証する(nullが明示された場合も検証対象)
+func omittable[T any](rules ...validation.Rule) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		input, _ := value.(graphql.Omittable[T])
+		v, ok := input.ValueOK()
+		if !ok {
+			return nil
+		}
+		return validation.Validate(v, rules...)
+	})
+}
@@ -0,0 +1,50 @@
+package validator
+
+import (
+	"app/graph/model"
+	"net/url"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+func ValidateCreateWebhook(webhookURL string, events []model.WebhookEvent, secret string) error {
+	return validation.Errors{
+		"url": validation.Validate(webhookURL,
+			validation.Required.Error("URLは必須入力です。"),
+			validation.RuneLength(1, 2048).Error("URLは2048文字以内での入力をお願いします。"),
+			is.URL.Error("URLの形式での入力をお願いします。"),
+			validation.By(validateWebhookScheme),
+		),
+		"events": validation.Validate(events,
+			validation.Required.Error("イベントは1つ以上指定してください。"),
+			validation.By(validateUniqueWebhookEvents),
+		),
+		// NOTE: 署名を推測されないよう、シークレットは16文字以上とする
+		"secret": validation.Validate(secret,
+			validation.Required.Error("シークレットは必須入力です。"),
+			validation.RuneLength(16, 255).Error("シークレットは16 ~ 255文字での入力をお願いします。"),
+		),
+	}.Filter()
+}
+
+func validateWebhookScheme(value interface{}) error {
+	webhookURL, _ := value.(string)
+	parsed, err := url.Parse(webhookURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return validation.NewError("validation_webhook_scheme", "URLはhttpまたはhttpsでの指定をお願いします。")
+	}
+	return nil
+}
+
+func validateUniqueWebhookEvents(value interface{}) error {
+	events, _ := value.([]model.WebhookEvent)
+	seen := map[model.WebhookEvent]bool{}
+	for _, event := range events {
+		if seen[event] {
+			return validation.NewError("validation_webhook_events", "イベントが重複しています。")
+		}
+		seen[event] = true
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package validator
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateWorkload(from string, to string) error {
+	return validation.Errors{
+		"from": validation.Validate(from,
+			validation.Required.Error("集計の開始日は必須入力です。"),
+			validation.Date("2006-01-02").Error("集計の開始日はYYYY-MM-DD形式での入力をお願いします。"),
+		),
+		"to": validation.Validate(&to,
+			validation.Required.Error("集計の終了日は必須入力です。"),
+			validation.Date("2006-01-02").Error("集計の終了日はYYYY-MM-DD形式での入力をお願いします。"),
+			validation.By(validateRangeOrder("2006-01-02", &from)),
+		),
+	}.Filter()
+}
@@ -8,6 +8,8 @@ import (
 type ViewError struct {
 	Code    int64
 	Message error
+	// NOTE: 競合エラー時に返す、サーバ側の最新の状態
+	Current interface{}
 }
 
 func (e ViewError) Error() string {
@@ -28,6 +30,13 @@ func NewUnauthorizedView(err error) ViewError {
 	}
 }
 
+func NewForbiddenView(err error) ViewError {
+	return ViewError{
+		Code:    http.StatusForbidden,
+		Message: err,
+	}
+}
+
 func NewNotFoundView(err error) ViewError {
 	return ViewError{
 		Code:    http.StatusNotFound,
@@ -35,6 +44,14 @@ func NewNotFoundView(err error) ViewError {
 	}
 }
 
+func NewConflictView(err error, current interface{}) ViewError {
+	return ViewError{
+		Code:    http.StatusConflict,
+		Message: err,
+		Current: current,
+	}
+}
+
 func NewInternalServerErrorView(err error) ViewError {
 	return ViewError{
 		Code:    http.StatusInternalServerError,